@@ -32,7 +32,11 @@ type HandlerOptions struct {
 	WasmConfig        *wasmtypes.WasmConfig
 	TXCounterStoreKey sdk.StoreKey
 	GlobalFeeSubspace paramtypes.Subspace
+	GlobalFeeKeeper   globalfee.FeeSource
+	RateLimiter       globalfee.RateLimiter
 	ContractSource    poekeeper.ContractSource
+	FeeSplitSource    poe.FeeSplitSource
+	FeeSponsorKeeper  poe.FeeSponsorKeeper
 }
 
 // NewAnteHandler constructor that setup the full ante handler chain for the application
@@ -58,9 +62,21 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 	if options.GlobalFeeSubspace.Name() == "" {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "param store is required for ante builder")
 	}
+	if options.GlobalFeeKeeper == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "global fee keeper is required for ante builder")
+	}
+	if options.RateLimiter == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "rate limiter is required for ante builder")
+	}
 	if options.ContractSource == nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "contract source is required for ante builder")
 	}
+	if options.FeeSplitSource == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "fee split source is required for ante builder")
+	}
+	if options.FeeSponsorKeeper == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "fee sponsor keeper is required for ante builder")
+	}
 	if options.IBCCoreKeeper == nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "ibc core keeper is required for ante builder")
 	}
@@ -75,20 +91,22 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		ante.NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
 		wasmkeeper.NewLimitSimulationGasDecorator(options.WasmConfig.SimulationGasLimit), // after setup context to enforce limits early
 		wasmkeeper.NewCountTXDecorator(options.TXCounterStoreKey),
-		ante.NewRejectExtensionOptionsDecorator(),
+		poe.NewRejectExtensionOptionsDecorator(), // accepts the fee sponsor extension only
 		ante.NewMempoolFeeDecorator(),
-		globalfee.NewGlobalMinimumChainFeeDecorator(options.GlobalFeeSubspace), // after local min fee check
+		globalfee.NewGlobalMinimumChainFeeDecorator(options.GlobalFeeSubspace, options.GlobalFeeKeeper), // after local min fee check
+		globalfee.NewRateLimitDecorator(options.RateLimiter),
 		ante.NewValidateBasicDecorator(),
 		ante.NewTxTimeoutHeightDecorator(),
 		ante.NewValidateMemoDecorator(options.AccountKeeper),
 		ante.NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
-		poe.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, options.ContractSource),
+		poe.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, options.ContractSource, options.FeeSplitSource),
 
 		// SetPubKeyDecorator must be called before all signature verification decorators
 		ante.NewSetPubKeyDecorator(options.AccountKeeper),
 		ante.NewValidateSigCountDecorator(options.AccountKeeper),
 		ante.NewSigGasConsumeDecorator(options.AccountKeeper, sigGasConsumer),
 		ante.NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler),
+		poe.NewSponsoredFeeDecorator(options.AccountKeeper, options.BankKeeper, options.ContractSource, options.FeeSplitSource, options.FeeSponsorKeeper), // after signature verification
 		ante.NewIncrementSequenceDecorator(options.AccountKeeper),
 		ibcante.NewAnteDecorator(options.IBCCoreKeeper),
 	}
@@ -10,6 +10,7 @@ import (
 	"github.com/confio/tgrade/app/upgrades"
 	v2 "github.com/confio/tgrade/app/upgrades/v2"
 	v3 "github.com/confio/tgrade/app/upgrades/v3"
+	v4 "github.com/confio/tgrade/app/upgrades/v4"
 
 	"github.com/CosmWasm/wasmd/x/wasm"
 	"github.com/cosmos/cosmos-sdk/baseapp"
@@ -153,9 +154,10 @@ var (
 		icatypes.ModuleName:         nil,
 		twasm.ModuleName:            {authtypes.Minter, authtypes.Burner},
 		poetypes.BondedPoolName:     {authtypes.Burner, authtypes.Staking},
+		poetypes.ModuleName:         {authtypes.Burner},
 	}
 
-	Upgrades = []upgrades.Upgrade{v2.Upgrade, v3.Upgrade}
+	Upgrades = []upgrades.Upgrade{v2.Upgrade, v3.Upgrade, v4.Upgrade}
 )
 
 var (
@@ -191,6 +193,7 @@ type TgradeApp struct {
 	authzKeeper      authzkeeper.Keeper
 	twasmKeeper      twasmkeeper.Keeper
 	poeKeeper        poekeeper.Keeper
+	globalFeeKeeper  globalfee.Keeper
 
 	scopedIBCKeeper      capabilitykeeper.ScopedKeeper
 	scopedICAHostKeeper  capabilitykeeper.ScopedKeeper
@@ -205,6 +208,9 @@ type TgradeApp struct {
 
 	// module configurator
 	configurator module.Configurator
+
+	txDecoder     sdk.TxDecoder
+	txPrioritizer globalfee.TxPrioritizer
 }
 
 // NewTgradeApp returns a reference to an initialized TgradeApp.
@@ -234,8 +240,9 @@ func NewTgradeApp(
 		paramstypes.StoreKey, ibchost.StoreKey, upgradetypes.StoreKey,
 		ibctransfertypes.StoreKey, capabilitytypes.StoreKey,
 		feegrant.StoreKey, authzkeeper.StoreKey, wasm.StoreKey, poe.StoreKey, icahosttypes.StoreKey,
+		globalfee.StoreKey,
 	)
-	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey)
+	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey, globalfee.TStoreKey)
 	memKeys := sdk.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
 
 	app := &TgradeApp{
@@ -409,6 +416,7 @@ func NewTgradeApp(
 		app.twasmKeeper,
 		app.accountKeeper,
 	)
+	app.globalFeeKeeper = globalfee.NewKeeper(keys[globalfee.StoreKey], tkeys[globalfee.TStoreKey], app.getSubspace(globalfee.ModuleName), &app.poeKeeper, &app.poeKeeper)
 	/****  Module Options ****/
 
 	// NOTE: we may consider parsing `appOpts` inside module constructors. For the moment
@@ -438,7 +446,7 @@ func NewTgradeApp(
 		ibc.NewAppModule(app.ibcKeeper),
 		params.NewAppModule(app.paramsKeeper),
 		transferModule,
-		globalfee.NewAppModule(app.getSubspace(globalfee.ModuleName)),
+		globalfee.NewAppModule(app.globalFeeKeeper, encodingConfig.TxConfig.TxDecoder(), app.Simulate),
 		icaModule,
 		crisis.NewAppModule(&app.crisisKeeper, skipGenesisInvariants),
 	)
@@ -496,7 +504,6 @@ func NewTgradeApp(
 		capabilitytypes.ModuleName,
 		authtypes.ModuleName,
 		banktypes.ModuleName,
-		crisistypes.ModuleName,
 		authz.ModuleName,
 		feegrant.ModuleName,
 		paramstypes.ModuleName,
@@ -511,6 +518,8 @@ func NewTgradeApp(
 		// poe after wasm contract instantiation
 		poe.ModuleName,
 		globalfee.ModuleName,
+		// crisis last so that the genesis invariants run on the bootstrapped state
+		crisistypes.ModuleName,
 	)
 
 	// Uncomment if you want to set a custom migration order here.
@@ -523,6 +532,7 @@ func NewTgradeApp(
 	app.mm.RegisterServices(app.configurator)
 
 	app.setupUpgradeHandlers()
+	app.setupUpgradeStoreLoaders()
 
 	// create the simulation manager and define the order of the modules for deterministic simulations
 	//
@@ -547,7 +557,7 @@ func NewTgradeApp(
 		),
 		ibc.NewAppModule(app.ibcKeeper),
 		transferModule,
-		globalfee.NewAppModule(app.getSubspace(globalfee.ModuleName)),
+		globalfee.NewAppModule(app.globalFeeKeeper, encodingConfig.TxConfig.TxDecoder(), app.Simulate),
 	)
 
 	app.sm.RegisterStoreDecoders()
@@ -557,6 +567,10 @@ func NewTgradeApp(
 	app.MountTransientStores(tkeys)
 	app.MountMemoryStores(memKeys)
 
+	txPriorityConfig, err := globalfee.ReadTxPriorityConfig(appOpts)
+	if err != nil {
+		panic("error while reading tx priority config: " + err.Error())
+	}
 	anteHandler, err := NewAnteHandler(
 		HandlerOptions{
 			AccountKeeper:     app.accountKeeper,
@@ -568,7 +582,11 @@ func NewTgradeApp(
 			WasmConfig:        &twasmConfig.WasmConfig,
 			TXCounterStoreKey: keys[twasm.StoreKey],
 			GlobalFeeSubspace: app.getSubspace(globalfee.ModuleName),
+			GlobalFeeKeeper:   app.globalFeeKeeper,
+			RateLimiter:       app.globalFeeKeeper,
 			ContractSource:    &app.poeKeeper,
+			FeeSplitSource:    &app.poeKeeper,
+			FeeSponsorKeeper:  &app.twasmKeeper,
 		},
 	)
 	if err != nil {
@@ -576,6 +594,8 @@ func NewTgradeApp(
 	}
 
 	app.SetAnteHandler(anteHandler)
+	app.txDecoder = encodingConfig.TxConfig.TxDecoder()
+	app.txPrioritizer = globalfee.NewTxPrioritizer(app.getSubspace(globalfee.ModuleName), app.globalFeeKeeper, txPriorityConfig)
 	app.SetInitChainer(app.InitChainer)
 	app.SetBeginBlocker(app.BeginBlocker)
 	app.SetEndBlocker(app.EndBlocker)
@@ -621,6 +641,21 @@ func (app *TgradeApp) BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock)
 	return app.mm.BeginBlock(ctx, req)
 }
 
+// CheckTx implements the ABCI interface and sets the fee based tx priority for the mempool. The SDK version in use
+// does not pass a priority out of the ante handler, so that it is calculated on the check state after the tx passed.
+func (app *TgradeApp) CheckTx(req abci.RequestCheckTx) abci.ResponseCheckTx {
+	res := app.BaseApp.CheckTx(req)
+	if !res.IsOK() {
+		return res
+	}
+	tx, err := app.txDecoder(req.Tx)
+	if err != nil {
+		return res
+	}
+	res.Priority = app.txPrioritizer.TxPriority(app.NewContext(true, tmproto.Header{}), tx)
+	return res
+}
+
 // EndBlocker application updates every end block
 func (app *TgradeApp) EndBlocker(ctx sdk.Context, req abci.RequestEndBlock) abci.ResponseEndBlock {
 	return app.mm.EndBlock(ctx, req)
@@ -712,14 +747,45 @@ func (app *TgradeApp) AppCodec() codec.Codec {
 
 func (app *TgradeApp) setupUpgradeHandlers() {
 	for _, upgrade := range Upgrades {
-		app.upgradeKeeper.SetUpgradeHandler(
-			upgrade.UpgradeName,
-			upgrade.CreateUpgradeHandler(
-				app.mm,
-				app.configurator,
-				app.accountKeeper,
-			),
+		handler := upgrade.CreateUpgradeHandler(
+			app.mm,
+			app.configurator,
+			app.accountKeeper,
 		)
+		if upgrade.PoEContractMigrations != nil {
+			handler = app.withPoEContractMigrations(handler, upgrade.PoEContractMigrations)
+		}
+		app.upgradeKeeper.SetUpgradeHandler(upgrade.UpgradeName, handler)
+	}
+}
+
+// setupUpgradeStoreLoaders sets the store loader for a planned upgrade that adds or removes stores
+func (app *TgradeApp) setupUpgradeStoreLoaders() {
+	upgradeInfo, err := app.upgradeKeeper.ReadUpgradeInfoFromDisk()
+	if err != nil {
+		panic(fmt.Sprintf("failed to read upgrade info from disk %s", err))
+	}
+	if app.upgradeKeeper.IsSkipHeight(upgradeInfo.Height) {
+		return
+	}
+	for _, upgrade := range Upgrades {
+		if upgradeInfo.Name == upgrade.UpgradeName && upgrade.StoreUpgrades != nil {
+			app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, upgrade.StoreUpgrades))
+		}
+	}
+}
+
+// withPoEContractMigrations migrates the PoE contracts to the embedded versions after the given handler
+func (app *TgradeApp) withPoEContractMigrations(handler upgradetypes.UpgradeHandler, msgs poe.ContractMigrateMsgs) upgradetypes.UpgradeHandler {
+	return func(ctx sdk.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		toVM, err := handler(ctx, plan, fromVM)
+		if err != nil {
+			return nil, err
+		}
+		if err := poe.MigratePoEContracts(ctx, app.twasmKeeper.GetContractKeeper(), app.twasmKeeper, &app.poeKeeper, msgs); err != nil {
+			return nil, fmt.Errorf("migrate poe contracts: %w", err)
+		}
+		return toVM, nil
 	}
 }
 
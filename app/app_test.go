@@ -6,10 +6,10 @@ import (
 	"testing"
 	"time"
 
-	"github.com/cosmos/cosmos-sdk/types/address"
-	"github.com/tendermint/tendermint/libs/rand"
-
 	"github.com/CosmWasm/wasmd/x/wasm"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/simapp/helpers"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
@@ -21,6 +21,8 @@ import (
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	db "github.com/tendermint/tm-db"
 
+	"github.com/confio/tgrade/x/globalfee"
+	globalfeetypes "github.com/confio/tgrade/x/globalfee/types"
 	poetypes "github.com/confio/tgrade/x/poe/types"
 )
 
@@ -32,7 +34,7 @@ func TestTgradeExport(t *testing.T) {
 	gapp := NewTgradeApp(log.NewTMLogger(log.NewSyncWriter(os.Stdout)), db, nil, true, map[int64]bool{}, DefaultNodeHome, 0, MakeEncodingConfig(), EmptyBaseAppOptions{}, emptyWasmOpts)
 	genesisState := NewDefaultGenesisState()
 
-	setupWithSingleValidatorGenTX(t, genesisState)
+	SetupWithSingleValidatorGenTX(t, genesisState, "")
 
 	stateBytes, err := json.MarshalIndent(genesisState, "", "  ")
 	require.NoError(t, err)
@@ -53,68 +55,6 @@ func TestTgradeExport(t *testing.T) {
 	require.NoError(t, err, "ExportAppStateAndValidators should not have an error")
 }
 
-func setupWithSingleValidatorGenTX(t *testing.T, genesisState GenesisState) {
-	// a validator needs:
-	// - signed genTX
-	// - account object
-	// - enough funds on the bank
-	// - membership in engagement group
-	marshaler := MakeEncodingConfig().Codec
-	poeGS := poetypes.GetGenesisStateFromAppState(marshaler, genesisState)
-	if poeGS.GetSeedContracts() == nil {
-		panic("not in seed mode")
-	}
-
-	bootstrapAccountAddr := sdk.AccAddress(rand.Bytes(address.Len))
-	myGenTx, myAddr, _ := poetypes.RandomGenTX(t, 100)
-	var authGenState authtypes.GenesisState
-	marshaler.MustUnmarshalJSON(genesisState[authtypes.ModuleName], &authGenState)
-	genAccounts := []authtypes.GenesisAccount{
-		authtypes.NewBaseAccount(myAddr, nil, 0, 0),
-		authtypes.NewBaseAccount(bootstrapAccountAddr, nil, 0, 0),
-	}
-	accounts, err := authtypes.PackAccounts(genAccounts)
-	require.NoError(t, err)
-	authGenState.Accounts = accounts
-	genesisState[authtypes.ModuleName] = marshaler.MustMarshalJSON(&authGenState)
-
-	var bankGenState banktypes.GenesisState
-	marshaler.MustUnmarshalJSON(genesisState[banktypes.ModuleName], &bankGenState)
-
-	coins := sdk.Coins{sdk.NewCoin(poetypes.DefaultBondDenom, sdk.NewInt(1000000000))}
-	bankGenState.Balances = append(bankGenState.Balances, banktypes.Balance{Address: myAddr.String(), Coins: coins})
-	bankGenState.Supply = bankGenState.Supply.Add(coins...)
-	bankGenState.Balances = append(bankGenState.Balances, banktypes.Balance{Address: bootstrapAccountAddr.String(), Coins: coins})
-	bankGenState.Supply = bankGenState.Supply.Add(coins...)
-
-	genAddrAndUpdateBalance := func(numAddr int, balance sdk.Coins) []string {
-		genAddr := make([]string, numAddr)
-		for i := 0; i < numAddr; i++ {
-			addr := poetypes.RandomAccAddress().String()
-			bankGenState.Balances = append(bankGenState.Balances, banktypes.Balance{Address: addr, Coins: balance})
-			genAddr[i] = addr
-			bankGenState.Supply = bankGenState.Supply.Add(balance...)
-		}
-		return genAddr
-	}
-	// add 3 oc members
-	ocMembers := genAddrAndUpdateBalance(3, coins)
-
-	// add 2 ap members
-	apMembers := genAddrAndUpdateBalance(2, coins)
-
-	genesisState[banktypes.ModuleName] = marshaler.MustMarshalJSON(&bankGenState)
-
-	// add system admin to not fail poe on validation
-	poeGS.GetSeedContracts().BondDenom = poetypes.DefaultBondDenom
-	poeGS.GetSeedContracts().GenTxs = []json.RawMessage{myGenTx}
-	poeGS.GetSeedContracts().Engagement = []poetypes.TG4Member{{Address: myAddr.String(), Points: 10}}
-	poeGS.GetSeedContracts().BootstrapAccountAddress = bootstrapAccountAddr.String()
-	poeGS.GetSeedContracts().OversightCommunityMembers = ocMembers
-	poeGS.GetSeedContracts().ArbiterPoolMembers = apMembers
-	genesisState = poetypes.SetGenesisStateInAppState(marshaler, genesisState, poeGS)
-}
-
 // ensure that blocked addresses are properly set in bank keeper
 func TestBlockedAddrs(t *testing.T) {
 	db := db.NewMemDB()
@@ -138,7 +78,7 @@ func TestIBCKeeperLazyInitialization(t *testing.T) {
 	db := db.NewMemDB()
 	gapp := NewTgradeApp(log.NewTMLogger(log.NewSyncWriter(os.Stdout)), db, nil, true, map[int64]bool{}, DefaultNodeHome, 0, MakeEncodingConfig(), EmptyBaseAppOptions{}, emptyWasmOpts)
 	genesisState := NewDefaultGenesisState()
-	setupWithSingleValidatorGenTX(t, genesisState)
+	SetupWithSingleValidatorGenTX(t, genesisState, "")
 
 	stateBytes, err := json.MarshalIndent(genesisState, "", "  ")
 	require.NoError(t, err)
@@ -169,3 +109,81 @@ func TestIBCKeeperLazyInitialization(t *testing.T) {
 	assert.Equal(t, []byte("myAppHash"), state.GetRoot().GetHash())
 	assert.Equal(t, uint64(now.UnixNano()), state.GetTimestamp())
 }
+
+func TestCheckTxPriority(t *testing.T) {
+	encodingConfig := MakeEncodingConfig()
+	gapp := NewTgradeApp(log.NewNopLogger(), db.NewMemDB(), nil, true, map[int64]bool{}, DefaultNodeHome, 0, encodingConfig, EmptyBaseAppOptions{}, emptyWasmOpts)
+	genesisState := NewDefaultGenesisState()
+	SetupWithSingleValidatorGenTX(t, genesisState, "")
+
+	// with a funded account
+	myKey := secp256k1.GenPrivKey()
+	myAddr := sdk.AccAddress(myKey.PubKey().Address())
+	cdc := encodingConfig.Codec
+	var authGenState authtypes.GenesisState
+	cdc.MustUnmarshalJSON(genesisState[authtypes.ModuleName], &authGenState)
+	acc, err := codectypes.NewAnyWithValue(authtypes.NewBaseAccount(myAddr, nil, 0, 0))
+	require.NoError(t, err)
+	authGenState.Accounts = append(authGenState.Accounts, acc)
+	genesisState[authtypes.ModuleName] = cdc.MustMarshalJSON(&authGenState)
+	var bankGenState banktypes.GenesisState
+	cdc.MustUnmarshalJSON(genesisState[banktypes.ModuleName], &bankGenState)
+	coins := sdk.NewCoins(sdk.NewCoin(poetypes.DefaultBondDenom, sdk.NewInt(1_000_000_000)))
+	bankGenState.Balances = append(bankGenState.Balances, banktypes.Balance{Address: myAddr.String(), Coins: coins})
+	bankGenState.Supply = bankGenState.Supply.Add(coins...)
+	genesisState[banktypes.ModuleName] = cdc.MustMarshalJSON(&bankGenState)
+	// and a global min gas price
+	var globalFeeGenState globalfeetypes.GenesisState
+	cdc.MustUnmarshalJSON(genesisState[globalfee.ModuleName], &globalFeeGenState)
+	globalFeeGenState.Params.MinimumGasPrices = sdk.NewDecCoins(sdk.NewDecCoinFromDec(poetypes.DefaultBondDenom, sdk.NewDecWithPrec(1, 2)))
+	genesisState[globalfee.ModuleName] = cdc.MustMarshalJSON(&globalFeeGenState)
+	initChainWithGenesis(t, gapp, genesisState)
+	// signatures are verified with account number 0 before the first block
+	header := tmproto.Header{Height: gapp.LastBlockHeight() + 1, Time: time.Now().UTC()}
+	gapp.BeginBlock(abci.RequestBeginBlock{Header: header})
+	gapp.EndBlock(abci.RequestEndBlock{Height: header.Height})
+	gapp.Commit()
+
+	ctx := gapp.NewContext(true, header)
+	accNum := gapp.accountKeeper.GetAccount(ctx, myAddr).GetAccountNumber()
+	const gas = 100_000
+	specs := map[string]struct {
+		feeAmount   sdk.Int
+		expPriority int64
+	}{
+		"min gas price": {
+			feeAmount:   sdk.NewInt(1_000),
+			expPriority: globalfee.TxPriorityScale,
+		},
+		"double min gas price": {
+			feeAmount:   sdk.NewInt(2_000),
+			expPriority: 2 * globalfee.TxPriorityScale,
+		},
+	}
+	var seq uint64
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			tx, err := helpers.GenTx(
+				encodingConfig.TxConfig,
+				[]sdk.Msg{banktypes.NewMsgSend(myAddr, myAddr, sdk.NewCoins(sdk.NewCoin(poetypes.DefaultBondDenom, sdk.OneInt())))},
+				sdk.NewCoins(sdk.NewCoin(poetypes.DefaultBondDenom, spec.feeAmount)),
+				gas,
+				"",
+				[]uint64{accNum},
+				[]uint64{seq},
+				myKey,
+			)
+			require.NoError(t, err)
+			txBytes, err := encodingConfig.TxConfig.TxEncoder()(tx)
+			require.NoError(t, err)
+
+			// when
+			res := gapp.CheckTx(abci.RequestCheckTx{Tx: txBytes, Type: abci.CheckTxType_New})
+
+			// then
+			require.True(t, res.IsOK(), res.Log)
+			assert.Equal(t, spec.expPriority, res.Priority)
+			seq++
+		})
+	}
+}
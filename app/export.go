@@ -6,6 +6,7 @@ import (
 
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	tmcrypto "github.com/tendermint/tendermint/crypto"
 	"github.com/tendermint/tendermint/crypto/encoding"
@@ -14,6 +15,7 @@ import (
 	tmtypes "github.com/tendermint/tendermint/types"
 
 	"github.com/confio/tgrade/x/poe/contract"
+	poetypes "github.com/confio/tgrade/x/poe/types"
 )
 
 // ExportAppStateAndValidators exports the state of the application for a genesis
@@ -21,9 +23,6 @@ import (
 func (app *TgradeApp) ExportAppStateAndValidators(
 	forZeroHeight bool, jailAllowedAddrs []string,
 ) (servertypes.ExportedApp, error) {
-	if forZeroHeight {
-		panic("zero height export not supported")
-	}
 	// as if they could withdraw from the start of the next block
 	ctx := app.NewContext(true, tmproto.Header{Height: app.LastBlockHeight()}).
 		WithBlockTime(time.Now().UTC())
@@ -31,6 +30,12 @@ func (app *TgradeApp) ExportAppStateAndValidators(
 	// We export at last height + 1, because that's the height at which
 	// Tendermint will start InitChain.
 	height := app.LastBlockHeight() + 1
+	if forZeroHeight {
+		height = 0
+		if err := app.prepForZeroHeightGenesis(ctx, jailAllowedAddrs); err != nil {
+			return servertypes.ExportedApp{}, sdkerrors.Wrap(err, "prepare zero height genesis")
+		}
+	}
 	genState := app.mm.ExportGenesis(ctx, app.appCodec)
 	appState, err := json.MarshalIndent(genState, "", "  ")
 	if err != nil {
@@ -49,6 +54,90 @@ func (app *TgradeApp) ExportAppStateAndValidators(
 	}, err
 }
 
+// prepForZeroHeightGenesis prepares the state for a chain restart at height zero.
+//
+// When jailAllowedAddrs are given, all other validators are jailed via the valset contract. The current valset epoch
+// is completed so that the accrued rewards are distributed and the active set is updated. Pending unbonding claims
+// and rewards stay in the contract state and are exported with it, via the contract export hook for contracts with
+// the state exporter privilege.
+func (app *TgradeApp) prepForZeroHeightGenesis(ctx sdk.Context, jailAllowedAddrs []string) error {
+	valsetAddr, err := app.poeKeeper.GetPoEContractAddress(ctx, poetypes.PoEContractTypeValset)
+	if err != nil {
+		return sdkerrors.Wrap(err, "valset contract")
+	}
+	if len(jailAllowedAddrs) != 0 {
+		if err := jailValidators(app, ctx, jailAllowedAddrs); err != nil {
+			return err
+		}
+	}
+	epoch, err := app.poeKeeper.ValsetContract(ctx).QueryEpoch(ctx)
+	if err != nil {
+		return sdkerrors.Wrap(err, "query epoch")
+	}
+	if epoch.EpochLength != 0 {
+		// the valset contract updates only once per epoch
+		lastEpoch := epoch.CurrentEpoch
+		if e := epoch.LastUpdateTime / epoch.EpochLength; e > lastEpoch {
+			lastEpoch = e
+		}
+		epochEnd := time.Unix(int64((lastEpoch+1)*epoch.EpochLength), 0).UTC()
+		if epochEnd.After(ctx.BlockTime()) {
+			ctx = ctx.WithBlockTime(epochEnd)
+		}
+	}
+	if _, err := contract.CallEndBlockWithValidatorUpdate(ctx, valsetAddr, &app.twasmKeeper); err != nil {
+		return sdkerrors.Wrap(err, "complete valset epoch")
+	}
+	return nil
+}
+
+// jailValidators jails all validators that are not in the allow list and not jailed already. The jail ends
+// with the next block so that operators can unjail themselves after the restart.
+func jailValidators(app *TgradeApp, ctx sdk.Context, jailAllowedAddrs []string) error {
+	allowed := make(map[string]struct{}, len(jailAllowedAddrs))
+	for _, a := range jailAllowedAddrs {
+		addr, err := sdk.AccAddressFromBech32(a)
+		if err != nil {
+			return sdkerrors.Wrapf(err, "jail allowed address %q", a)
+		}
+		allowed[addr.String()] = struct{}{}
+	}
+	// the OC is the valset admin that is allowed to jail
+	ocAddr, err := app.poeKeeper.GetPoEContractAddress(ctx, poetypes.PoEContractTypeOversightCommunityGovProposals)
+	if err != nil {
+		return sdkerrors.Wrap(err, "oc proposals contract")
+	}
+	valset := app.poeKeeper.ValsetContract(ctx)
+	var toJail []sdk.AccAddress
+	var pagination *contract.Paginator
+	for {
+		vals, cursor, err := valset.ListValidators(ctx, pagination)
+		if err != nil {
+			return sdkerrors.Wrap(err, "list validators")
+		}
+		for _, v := range vals {
+			if _, ok := allowed[v.OperatorAddress]; ok || v.Jailed {
+				continue
+			}
+			opAddr, err := sdk.AccAddressFromBech32(v.OperatorAddress)
+			if err != nil {
+				return sdkerrors.Wrapf(err, "operator %s", v.OperatorAddress)
+			}
+			toJail = append(toJail, opAddr)
+		}
+		if len(vals) == 0 || len(cursor) == 0 {
+			break
+		}
+		pagination = &contract.Paginator{StartAfter: cursor}
+	}
+	for _, opAddr := range toJail {
+		if err := valset.JailValidator(ctx, opAddr, time.Second, false, ocAddr); err != nil {
+			return sdkerrors.Wrapf(err, "jail validator %s", opAddr)
+		}
+	}
+	return nil
+}
+
 func activeValidatorSet(app *TgradeApp, ctx sdk.Context) ([]tmtypes.GenesisValidator, error) {
 	var result []tmtypes.GenesisValidator
 	valset := app.poeKeeper.ValsetContract(ctx)
@@ -7,6 +7,7 @@ import (
 	"testing"
 	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	poetypes "github.com/confio/tgrade/x/poe/types"
@@ -25,18 +26,7 @@ import (
 
 func TestTgradeGenesisExportImport(t *testing.T) {
 	doInitWithGenesis := func(gapp *TgradeApp, genesisState GenesisState) {
-		stateBytes, err := json.MarshalIndent(genesisState, "", "  ")
-		require.NoError(t, err)
-
-		// Initialize the chain
-		gapp.InitChain(
-			abci.RequestInitChain{
-				Time:          time.Now().UTC(),
-				Validators:    []abci.ValidatorUpdate{},
-				AppStateBytes: stateBytes,
-			},
-		)
-		gapp.Commit()
+		initChainWithGenesis(t, gapp, genesisState)
 	}
 	memDB := db.NewMemDB()
 	srcApp := NewTgradeApp(
@@ -53,7 +43,7 @@ func TestTgradeGenesisExportImport(t *testing.T) {
 	)
 
 	init := NewDefaultGenesisState()
-	setupWithSingleValidatorGenTX(t, init)
+	SetupWithSingleValidatorGenTX(t, init, "")
 	doInitWithGenesis(srcApp, init)
 
 	now := time.Now().UTC()
@@ -120,3 +110,102 @@ func TestTgradeGenesisExportImport(t *testing.T) {
 	)
 	doInitWithGenesis(newApp, gs)
 }
+
+func TestTgradeZeroHeightGenesisExportImport(t *testing.T) {
+	specs := map[string]struct {
+		jailAllowed  func(opAddr sdk.AccAddress) []string
+		expJailed    bool
+		expValidator bool
+	}{
+		"no jail allow list": {
+			jailAllowed:  func(opAddr sdk.AccAddress) []string { return nil },
+			expValidator: true,
+		},
+		"validator in jail allow list": {
+			jailAllowed:  func(opAddr sdk.AccAddress) []string { return []string{opAddr.String()} },
+			expValidator: true,
+		},
+		"validator not in jail allow list": {
+			jailAllowed: func(opAddr sdk.AccAddress) []string { return []string{poetypes.RandomAccAddress().String()} },
+			expJailed:   true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			memDB := db.NewMemDB()
+			srcApp := NewTgradeApp(log.NewNopLogger(), memDB, nil, true, map[int64]bool{}, DefaultNodeHome, 0, MakeEncodingConfig(), EmptyBaseAppOptions{}, emptyWasmOpts)
+			init := NewDefaultGenesisState()
+			SetupWithSingleValidatorGenTX(t, init, "")
+			initChainWithGenesis(t, srcApp, init)
+
+			now := time.Now().UTC()
+			for i := 0; i < 3; i++ { // add some blocks
+				header := tmproto.Header{
+					ChainID: "testing-1",
+					Height:  int64(2 + i),
+					Time:    now.Add(time.Duration(i+1) * time.Hour), // big step > epoch
+					AppHash: []byte(fmt.Sprintf("myAppHash%d", i)),
+				}
+				srcApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+				srcApp.EndBlock(abci.RequestEndBlock{Height: header.Height})
+				srcApp.Commit()
+			}
+			ctx := srcApp.NewContext(true, tmproto.Header{Height: srcApp.LastBlockHeight(), Time: time.Now().UTC()})
+			vals, _, err := srcApp.poeKeeper.ValsetContract(ctx).ListValidators(ctx, nil)
+			require.NoError(t, err)
+			require.Len(t, vals, 1)
+			opAddr, err := sdk.AccAddressFromBech32(vals[0].OperatorAddress)
+			require.NoError(t, err)
+
+			// when
+			exported, err := srcApp.ExportAppStateAndValidators(true, spec.jailAllowed(opAddr))
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, int64(0), exported.Height)
+			var gs GenesisState
+			require.NoError(t, tmjson.Unmarshal(exported.AppState, &gs))
+			var poeGs poetypes.GenesisState
+			require.NoError(t, srcApp.appCodec.UnmarshalJSON(gs[poetypes.ModuleName], &poeGs))
+			require.NotNil(t, poeGs.GetImportDump())
+			require.NoError(t, poetypes.ValidateGenesis(poeGs, MakeEncodingConfig().TxConfig.TxJSONDecoder()))
+			// and the validator is jailed in the exported state
+			ctx = srcApp.NewContext(true, tmproto.Header{Height: srcApp.LastBlockHeight(), Time: time.Now().UTC()})
+			gotVal, err := srcApp.poeKeeper.ValsetContract(ctx).QueryValidator(ctx, opAddr)
+			require.NoError(t, err)
+			require.NotNil(t, gotVal)
+			assert.Equal(t, spec.expJailed, gotVal.Jailed)
+			if !spec.expValidator {
+				// without an active validator the state can not be imported
+				assert.Empty(t, exported.Validators)
+				return
+			}
+			require.Len(t, exported.Validators, 1)
+
+			// and the state can be imported on a fresh DB
+			newApp := NewTgradeApp(log.NewNopLogger(), db.NewMemDB(), nil, true, map[int64]bool{}, DefaultNodeHome, 0, MakeEncodingConfig(), EmptyBaseAppOptions{}, emptyWasmOpts)
+			initChainWithGenesis(t, newApp, gs)
+			ctx = newApp.NewContext(true, tmproto.Header{Height: newApp.LastBlockHeight(), Time: time.Now().UTC()})
+			gotVal, err = newApp.poeKeeper.ValsetContract(ctx).QueryValidator(ctx, opAddr)
+			require.NoError(t, err)
+			require.NotNil(t, gotVal)
+			assert.Equal(t, spec.expJailed, gotVal.Jailed)
+		})
+	}
+}
+
+func initChainWithGenesis(t *testing.T, gapp *TgradeApp, genesisState GenesisState) {
+	t.Helper()
+	stateBytes, err := json.MarshalIndent(genesisState, "", "  ")
+	require.NoError(t, err)
+
+	// Initialize the chain
+	gapp.InitChain(
+		abci.RequestInitChain{
+			Time:          time.Now().UTC(),
+			Validators:    []abci.ValidatorUpdate{},
+			AppStateBytes: stateBytes,
+		},
+	)
+	gapp.Commit()
+}
@@ -7,8 +7,10 @@ import (
 
 	"github.com/CosmWasm/wasmd/x/wasm"
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/module"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	capabilitykeeper "github.com/cosmos/cosmos-sdk/x/capability/keeper"
+	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
 	ibctransferkeeper "github.com/cosmos/ibc-go/v3/modules/apps/transfer/keeper"
 	ibckeeper "github.com/cosmos/ibc-go/v3/modules/core/keeper"
 )
@@ -57,3 +59,15 @@ func (s TestSupport) TransferKeeper() ibctransferkeeper.Keeper {
 func (s TestSupport) AccountKeeper() authkeeper.AccountKeeper {
 	return s.app.accountKeeper
 }
+
+func (s TestSupport) ParamsKeeper() paramskeeper.Keeper {
+	return s.app.paramsKeeper
+}
+
+func (s TestSupport) ModuleManager() *module.Manager {
+	return s.app.mm
+}
+
+func (s TestSupport) Configurator() module.Configurator {
+	return s.app.configurator
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/libs/rand"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	tmtypes "github.com/tendermint/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
@@ -26,10 +27,13 @@ import (
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/simapp/helpers"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 	"github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	poetypes "github.com/confio/tgrade/x/poe/types"
 )
 
 // DefaultConsensusParams defines the default Tendermint consensus params used in
@@ -376,3 +380,67 @@ type EmptyBaseAppOptions struct{}
 func (ao EmptyBaseAppOptions) Get(o string) interface{} {
 	return nil
 }
+
+// SetupWithSingleValidatorGenTX adds a gentx signed for the chain id with account, funds and engagement points of a
+// single validator and the minimum PoE contract members to the genesis state in seed mode
+func SetupWithSingleValidatorGenTX(t *testing.T, genesisState GenesisState, chainID string) {
+	// a validator needs:
+	// - signed genTX
+	// - account object
+	// - enough funds on the bank
+	// - membership in engagement group
+	marshaler := MakeEncodingConfig().Codec
+	poeGS := poetypes.GetGenesisStateFromAppState(marshaler, genesisState)
+	if poeGS.GetSeedContracts() == nil {
+		panic("not in seed mode")
+	}
+
+	bootstrapAccountAddr := sdk.AccAddress(rand.Bytes(address.Len))
+	myGenTx, myAddr, _ := poetypes.RandomGenTXWithChainID(t, chainID, 100)
+	var authGenState authtypes.GenesisState
+	marshaler.MustUnmarshalJSON(genesisState[authtypes.ModuleName], &authGenState)
+	genAccounts := []authtypes.GenesisAccount{
+		authtypes.NewBaseAccount(myAddr, nil, 0, 0),
+		authtypes.NewBaseAccount(bootstrapAccountAddr, nil, 0, 0),
+	}
+	accounts, err := authtypes.PackAccounts(genAccounts)
+	require.NoError(t, err)
+	authGenState.Accounts = accounts
+	genesisState[authtypes.ModuleName] = marshaler.MustMarshalJSON(&authGenState)
+
+	var bankGenState banktypes.GenesisState
+	marshaler.MustUnmarshalJSON(genesisState[banktypes.ModuleName], &bankGenState)
+
+	coins := sdk.Coins{sdk.NewCoin(poetypes.DefaultBondDenom, sdk.NewInt(1000000000))}
+	bankGenState.Balances = append(bankGenState.Balances, banktypes.Balance{Address: myAddr.String(), Coins: coins})
+	bankGenState.Supply = bankGenState.Supply.Add(coins...)
+	bankGenState.Balances = append(bankGenState.Balances, banktypes.Balance{Address: bootstrapAccountAddr.String(), Coins: coins})
+	bankGenState.Supply = bankGenState.Supply.Add(coins...)
+
+	genAddrAndUpdateBalance := func(numAddr int, balance sdk.Coins) []string {
+		genAddr := make([]string, numAddr)
+		for i := 0; i < numAddr; i++ {
+			addr := poetypes.RandomAccAddress().String()
+			bankGenState.Balances = append(bankGenState.Balances, banktypes.Balance{Address: addr, Coins: balance})
+			genAddr[i] = addr
+			bankGenState.Supply = bankGenState.Supply.Add(balance...)
+		}
+		return genAddr
+	}
+	// add 3 oc members
+	ocMembers := genAddrAndUpdateBalance(3, coins)
+
+	// add 2 ap members
+	apMembers := genAddrAndUpdateBalance(2, coins)
+
+	genesisState[banktypes.ModuleName] = marshaler.MustMarshalJSON(&bankGenState)
+
+	// add system admin to not fail poe on validation
+	poeGS.GetSeedContracts().BondDenom = poetypes.DefaultBondDenom
+	poeGS.GetSeedContracts().GenTxs = []json.RawMessage{myGenTx}
+	poeGS.GetSeedContracts().Engagement = []poetypes.TG4Member{{Address: myAddr.String(), Points: 10}}
+	poeGS.GetSeedContracts().BootstrapAccountAddress = bootstrapAccountAddr.String()
+	poeGS.GetSeedContracts().OversightCommunityMembers = ocMembers
+	poeGS.GetSeedContracts().ArbiterPoolMembers = apMembers
+	genesisState = poetypes.SetGenesisStateInAppState(marshaler, genesisState, poeGS)
+}
@@ -1,9 +1,12 @@
 package upgrades
 
 import (
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	"github.com/confio/tgrade/x/poe"
 )
 
 // Upgrade defines a struct containing necessary fields that a SoftwareUpgradeProposal
@@ -16,4 +19,11 @@ type Upgrade struct {
 
 	// CreateUpgradeHandler defines the function that creates an upgrade handler
 	CreateUpgradeHandler func(*module.Manager, module.Configurator, authkeeper.AccountKeeper) upgradetypes.UpgradeHandler
+
+	// PoEContractMigrations when not nil, the PoE contracts are migrated to the embedded contract versions
+	// after the upgrade handler. Contract types without a migrate message are migrated with an empty json object.
+	PoEContractMigrations poe.ContractMigrateMsgs
+
+	// StoreUpgrades when not nil, stores to add, rename or delete with the upgrade
+	StoreUpgrades *storetypes.StoreUpgrades
 }
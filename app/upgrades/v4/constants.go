@@ -0,0 +1,19 @@
+package v4
+
+import (
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+
+	"github.com/confio/tgrade/app/upgrades"
+	globalfeetypes "github.com/confio/tgrade/x/globalfee/types"
+)
+
+// UpgradeName defines the on-chain upgrade name for the Tgrade v4 upgrade.
+const UpgradeName = "v4"
+
+var Upgrade = upgrades.Upgrade{
+	UpgradeName:          UpgradeName,
+	CreateUpgradeHandler: CreateUpgradeHandler,
+	StoreUpgrades: &storetypes.StoreUpgrades{
+		Added: []string{globalfeetypes.StoreKey},
+	},
+}
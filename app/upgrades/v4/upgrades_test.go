@@ -0,0 +1,64 @@
+package v4_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/confio/tgrade/app"
+	v4 "github.com/confio/tgrade/app/upgrades/v4"
+	globalfeetypes "github.com/confio/tgrade/x/globalfee/types"
+	poetypes "github.com/confio/tgrade/x/poe/types"
+)
+
+func TestUpgrade(t *testing.T) {
+	require.NotNil(t, v4.Upgrade.StoreUpgrades)
+	assert.Equal(t, []string{globalfeetypes.StoreKey}, v4.Upgrade.StoreUpgrades.Added)
+	var names []string
+	for _, u := range app.Upgrades {
+		names = append(names, u.UpgradeName)
+	}
+	assert.Contains(t, names, v4.UpgradeName)
+}
+
+func TestCreateUpgradeHandler(t *testing.T) {
+	tgrade := app.Setup(true)
+	tgrade.InitChain(
+		abci.RequestInitChain{
+			Validators:      []abci.ValidatorUpdate{},
+			ConsensusParams: app.DefaultConsensusParams,
+			AppStateBytes:   []byte(`{}`),
+		},
+	)
+	h := app.NewTestSupport(t, tgrade)
+	ctx := tgrade.NewContext(false, tmproto.Header{})
+
+	// state of the v3 chain
+	globalFeeSubspace, ok := h.ParamsKeeper().GetSubspace(globalfeetypes.ModuleName)
+	require.True(t, ok)
+	globalFeeSubspace.Set(ctx, globalfeetypes.ParamStoreKeyMinGasPrices, sdk.NewDecCoins())
+	fromVM := h.ModuleManager().GetVersionMap()
+	fromVM[globalfeetypes.ModuleName] = 1
+	fromVM[poetypes.ModuleName] = 1
+
+	// when
+	handler := v4.CreateUpgradeHandler(h.ModuleManager(), h.Configurator(), h.AccountKeeper())
+	gotVM, err := handler(ctx, upgradetypes.Plan{}, fromVM)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, h.ModuleManager().GetVersionMap(), gotVM)
+	var globalFeeParams globalfeetypes.Params
+	globalFeeSubspace.GetParamSet(ctx, &globalFeeParams)
+	assert.Equal(t, globalfeetypes.DefaultRateLimitParams(), globalFeeParams.RateLimit)
+	poeSubspace, ok := h.ParamsKeeper().GetSubspace(poetypes.ModuleName)
+	require.True(t, ok)
+	var feeSplit poetypes.FeeSplit
+	poeSubspace.Get(ctx, poetypes.KeyFeeSplit, &feeSplit)
+	assert.Equal(t, poetypes.DefaultFeeSplit(), feeSplit)
+}
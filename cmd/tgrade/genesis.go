@@ -0,0 +1,213 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/CosmWasm/wasmd/x/wasm"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/version"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/spf13/cobra"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmtypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/confio/tgrade/app"
+	appparams "github.com/confio/tgrade/app/params"
+	poetypes "github.com/confio/tgrade/x/poe/types"
+)
+
+// GenesisCmd returns the genesis tooling subcommands
+func GenesisCmd(encodingConfig appparams.EncodingConfig) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "genesis",
+		Short:                      "Genesis tooling subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	poeCmd := &cobra.Command{
+		Use:                        "poe",
+		Short:                      "Proof of engagement genesis subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	poeCmd.AddCommand(GenesisPoEDryRunCmd(encodingConfig))
+	cmd.AddCommand(poeCmd)
+	return cmd
+}
+
+type dryRunContract struct {
+	Type    string `json:"type"`
+	Address string `json:"address"`
+}
+
+type dryRunValidator struct {
+	Operator string          `json:"operator"`
+	Moniker  string          `json:"moniker"`
+	PubKey   json.RawMessage `json:"pub_key"`
+	Power    int64           `json:"power"`
+}
+
+type dryRunResult struct {
+	ChainID           string                      `json:"chain_id"`
+	Contracts         []dryRunContract            `json:"contracts"`
+	Validators        []dryRunValidator           `json:"validators"`
+	EngagementMembers []poetypes.EngagementMember `json:"engagement_members"`
+}
+
+// GenesisPoEDryRunCmd returns a command that bootstraps the PoE contracts from a genesis file in an in-memory app
+func GenesisPoEDryRunCmd(encodingConfig appparams.EncodingConfig) *cobra.Command {
+	return &cobra.Command{
+		Use:   "dry-run [genesis-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Run the genesis with the PoE contract bootstrap and gentxs in an in-memory app",
+		Long: fmt.Sprintf(`Run InitGenesis, including the PoE contract bootstrap and gentx delivery, in an in-memory app.
+Prints the resulting contract addresses, initial validator set and engagement members or the genesis error.
+No node state is written.
+
+Example:
+$ %s genesis poe dry-run ~/.tgrade/config/genesis.json`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			genDoc, err := tmtypes.GenesisDocFromFile(args[0])
+			if err != nil {
+				return err
+			}
+			var genState app.GenesisState
+			if err := json.Unmarshal(genDoc.AppState, &genState); err != nil {
+				return fmt.Errorf("unmarshal app state: %w", err)
+			}
+			if err := app.ModuleBasics.ValidateGenesis(encodingConfig.Codec, encodingConfig.TxConfig, genState); err != nil {
+				return fmt.Errorf("validate genesis: %w", err)
+			}
+
+			homeDir, err := os.MkdirTemp("", "tgrade-dry-run")
+			if err != nil {
+				return err
+			}
+			defer os.RemoveAll(homeDir)
+
+			var emptyWasmOpts []wasm.Option
+			tgradeApp := app.NewTgradeApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, homeDir, 0, encodingConfig, app.EmptyBaseAppOptions{}, emptyWasmOpts)
+			res, err := dryRunInitChain(tgradeApp, genDoc)
+			if err != nil {
+				return fmt.Errorf("init genesis: %w", err)
+			}
+			tgradeApp.Commit()
+
+			result, err := collectDryRunResult(tgradeApp, encodingConfig.Codec, genDoc.ChainID, res.Validators)
+			if err != nil {
+				return err
+			}
+			bz, err := json.MarshalIndent(result, "", " ")
+			if err != nil {
+				return err
+			}
+			cmd.Println(string(bz))
+			return nil
+		},
+	}
+}
+
+// dryRunInitChain calls InitChain and returns any panic as error
+func dryRunInitChain(tgradeApp *app.TgradeApp, genDoc *tmtypes.GenesisDoc) (res abci.ResponseInitChain, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+	res = tgradeApp.InitChain(abci.RequestInitChain{
+		Time:            genDoc.GenesisTime,
+		ChainId:         genDoc.ChainID,
+		ConsensusParams: tmtypes.TM2PB.ConsensusParams(genDoc.ConsensusParams),
+		AppStateBytes:   genDoc.AppState,
+		InitialHeight:   genDoc.InitialHeight,
+	})
+	return res, nil
+}
+
+func collectDryRunResult(tgradeApp *app.TgradeApp, cdc codec.Codec, chainID string, updates []abci.ValidatorUpdate) (*dryRunResult, error) {
+	result := dryRunResult{ChainID: chainID}
+	var err error
+	poetypes.IteratePoEContractTypes(func(tp poetypes.PoEContractType) bool {
+		var rsp poetypes.QueryContractAddressResponse
+		err = abciQuery(tgradeApp, "/confio.poe.v1beta1.Query/ContractAddress", &poetypes.QueryContractAddressRequest{ContractType: tp}, &rsp)
+		if err != nil {
+			return true
+		}
+		result.Contracts = append(result.Contracts, dryRunContract{Type: tp.String(), Address: rsp.Address})
+		return false
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var validators []stakingtypes.Validator
+	var pageReq query.PageRequest
+	for {
+		var rsp stakingtypes.QueryValidatorsResponse
+		if err := abciQuery(tgradeApp, "/confio.poe.v1beta1.Query/Validators", &stakingtypes.QueryValidatorsRequest{Pagination: &pageReq}, &rsp); err != nil {
+			return nil, err
+		}
+		validators = append(validators, rsp.Validators...)
+		if rsp.Pagination == nil || len(rsp.Pagination.NextKey) == 0 {
+			break
+		}
+		pageReq.Key = rsp.Pagination.NextKey
+	}
+	for _, u := range updates {
+		pk, err := cryptocodec.FromTmProtoPublicKey(u.PubKey)
+		if err != nil {
+			return nil, fmt.Errorf("validator update pubkey: %w", err)
+		}
+		pkBz, err := cdc.MarshalInterfaceJSON(pk)
+		if err != nil {
+			return nil, fmt.Errorf("validator update pubkey: %w", err)
+		}
+		v := dryRunValidator{PubKey: pkBz, Power: u.Power}
+		for _, val := range validators {
+			if err := val.UnpackInterfaces(cdc); err != nil {
+				return nil, err
+			}
+			if valPK, err := val.ConsPubKey(); err == nil && valPK.Equals(pk) {
+				v.Operator, v.Moniker = val.OperatorAddress, val.GetMoniker()
+				break
+			}
+		}
+		result.Validators = append(result.Validators, v)
+	}
+
+	pageReq = query.PageRequest{}
+	for {
+		var rsp poetypes.QueryEngagementMembersResponse
+		if err := abciQuery(tgradeApp, "/confio.poe.v1beta1.Query/EngagementMembers", &poetypes.QueryEngagementMembersRequest{Pagination: &pageReq}, &rsp); err != nil {
+			return nil, err
+		}
+		result.EngagementMembers = append(result.EngagementMembers, rsp.Members...)
+		if rsp.Pagination == nil || len(rsp.Pagination.NextKey) == 0 {
+			break
+		}
+		pageReq.Key = rsp.Pagination.NextKey
+	}
+	return &result, nil
+}
+
+// abciQuery runs the gRPC query against the latest committed state
+func abciQuery(tgradeApp *app.TgradeApp, path string, req, rsp codec.ProtoMarshaler) error {
+	bz, err := req.Marshal()
+	if err != nil {
+		return err
+	}
+	res := tgradeApp.Query(abci.RequestQuery{Path: path, Data: bz})
+	if !res.IsOK() {
+		return fmt.Errorf("query %s: %s", path, res.Log)
+	}
+	return rsp.Unmarshal(res.Value)
+}
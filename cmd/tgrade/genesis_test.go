@@ -0,0 +1,93 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/confio/tgrade/app"
+	poetypes "github.com/confio/tgrade/x/poe/types"
+)
+
+func TestGenesisPoEDryRunCmd(t *testing.T) {
+	encodingConfig := app.MakeEncodingConfig()
+	specs := map[string]struct {
+		setupGenesis func(t *testing.T, genesisState app.GenesisState)
+		expErr       string
+	}{
+		"with single validator": {
+			setupGenesis: func(t *testing.T, genesisState app.GenesisState) {
+				app.SetupWithSingleValidatorGenTX(t, genesisState, "testing")
+			},
+		},
+		"empty gentx": {
+			setupGenesis: func(t *testing.T, genesisState app.GenesisState) {
+				app.SetupWithSingleValidatorGenTX(t, genesisState, "testing")
+				cdc := app.MakeEncodingConfig().Codec
+				poeGS := poetypes.GetGenesisStateFromAppState(cdc, genesisState)
+				poeGS.GetSeedContracts().GenTxs = nil
+				poetypes.SetGenesisStateInAppState(cdc, genesisState, poeGS)
+			},
+			expErr: "empty gentx",
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			genesisState := app.NewDefaultGenesisState()
+			spec.setupGenesis(t, genesisState)
+			appState, err := json.Marshal(genesisState)
+			require.NoError(t, err)
+			genDoc := tmtypes.GenesisDoc{
+				ChainID:     "testing",
+				GenesisTime: time.Now().UTC(),
+				AppState:    appState,
+			}
+			genFile := filepath.Join(t.TempDir(), "genesis.json")
+			require.NoError(t, genDoc.SaveAs(genFile))
+
+			cmd := GenesisPoEDryRunCmd(encodingConfig)
+			var out bytes.Buffer
+			cmd.SetOut(&out)
+			cmd.SetErr(&out)
+			cmd.SetArgs([]string{genFile})
+
+			// when
+			gotErr := cmd.Execute()
+
+			// then
+			if spec.expErr != "" {
+				require.Error(t, gotErr)
+				assert.Contains(t, gotErr.Error(), spec.expErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			var result dryRunResult
+			require.NoError(t, json.Unmarshal(out.Bytes(), &result))
+			assert.Equal(t, "testing", result.ChainID)
+			assert.NotEmpty(t, result.Contracts)
+			for _, c := range result.Contracts {
+				assert.NotEmpty(t, c.Address, c.Type)
+			}
+			require.Len(t, result.Validators, 1)
+			v := result.Validators[0]
+			assert.NotEmpty(t, v.Operator)
+			assert.NotEmpty(t, v.Moniker)
+			assert.Positive(t, v.Power)
+			var pubKey struct {
+				Type string `json:"@type"`
+				Key  []byte `json:"key"`
+			}
+			require.NoError(t, json.Unmarshal(v.PubKey, &pubKey))
+			assert.Equal(t, "/cosmos.crypto.ed25519.PubKey", pubKey.Type)
+			assert.Len(t, pubKey.Key, 32)
+			require.Len(t, result.EngagementMembers, 1)
+			assert.Equal(t, v.Operator, result.EngagementMembers[0].Address)
+		})
+	}
+}
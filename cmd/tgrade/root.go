@@ -37,6 +37,7 @@ import (
 
 	"github.com/confio/tgrade/app"
 	appparams "github.com/confio/tgrade/app/params"
+	"github.com/confio/tgrade/x/globalfee"
 	"github.com/confio/tgrade/x/poe/client/cli"
 )
 
@@ -112,6 +113,7 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig appparams.EncodingConfig
 		AddGenesisAccountCmd(app.DefaultNodeHome),
 		AddGenesisWasmMsgCmd(app.DefaultNodeHome),
 		GenesisWasmFlagsCmd(app.DefaultNodeHome),
+		GenesisCmd(encodingConfig),
 		tmcli.NewCompletionCmd(rootCmd, true),
 		testnetCmd(app.ModuleBasics, banktypes.GenesisBalancesIterator{}),
 		debug.Cmd(),
@@ -151,6 +153,7 @@ func customizeInitCmdDefaults(initCmd *cobra.Command) *cobra.Command {
 func addModuleInitFlags(startCmd *cobra.Command) {
 	crisis.AddModuleInitFlags(startCmd)
 	wasm.AddModuleInitFlags(startCmd)
+	globalfee.AddModuleInitFlags(startCmd)
 }
 
 func queryCommand() *cobra.Command {
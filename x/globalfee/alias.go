@@ -8,4 +8,6 @@ import (
 
 const (
 	ModuleName = types.ModuleName
+	StoreKey   = types.StoreKey
+	TStoreKey  = types.TStoreKey
 )
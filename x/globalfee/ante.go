@@ -17,19 +17,24 @@ type paramSource interface {
 }
 
 // GlobalMinimumChainFeeDecorator Ante decorator that enforces a minimum fee set for all transactions.
-// This minimum can be 0 though.
+// This minimum can be 0 though. When the dynamic base fee is enabled, the base gas price is enforced as minimum
+// for its denom. The minimum is discounted by the engagement tier of the fee payer. Transactions with bypass
+// message types only and within the bypass gas limit are not charged. Fees in the denoms of the fee price table
+// are accepted when their value is equivalent to the minimum fee in the base denom.
 type GlobalMinimumChainFeeDecorator struct {
 	paramSource paramSource
+	feeSource   FeeSource
 }
 
 // NewGlobalMinimumChainFeeDecorator constructor
-func NewGlobalMinimumChainFeeDecorator(paramSpace paramtypes.Subspace) GlobalMinimumChainFeeDecorator {
+func NewGlobalMinimumChainFeeDecorator(paramSpace paramtypes.Subspace, feeSource FeeSource) GlobalMinimumChainFeeDecorator {
 	if !paramSpace.HasKeyTable() {
 		panic("paramspace was not set up via module")
 	}
 
 	return GlobalMinimumChainFeeDecorator{
 		paramSource: paramSpace,
+		feeSource:   feeSource,
 	}
 }
 
@@ -40,25 +45,135 @@ func (g GlobalMinimumChainFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, s
 		if !ok {
 			return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "tx must be a sdk FeeTx")
 		}
-
-		var minGasPrices sdk.DecCoins
-		g.paramSource.Get(ctx, types.ParamStoreKeyMinGasPrices, &minGasPrices)
-		if !minGasPrices.IsZero() {
-			requiredFees := make(sdk.Coins, len(minGasPrices))
-
-			// Determine the required fees by multiplying each required minimum gas
-			// price by the gas limit, where fee = ceil(minGasPrice * gasLimit).
-			glDec := sdk.NewDec(int64(feeTx.GetGas()))
-			for i, gp := range minGasPrices {
-				fee := gp.Amount.Mul(glDec)
-				amount := fee.Ceil().RoundInt()
-				requiredFees[i] = sdk.NewCoin(gp.Denom, amount)
+		// the fee payer is not authenticated yet, so the engagement discount is only looked up when the fee does
+		// not cover the undiscounted minimum
+		fees, msgs, gas := feeTx.GetFee(), feeTx.GetMsgs(), feeTx.GetGas()
+		if !feeCovered(ctx, g.paramSource, fees, requiredFees(ctx, g.paramSource, g.feeSource, nil, msgs, gas)) {
+			requiredFees := requiredFees(ctx, g.paramSource, g.feeSource, feeTx.FeePayer(), msgs, gas)
+			if !feeCovered(ctx, g.paramSource, fees, requiredFees) {
+				return ctx, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "got: %s required: %s", fees, requiredFees)
 			}
+		}
+	}
+	return next(ctx, tx, simulate)
+}
+
+// feeCovered returns true when no fee is required or the fees cover the required fees in any denom or by value
+func feeCovered(ctx sdk.Context, paramSource paramSource, fees, requiredFees sdk.Coins) bool {
+	return len(requiredFees) == 0 || fees.IsAnyGTE(requiredFees) || coveredByValue(ctx, paramSource, fees, requiredFees)
+}
+
+// requiredFees returns the fees for the given fee payer, messages and gas that are accepted alternatively by the
+// global fee rules. Nil is returned when no fee is required.
+func requiredFees(ctx sdk.Context, paramSource paramSource, feeSource FeeSource, feePayer sdk.AccAddress, msgs []sdk.Msg, gas uint64) sdk.Coins {
+	if !paramSource.Has(ctx, types.ParamStoreKeyMinGasPrices) || bypassMinFee(ctx, paramSource, msgs, gas) {
+		return nil
+	}
+	minGasPrices, _ := effectiveMinGasPrices(ctx, paramSource, feeSource, feePayer)
+	if minGasPrices.IsZero() {
+		return nil
+	}
+	requiredFees := make(sdk.Coins, len(minGasPrices))
+
+	// Determine the required fees by multiplying each required minimum gas
+	// price by the gas limit, where fee = ceil(minGasPrice * gasLimit).
+	glDec := sdk.NewDec(int64(gas))
+	for i, gp := range minGasPrices {
+		fee := gp.Amount.Mul(glDec)
+		amount := fee.Ceil().RoundInt()
+		requiredFees[i] = sdk.NewCoin(gp.Denom, amount)
+	}
+	return requiredFees
+}
+
+// coveredByValue returns true when the value of the fees in the base denom of the fee price table is not lower
+// than the required fee in the base denom
+func coveredByValue(ctx sdk.Context, paramSource paramSource, fees, requiredFees sdk.Coins) bool {
+	table, ok := feePriceTable(ctx, paramSource)
+	if !ok {
+		return false
+	}
+	required := requiredFees.AmountOf(table.BaseDenom)
+	if !required.IsPositive() {
+		return false
+	}
+	return table.Value(fees).GTE(required.ToDec())
+}
+
+// feePriceTable returns the fee price table when set with prices
+func feePriceTable(ctx sdk.Context, paramSource paramSource) (types.FeePriceTable, bool) {
+	var table types.FeePriceTable
+	if !paramSource.Has(ctx, types.ParamStoreKeyFeePriceTable) {
+		return table, false
+	}
+	paramSource.Get(ctx, types.ParamStoreKeyFeePriceTable, &table)
+	return table, len(table.Prices) != 0
+}
 
-			if !feeTx.GetFee().IsAnyGTE(requiredFees) {
-				return ctx, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "got: %s required: %s", feeTx.GetFee(), requiredFees)
+// effectiveMinGasPrices returns the minimum gas prices, with the base gas price as lower bound, multiplied by the
+// engagement discount of the fee payer. The discount is not applied without a fee payer.
+func effectiveMinGasPrices(ctx sdk.Context, paramSource paramSource, feeSource FeeSource, feePayer sdk.AccAddress) (sdk.DecCoins, sdk.Dec) {
+	var minGasPrices sdk.DecCoins
+	if paramSource.Has(ctx, types.ParamStoreKeyMinGasPrices) {
+		paramSource.Get(ctx, types.ParamStoreKeyMinGasPrices, &minGasPrices)
+	}
+	if basePrice, ok := feeSource.GetBaseGasPrice(ctx); ok {
+		minGasPrices = withBaseGasPrice(minGasPrices, basePrice)
+	}
+	multiplier := sdk.OneDec()
+	if !feePayer.Empty() {
+		multiplier = feeSource.GetGasPriceMultiplier(ctx, feePayer)
+	}
+	if multiplier.Equal(sdk.OneDec()) {
+		return minGasPrices, multiplier
+	}
+	return minGasPrices.MulDec(multiplier), multiplier
+}
+
+// withBaseGasPrice returns the min gas prices with the base gas price as lower bound for its denom
+func withBaseGasPrice(minGasPrices sdk.DecCoins, basePrice sdk.DecCoin) sdk.DecCoins {
+	r := make([]sdk.DecCoin, 0, len(minGasPrices)+1)
+	var found bool
+	for _, c := range minGasPrices {
+		if c.Denom == basePrice.Denom {
+			found = true
+			if c.Amount.LT(basePrice.Amount) {
+				c = basePrice
 			}
 		}
+		r = append(r, c)
 	}
-	return next(ctx, tx, simulate)
+	if !found {
+		r = append(r, basePrice)
+	}
+	return sdk.NewDecCoins(r...)
+}
+
+// bypassMinFee returns true when all messages are of a bypass type and the gas does not exceed the
+// bypass gas limit
+func bypassMinFee(ctx sdk.Context, paramSource paramSource, msgs []sdk.Msg, gas uint64) bool {
+	if !paramSource.Has(ctx, types.ParamStoreKeyBypassMinFeeMsgTypes) ||
+		!paramSource.Has(ctx, types.ParamStoreKeyMaxTotalBypassMinFeeMsgGasUsage) {
+		return false
+	}
+	var maxGas uint64
+	paramSource.Get(ctx, types.ParamStoreKeyMaxTotalBypassMinFeeMsgGasUsage, &maxGas)
+	if gas > maxGas {
+		return false
+	}
+	var bypassMsgTypes []string
+	paramSource.Get(ctx, types.ParamStoreKeyBypassMinFeeMsgTypes, &bypassMsgTypes)
+	if len(msgs) == 0 || len(bypassMsgTypes) == 0 {
+		return false
+	}
+	bypass := make(map[string]struct{}, len(bypassMsgTypes))
+	for _, t := range bypassMsgTypes {
+		bypass[t] = struct{}{}
+	}
+	for _, msg := range msgs {
+		if _, ok := bypass[sdk.MsgTypeURL(msg)]; !ok {
+			return false
+		}
+	}
+	return true
 }
@@ -4,16 +4,22 @@ import (
 	"testing"
 	"time"
 
+	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
 	"github.com/cosmos/cosmos-sdk/store"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/libs/rand"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
 
@@ -25,8 +31,11 @@ func TestGlobalMinimumChainFeeAnteHandler(t *testing.T) {
 		setupStore func(ctx sdk.Context, s paramstypes.Subspace)
 		next       sdk.AnteDecorator
 		simulation bool
+		msgs       []sdk.Msg
 		feeAmount  sdk.Coins
 		gasLimit   sdk.Gas
+		points     uint64
+		expQueried bool
 		expErr     *sdkerrors.Error
 	}{
 		"single fee above min": {
@@ -160,6 +169,127 @@ func TestGlobalMinimumChainFeeAnteHandler(t *testing.T) {
 			gasLimit: 1,
 			expErr:   sdkerrors.ErrInsufficientFee,
 		},
+		"bypass msg types only": {
+			setupStore: setupBypassParams,
+			msgs:       []sdk.Msg{&channeltypes.MsgRecvPacket{}, &clienttypes.MsgUpdateClient{}},
+			gasLimit:   1_000,
+		},
+		"bypass msg types at gas limit": {
+			setupStore: setupBypassParams,
+			msgs:       []sdk.Msg{&channeltypes.MsgRecvPacket{}},
+			gasLimit:   1_000,
+		},
+		"bypass msg types above gas limit": {
+			setupStore: setupBypassParams,
+			msgs:       []sdk.Msg{&channeltypes.MsgRecvPacket{}},
+			gasLimit:   1_001,
+			expErr:     sdkerrors.ErrInsufficientFee,
+		},
+		"bypass and other msg types": {
+			setupStore: setupBypassParams,
+			msgs:       []sdk.Msg{&channeltypes.MsgRecvPacket{}, &banktypes.MsgSend{}},
+			gasLimit:   1_000,
+			expErr:     sdkerrors.ErrInsufficientFee,
+		},
+		"other msg types with fee": {
+			setupStore: setupBypassParams,
+			msgs:       []sdk.Msg{&banktypes.MsgSend{}},
+			feeAmount:  sdk.NewCoins(sdk.NewCoin("ALX", sdk.NewInt(1_000))),
+			gasLimit:   1_000,
+		},
+		"bypass msg types not set": {
+			setupStore: func(ctx sdk.Context, s paramstypes.Subspace) {
+				s.SetParamSet(ctx, &types.Params{
+					MinimumGasPrices:                sdk.NewDecCoins(sdk.NewDecCoin("ALX", sdk.OneInt())),
+					MaxTotalBypassMinFeeMsgGasUsage: 1_000,
+				})
+			},
+			msgs:     []sdk.Msg{&channeltypes.MsgRecvPacket{}},
+			gasLimit: 1_000,
+			expErr:   sdkerrors.ErrInsufficientFee,
+		},
+		"dynamic base fee above min gas price": {
+			setupStore: setupDynamicBaseFeeParams(sdk.NewDec(2)),
+			feeAmount:  sdk.NewCoins(sdk.NewCoin("ALX", sdk.NewInt(2))),
+			gasLimit:   1,
+		},
+		"dynamic base fee not paid": {
+			setupStore: setupDynamicBaseFeeParams(sdk.NewDec(2)),
+			feeAmount:  sdk.NewCoins(sdk.NewCoin("ALX", sdk.NewInt(1))),
+			gasLimit:   1,
+			expErr:     sdkerrors.ErrInsufficientFee,
+		},
+		"dynamic base fee below min gas price": {
+			setupStore: setupDynamicBaseFeeParams(sdk.NewDecWithPrec(5, 1)),
+			feeAmount:  sdk.NewCoins(sdk.NewCoin("ALX", sdk.NewInt(1))),
+			gasLimit:   1,
+		},
+		"dynamic base fee with bypass msg types only": {
+			setupStore: func(ctx sdk.Context, s paramstypes.Subspace) {
+				setupDynamicBaseFeeParams(sdk.NewDec(2))(ctx, s)
+				s.Set(ctx, types.ParamStoreKeyBypassMinFeeMsgTypes, []string{sdk.MsgTypeURL(&channeltypes.MsgRecvPacket{})})
+			},
+			msgs:     []sdk.Msg{&channeltypes.MsgRecvPacket{}},
+			gasLimit: 1,
+		},
+		"engagement discount applied": {
+			setupStore: setupEngagementDiscountParams(sdk.NewDecWithPrec(5, 1)),
+			feeAmount:  sdk.NewCoins(sdk.NewCoin("ALX", sdk.NewInt(1))),
+			gasLimit:   1,
+			points:     10,
+			expQueried: true,
+		},
+		"engagement discount tier not reached": {
+			setupStore: setupEngagementDiscountParams(sdk.NewDecWithPrec(5, 1)),
+			feeAmount:  sdk.NewCoins(sdk.NewCoin("ALX", sdk.NewInt(1))),
+			gasLimit:   1,
+			points:     9,
+			expQueried: true,
+			expErr:     sdkerrors.ErrInsufficientFee,
+		},
+		"engagement discount to free": {
+			setupStore: setupEngagementDiscountParams(sdk.ZeroDec()),
+			gasLimit:   1,
+			points:     10,
+			expQueried: true,
+		},
+		"engagement discount not looked up when undiscounted fee covered": {
+			setupStore: setupEngagementDiscountParams(sdk.NewDecWithPrec(5, 1)),
+			feeAmount:  sdk.NewCoins(sdk.NewCoin("ALX", sdk.NewInt(2))),
+			gasLimit:   1,
+			points:     10,
+		},
+		"fee price table - single denom equivalent value": {
+			setupStore: setupFeePriceTableParams,
+			feeAmount:  sdk.NewCoins(sdk.NewCoin("BLX", sdk.NewInt(4))),
+			gasLimit:   2,
+		},
+		"fee price table - single denom below value": {
+			setupStore: setupFeePriceTableParams,
+			feeAmount:  sdk.NewCoins(sdk.NewCoin("BLX", sdk.NewInt(3))),
+			gasLimit:   2,
+			expErr:     sdkerrors.ErrInsufficientFee,
+		},
+		"fee price table - multiple denoms equivalent value": {
+			setupStore: setupFeePriceTableParams,
+			feeAmount:  sdk.NewCoins(sdk.NewCoin("ALX", sdk.NewInt(1)), sdk.NewCoin("BLX", sdk.NewInt(2)), sdk.NewCoin("CLX", sdk.NewInt(1))),
+			gasLimit:   6,
+		},
+		"fee price table - denom without price": {
+			setupStore: setupFeePriceTableParams,
+			feeAmount:  sdk.NewCoins(sdk.NewCoin("DLX", sdk.NewInt(100))),
+			gasLimit:   2,
+			expErr:     sdkerrors.ErrInsufficientFee,
+		},
+		"fee price table - base denom without min gas price": {
+			setupStore: func(ctx sdk.Context, s paramstypes.Subspace) {
+				setupFeePriceTableParams(ctx, s)
+				s.Set(ctx, types.ParamStoreKeyMinGasPrices, sdk.NewDecCoins(sdk.NewDecCoin("DLX", sdk.OneInt())))
+			},
+			feeAmount: sdk.NewCoins(sdk.NewCoin("BLX", sdk.NewInt(100))),
+			gasLimit:  2,
+			expErr:    sdkerrors.ErrInsufficientFee,
+		},
 		"simulation with no fee set": {
 			setupStore: func(ctx sdk.Context, s paramstypes.Subspace) {
 				s.SetParamSet(ctx, &types.Params{
@@ -180,20 +310,31 @@ func TestGlobalMinimumChainFeeAnteHandler(t *testing.T) {
 	}
 	for name, spec := range specs {
 		t.Run(name, func(t *testing.T) {
-			ctx, encCfg, subspace := setupTestStore(t)
+			ctx, encCfg, keeper := setupTestKeeper(t)
+			var queried bool
+			keeper.engagementSource = EngagementPointsSourceMock{
+				GetEngagementPointsFn: func(ctx sdk.Context, addr sdk.AccAddress) (uint64, error) {
+					queried = true
+					return spec.points, nil
+				},
+			}
+			subspace := keeper.paramSpace
 			spec.setupStore(ctx, subspace)
 
 			txBuilder := encCfg.TxConfig.NewTxBuilder()
+			require.NoError(t, txBuilder.SetMsgs(spec.msgs...))
+			setFeePayer(txBuilder, rand.Bytes(address.Len))
 			txBuilder.SetFeeAmount(spec.feeAmount)
 			txBuilder.SetGasLimit(spec.gasLimit)
 			tx := txBuilder.GetTx()
 			captured := &CapturingAnteHandler{}
 			anteHandler := sdk.ChainAnteDecorators(
-				NewGlobalMinimumChainFeeDecorator(subspace),
+				NewGlobalMinimumChainFeeDecorator(subspace, keeper),
 				captured,
 			)
 			_, gotErr := anteHandler(ctx, tx, spec.simulation)
 			require.True(t, spec.expErr.Is(gotErr), "exp : %s but got %#+v", spec.expErr, gotErr)
+			assert.Equal(t, spec.expQueried, queried)
 			if spec.expErr != nil {
 				require.Empty(t, captured.txs)
 				return
@@ -203,14 +344,76 @@ func TestGlobalMinimumChainFeeAnteHandler(t *testing.T) {
 	}
 }
 
+func setupBypassParams(ctx sdk.Context, s paramstypes.Subspace) {
+	s.SetParamSet(ctx, &types.Params{
+		MinimumGasPrices: sdk.NewDecCoins(sdk.NewDecCoin("ALX", sdk.OneInt())),
+		BypassMinFeeMsgTypes: []string{
+			sdk.MsgTypeURL(&channeltypes.MsgRecvPacket{}),
+			sdk.MsgTypeURL(&clienttypes.MsgUpdateClient{}),
+		},
+		MaxTotalBypassMinFeeMsgGasUsage: 1_000,
+	})
+}
+
+// setupDynamicBaseFeeParams sets a min gas price of 1ALX and the dynamic base fee with the given base gas price
+func setupDynamicBaseFeeParams(basePrice sdk.Dec) func(ctx sdk.Context, s paramstypes.Subspace) {
+	return func(ctx sdk.Context, s paramstypes.Subspace) {
+		s.SetParamSet(ctx, &types.Params{
+			MinimumGasPrices:                sdk.NewDecCoins(sdk.NewDecCoin("ALX", sdk.OneInt())),
+			MaxTotalBypassMinFeeMsgGasUsage: 1_000,
+			DynamicBaseFee: types.DynamicBaseFeeParams{
+				Enabled:         true,
+				Denom:           "ALX",
+				MinBaseGasPrice: basePrice,
+				MaxBaseGasPrice: basePrice,
+				TargetBlockGas:  1,
+				MaxChangeRate:   sdk.OneDec(),
+			},
+		})
+	}
+}
+
+// setupEngagementDiscountParams sets a min gas price of 2ALX and a discount tier for 10 engagement points
+func setupEngagementDiscountParams(multiplier sdk.Dec) func(ctx sdk.Context, s paramstypes.Subspace) {
+	return func(ctx sdk.Context, s paramstypes.Subspace) {
+		s.SetParamSet(ctx, &types.Params{
+			MinimumGasPrices:        sdk.NewDecCoins(sdk.NewDecCoin("ALX", sdk.NewInt(2))),
+			EngagementDiscountTiers: []types.EngagementDiscountTier{{MinPoints: 10, GasPriceMultiplier: multiplier}},
+		})
+	}
+}
+
+// setupFeePriceTableParams sets a min gas price of 1ALX and the prices 0.5ALX per BLX and 4ALX per CLX
+func setupFeePriceTableParams(ctx sdk.Context, s paramstypes.Subspace) {
+	s.SetParamSet(ctx, &types.Params{
+		MinimumGasPrices: sdk.NewDecCoins(sdk.NewDecCoin("ALX", sdk.OneInt())),
+		FeePriceTable: types.FeePriceTable{
+			BaseDenom: "ALX",
+			Prices: []types.DenomPrice{
+				{Denom: "BLX", Price: sdk.NewDecWithPrec(5, 1)},
+				{Denom: "CLX", Price: sdk.NewDec(4)},
+			},
+		},
+	})
+}
+
 func setupTestStore(t *testing.T) (sdk.Context, simappparams.EncodingConfig, paramstypes.Subspace) {
+	ctx, encCfg, keeper := setupTestKeeper(t)
+	return ctx, encCfg, keeper.paramSpace
+}
+
+func setupTestKeeper(t *testing.T) (sdk.Context, simappparams.EncodingConfig, Keeper) {
 	db := dbm.NewMemDB()
 	ms := store.NewCommitMultiStore(db)
 	encCfg := simapp.MakeTestEncodingConfig()
 	keyParams := sdk.NewKVStoreKey(paramstypes.StoreKey)
 	tkeyParams := sdk.NewTransientStoreKey(paramstypes.TStoreKey)
+	keyGlobalFee := sdk.NewKVStoreKey(types.StoreKey)
+	tkeyGlobalFee := sdk.NewTransientStoreKey(types.TStoreKey)
 	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, db)
+	ms.MountStoreWithDB(keyGlobalFee, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tkeyGlobalFee, sdk.StoreTypeTransient, db)
 	require.NoError(t, ms.LoadLatestVersion())
 
 	paramsKeeper := paramskeeper.NewKeeper(encCfg.Marshaler, encCfg.Amino, keyParams, tkeyParams)
@@ -220,8 +423,38 @@ func setupTestStore(t *testing.T) (sdk.Context, simappparams.EncodingConfig, par
 		Time:   time.Date(2020, time.April, 22, 12, 0, 0, 0, time.UTC),
 	}, false, log.NewNopLogger())
 
-	subspace := paramsKeeper.Subspace(ModuleName).WithKeyTable(types.ParamKeyTable())
-	return ctx, encCfg, subspace
+	engagementSource := EngagementPointsSourceMock{
+		GetEngagementPointsFn: func(ctx sdk.Context, addr sdk.AccAddress) (uint64, error) { return 0, nil },
+	}
+	keeper := NewKeeper(keyGlobalFee, tkeyGlobalFee, paramsKeeper.Subspace(ModuleName).WithKeyTable(types.ParamKeyTable()), engagementSource, ValidatorOperatorSourceMock{})
+	return ctx, encCfg, keeper
+}
+
+type EngagementPointsSourceMock struct {
+	GetEngagementPointsFn func(ctx sdk.Context, addr sdk.AccAddress) (uint64, error)
+}
+
+func (m EngagementPointsSourceMock) GetEngagementPoints(ctx sdk.Context, addr sdk.AccAddress) (uint64, error) {
+	if m.GetEngagementPointsFn == nil {
+		panic("not expected to be called")
+	}
+	return m.GetEngagementPointsFn(ctx, addr)
+}
+
+type ValidatorOperatorSourceMock struct {
+	IsValidatorOperatorFn func(ctx sdk.Context, addr sdk.AccAddress) bool
+}
+
+func (m ValidatorOperatorSourceMock) IsValidatorOperator(ctx sdk.Context, addr sdk.AccAddress) bool {
+	if m.IsValidatorOperatorFn == nil {
+		panic("not expected to be called")
+	}
+	return m.IsValidatorOperatorFn(ctx, addr)
+}
+
+// setFeePayer sets the fee payer that is not exposed by the client.TxBuilder interface
+func setFeePayer(txBuilder client.TxBuilder, feePayer sdk.AccAddress) {
+	txBuilder.(interface{ SetFeePayer(sdk.AccAddress) }).SetFeePayer(feePayer)
 }
 
 type CapturingAnteHandler struct {
@@ -1,8 +1,13 @@
 package cli
 
 import (
+	"errors"
+	"fmt"
+	"os"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/spf13/cobra"
 
 	"github.com/confio/tgrade/x/globalfee/types"
@@ -18,6 +23,12 @@ func GetQueryCmd() *cobra.Command {
 	}
 	queryCmd.AddCommand(
 		GetCmdShowMinimumGasPrices(),
+		GetCmdShowBypassMinFeeMsgTypes(),
+		GetCmdShowBaseGasPrice(),
+		GetCmdEstimateFee(),
+		GetCmdShowEffectiveMinGasPrices(),
+		GetCmdShowFeePriceTable(),
+		GetCmdShowRateLimitUsage(),
 	)
 	return queryCmd
 }
@@ -46,3 +57,198 @@ func GetCmdShowMinimumGasPrices() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+func GetCmdShowBypassMinFeeMsgTypes() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "bypass-min-fee-msg-types",
+		Short:   "Show message types that bypass the minimum fee",
+		Long:    "Show all message types that are free of the global minimum fee and the total gas limit for such TXs",
+		Aliases: []string{"bypass"},
+		Args:    cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.BypassMinFeeMsgTypes(cmd.Context(), &types.QueryBypassMinFeeMsgTypesRequest{})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdShowBaseGasPrice() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "base-gas-price",
+		Short: "Show the dynamic base gas price",
+		Long:  "Show the current dynamic base gas price. Empty when the dynamic base fee is disabled",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.BaseGasPrice(cmd.Context(), &types.QueryBaseGasPriceRequest{})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+const (
+	flagGas           = "gas"
+	flagGasAdjustment = "gas-adjustment"
+)
+
+func GetCmdEstimateFee() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "estimate [tx-json-file]",
+		Short: "Estimate the fees required by the global fee rules",
+		Long: fmt.Sprintf(`Estimate the fees required by the global fee rules for each accepted denom.
+The gas is simulated when a JSON encoded TX file is given. The TX must contain the signer infos with sequences.
+The simulated gas is multiplied with the --%s and the fees are calculated for this gas limit.
+The fees must be scaled when the TX is submitted with a different gas limit.
+Without a TX file the fees are calculated for the --%s amount.`, flagGasAdjustment, flagGas),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			gas, err := cmd.Flags().GetUint64(flagGas)
+			if err != nil {
+				return err
+			}
+			req := types.QueryEstimateFeeRequest{Gas: gas}
+			adjustment, err := cmd.Flags().GetString(flagGasAdjustment)
+			if err != nil {
+				return err
+			}
+			if adjustment != "" {
+				if req.GasAdjustment, err = sdk.NewDecFromStr(adjustment); err != nil {
+					return fmt.Errorf("gas adjustment: %w", err)
+				}
+			}
+			switch {
+			case len(args) != 0:
+				bz, err := os.ReadFile(args[0])
+				if err != nil {
+					return err
+				}
+				tx, err := clientCtx.TxConfig.TxJSONDecoder()(bz)
+				if err != nil {
+					return err
+				}
+				if req.TxBytes, err = clientCtx.TxConfig.TxEncoder()(tx); err != nil {
+					return err
+				}
+			case gas == 0:
+				return errors.New("tx file or gas required")
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.EstimateFee(cmd.Context(), &req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	cmd.Flags().Uint64(flagGas, 0, "Gas limit to calculate the fees for when no TX file is given")
+	cmd.Flags().String(flagGasAdjustment, "", "Factor applied to the simulated gas for the gas limit (default 1.3)")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdShowEffectiveMinGasPrices() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "effective-min-gas-prices [address]",
+		Short:   "Show the minimum gas prices for a fee payer",
+		Long:    "Show the minimum gas prices for a fee payer after the engagement discount, including the dynamic base gas price",
+		Aliases: []string{"effective"},
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			if _, err := sdk.AccAddressFromBech32(args[0]); err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.EffectiveMinGasPrices(cmd.Context(), &types.QueryEffectiveMinGasPricesRequest{Address: args[0]})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdShowFeePriceTable() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "fee-price-table",
+		Short:   "Show the fee price table",
+		Long:    "Show the fixed prices of accepted fee denoms in the base denom",
+		Aliases: []string{"prices"},
+		Args:    cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.FeePriceTable(cmd.Context(), &types.QueryFeePriceTableRequest{})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdShowRateLimitUsage() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "rate-limit-usage [address]",
+		Short:   "Show the rate limit usage of an account",
+		Long:    "Show the TXs and gas of an account within the current rate limit window and the limits",
+		Aliases: []string{"usage"},
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			if _, err := sdk.AccAddressFromBech32(args[0]); err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.RateLimitUsage(cmd.Context(), &types.QueryRateLimitUsageRequest{Address: args[0]})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
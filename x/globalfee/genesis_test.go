@@ -14,7 +14,7 @@ import (
 func TestDefaultGenesis(t *testing.T) {
 	encCfg := simapp.MakeTestEncodingConfig()
 	gotJson := AppModuleBasic{}.DefaultGenesis(encCfg.Marshaler)
-	assert.JSONEq(t, `{"params":{"minimum_gas_prices":[]}}`, string(gotJson), string(gotJson))
+	assert.JSONEq(t, `{"params":{"minimum_gas_prices":[],"bypass_min_fee_msg_types":[],"max_total_bypass_min_fee_msg_gas_usage":"1000000","dynamic_base_fee":{"enabled":false,"denom":"utgd","min_base_gas_price":"0.010000000000000000","max_base_gas_price":"10.000000000000000000","target_block_gas":"10000000","max_change_rate":"0.125000000000000000"},"engagement_discount_tiers":[],"fee_price_table":{"base_denom":"utgd","prices":[]},"rate_limit":{"window_blocks":"10","max_txs":"0","max_gas":"0"}},"base_gas_price":"0"}`, string(gotJson), string(gotJson))
 }
 
 func TestValidateGenesis(t *testing.T) {
@@ -44,6 +44,103 @@ func TestValidateGenesis(t *testing.T) {
 			src:    `{"params":{"minimum_gas_prices":[{"denom":"ALX", "amount":"-1"}]}}`,
 			expErr: true,
 		},
+		"bypass msg types": {
+			src: `{"params":{"bypass_min_fee_msg_types":["/ibc.core.channel.v1.MsgRecvPacket","/ibc.core.client.v1.MsgUpdateClient"],"max_total_bypass_min_fee_msg_gas_usage":"1000"}}`,
+		},
+		"duplicate bypass msg types not allowed": {
+			src:    `{"params":{"bypass_min_fee_msg_types":["/ibc.core.channel.v1.MsgRecvPacket","/ibc.core.channel.v1.MsgRecvPacket"]}}`,
+			expErr: true,
+		},
+		"invalid bypass msg type not allowed": {
+			src:    `{"params":{"bypass_min_fee_msg_types":["ibc.core.channel.v1.MsgRecvPacket"]}}`,
+			expErr: true,
+		},
+		"dynamic base fee": {
+			src: `{"params":{"dynamic_base_fee":{"enabled":true,"denom":"ALX","min_base_gas_price":"0.1","max_base_gas_price":"1","target_block_gas":"1000","max_change_rate":"0.125"}},"base_gas_price":"0.5"}`,
+		},
+		"dynamic base fee without min base gas price": {
+			src:    `{"params":{"dynamic_base_fee":{"enabled":true,"denom":"ALX","min_base_gas_price":"0","max_base_gas_price":"1","target_block_gas":"1000","max_change_rate":"0.125"}}}`,
+			expErr: true,
+		},
+		"dynamic base fee max below min": {
+			src:    `{"params":{"dynamic_base_fee":{"enabled":true,"denom":"ALX","min_base_gas_price":"1","max_base_gas_price":"0.1","target_block_gas":"1000","max_change_rate":"0.125"}}}`,
+			expErr: true,
+		},
+		"dynamic base fee without target block gas": {
+			src:    `{"params":{"dynamic_base_fee":{"enabled":true,"denom":"ALX","min_base_gas_price":"0.1","max_base_gas_price":"1","target_block_gas":"0","max_change_rate":"0.125"}}}`,
+			expErr: true,
+		},
+		"dynamic base fee change rate above 1": {
+			src:    `{"params":{"dynamic_base_fee":{"enabled":true,"denom":"ALX","min_base_gas_price":"0.1","max_base_gas_price":"1","target_block_gas":"1000","max_change_rate":"1.1"}}}`,
+			expErr: true,
+		},
+		"disabled dynamic base fee not validated": {
+			src: `{"params":{"dynamic_base_fee":{"enabled":false,"max_change_rate":"2"}}}`,
+		},
+		"negative base gas price": {
+			src:    `{"params":{},"base_gas_price":"-1"}`,
+			expErr: true,
+		},
+		"empty bypass msg type not allowed": {
+			src:    `{"params":{"bypass_min_fee_msg_types":[""]}}`,
+			expErr: true,
+		},
+		"engagement discount tiers": {
+			src: `{"params":{"engagement_discount_tiers":[{"min_points":"10","gas_price_multiplier":"0.8"},{"min_points":"100","gas_price_multiplier":"0"}]}}`,
+		},
+		"engagement discount tiers not sorted": {
+			src:    `{"params":{"engagement_discount_tiers":[{"min_points":"100","gas_price_multiplier":"0.5"},{"min_points":"10","gas_price_multiplier":"0.8"}]}}`,
+			expErr: true,
+		},
+		"engagement discount tiers with duplicate min points": {
+			src:    `{"params":{"engagement_discount_tiers":[{"min_points":"10","gas_price_multiplier":"0.8"},{"min_points":"10","gas_price_multiplier":"0.5"}]}}`,
+			expErr: true,
+		},
+		"engagement discount tier without min points": {
+			src:    `{"params":{"engagement_discount_tiers":[{"min_points":"0","gas_price_multiplier":"0.8"}]}}`,
+			expErr: true,
+		},
+		"engagement discount tier multiplier above 1": {
+			src:    `{"params":{"engagement_discount_tiers":[{"min_points":"10","gas_price_multiplier":"1.1"}]}}`,
+			expErr: true,
+		},
+		"fee price table": {
+			src: `{"params":{"fee_price_table":{"base_denom":"ALX","prices":[{"denom":"BLX","price":"0.5"},{"denom":"CLX","price":"2"}]}}}`,
+		},
+		"fee price table not sorted": {
+			src:    `{"params":{"fee_price_table":{"base_denom":"ALX","prices":[{"denom":"CLX","price":"2"},{"denom":"BLX","price":"0.5"}]}}}`,
+			expErr: true,
+		},
+		"fee price table with duplicate denoms": {
+			src:    `{"params":{"fee_price_table":{"base_denom":"ALX","prices":[{"denom":"BLX","price":"2"},{"denom":"BLX","price":"0.5"}]}}}`,
+			expErr: true,
+		},
+		"fee price table with base denom price": {
+			src:    `{"params":{"fee_price_table":{"base_denom":"ALX","prices":[{"denom":"ALX","price":"1"}]}}}`,
+			expErr: true,
+		},
+		"fee price table without base denom": {
+			src:    `{"params":{"fee_price_table":{"prices":[{"denom":"BLX","price":"1"}]}}}`,
+			expErr: true,
+		},
+		"fee price table with zero price": {
+			src:    `{"params":{"fee_price_table":{"base_denom":"ALX","prices":[{"denom":"BLX","price":"0"}]}}}`,
+			expErr: true,
+		},
+		"rate limit": {
+			src: `{"params":{"rate_limit":{"window_blocks":"10","max_txs":"5","max_gas":"1000000"}}}`,
+		},
+		"rate limit disabled without window": {
+			src: `{"params":{"rate_limit":{"window_blocks":"0","max_txs":"0","max_gas":"0"}}}`,
+		},
+		"rate limit without window": {
+			src:    `{"params":{"rate_limit":{"window_blocks":"0","max_txs":"5","max_gas":"0"}}}`,
+			expErr: true,
+		},
+		"engagement discount tier negative multiplier": {
+			src:    `{"params":{"engagement_discount_tiers":[{"min_points":"10","gas_price_multiplier":"-0.1"}]}}`,
+			expErr: true,
+		},
 	}
 	for name, spec := range specs {
 		t.Run(name, func(t *testing.T) {
@@ -64,22 +161,59 @@ func TestInitExportGenesis(t *testing.T) {
 	}{
 		"single fee": {
 			src: `{"params":{"minimum_gas_prices":[{"denom":"ALX", "amount":"1"}]}}`,
-			exp: types.GenesisState{types.Params{MinimumGasPrices: sdk.NewDecCoins(sdk.NewDecCoin("ALX", sdk.NewInt(1)))}},
+			exp: genesisFixture(func(m *types.GenesisState) {
+				m.Params.MinimumGasPrices = sdk.NewDecCoins(sdk.NewDecCoin("ALX", sdk.NewInt(1)))
+			}),
 		},
 		"multiple fee options": {
 			src: `{"params":{"minimum_gas_prices":[{"denom":"ALX", "amount":"1"}, {"denom":"BLX", "amount":"0.001"}]}}`,
-			exp: types.GenesisState{types.Params{MinimumGasPrices: sdk.NewDecCoins(sdk.NewDecCoin("ALX", sdk.NewInt(1)),
-				sdk.NewDecCoinFromDec("BLX", sdk.NewDecWithPrec(1, 3)))}},
+			exp: genesisFixture(func(m *types.GenesisState) {
+				m.Params.MinimumGasPrices = sdk.NewDecCoins(sdk.NewDecCoin("ALX", sdk.NewInt(1)),
+					sdk.NewDecCoinFromDec("BLX", sdk.NewDecWithPrec(1, 3)))
+			}),
+		},
+		"bypass msg types": {
+			src: `{"params":{"bypass_min_fee_msg_types":["/ibc.core.channel.v1.MsgRecvPacket"],"max_total_bypass_min_fee_msg_gas_usage":"1000"}}`,
+			exp: genesisFixture(func(m *types.GenesisState) {
+				m.Params.BypassMinFeeMsgTypes = []string{"/ibc.core.channel.v1.MsgRecvPacket"}
+				m.Params.MaxTotalBypassMinFeeMsgGasUsage = 1000
+			}),
+		},
+		"dynamic base fee": {
+			src: `{"params":{"dynamic_base_fee":{"enabled":true,"denom":"ALX","min_base_gas_price":"0.1","max_base_gas_price":"1","target_block_gas":"1000","max_change_rate":"0.125"}},"base_gas_price":"0.5"}`,
+			exp: genesisFixture(func(m *types.GenesisState) {
+				m.Params.DynamicBaseFee = types.DynamicBaseFeeParams{
+					Enabled:         true,
+					Denom:           "ALX",
+					MinBaseGasPrice: sdk.NewDecWithPrec(1, 1),
+					MaxBaseGasPrice: sdk.OneDec(),
+					TargetBlockGas:  1000,
+					MaxChangeRate:   sdk.NewDecWithPrec(125, 3),
+				}
+				m.BaseGasPrice = sdk.NewDecWithPrec(5, 1)
+			}),
+		},
+		"engagement discount tiers": {
+			src: `{"params":{"engagement_discount_tiers":[{"min_points":"10","gas_price_multiplier":"0.8"}]}}`,
+			exp: genesisFixture(func(m *types.GenesisState) {
+				m.Params.EngagementDiscountTiers = []types.EngagementDiscountTier{{MinPoints: 10, GasPriceMultiplier: sdk.NewDecWithPrec(8, 1)}}
+			}),
+		},
+		"fee price table": {
+			src: `{"params":{"fee_price_table":{"base_denom":"ALX","prices":[{"denom":"BLX","price":"0.5"}]}}}`,
+			exp: genesisFixture(func(m *types.GenesisState) {
+				m.Params.FeePriceTable = types.FeePriceTable{BaseDenom: "ALX", Prices: []types.DenomPrice{{Denom: "BLX", Price: sdk.NewDecWithPrec(5, 1)}}}
+			}),
 		},
 		"no fee set": {
 			src: `{"params":{}}`,
-			exp: types.GenesisState{types.Params{MinimumGasPrices: sdk.DecCoins{}}},
+			exp: genesisFixture(),
 		},
 	}
 	for name, spec := range specs {
 		t.Run(name, func(t *testing.T) {
-			ctx, encCfg, subspace := setupTestStore(t)
-			m := NewAppModule(subspace)
+			ctx, encCfg, keeper := setupTestKeeper(t)
+			m := NewAppModule(keeper, nil, nil)
 			m.InitGenesis(ctx, encCfg.Marshaler, []byte(spec.src))
 			gotJSON := m.ExportGenesis(ctx, encCfg.Marshaler)
 			var got types.GenesisState
@@ -88,3 +222,25 @@ func TestInitExportGenesis(t *testing.T) {
 		})
 	}
 }
+
+// genesisFixture returns the exported state for empty params
+func genesisFixture(mutators ...func(m *types.GenesisState)) types.GenesisState {
+	r := types.GenesisState{
+		Params: types.Params{
+			MinimumGasPrices:     sdk.DecCoins{},
+			BypassMinFeeMsgTypes: []string{},
+			DynamicBaseFee: types.DynamicBaseFeeParams{
+				MinBaseGasPrice: sdk.ZeroDec(),
+				MaxBaseGasPrice: sdk.ZeroDec(),
+				MaxChangeRate:   sdk.ZeroDec(),
+			},
+			EngagementDiscountTiers: []types.EngagementDiscountTier{},
+			FeePriceTable:           types.FeePriceTable{Prices: []types.DenomPrice{}},
+		},
+		BaseGasPrice: sdk.ZeroDec(),
+	}
+	for _, m := range mutators {
+		m(&r)
+	}
+	return r
+}
@@ -0,0 +1,163 @@
+package globalfee
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/confio/tgrade/x/globalfee/types"
+)
+
+// BaseGasPriceSource provides the current dynamic base gas price
+type BaseGasPriceSource interface {
+	// GetBaseGasPrice returns the base gas price and true when the dynamic base fee is enabled
+	GetBaseGasPrice(ctx sdk.Context) (sdk.DecCoin, bool)
+}
+
+// GasPriceDiscountSource provides the engagement based discount on the minimum gas prices
+type GasPriceDiscountSource interface {
+	// GetGasPriceMultiplier returns the multiplier of the minimum gas prices for the fee payer
+	GetGasPriceMultiplier(ctx sdk.Context, feePayer sdk.AccAddress) sdk.Dec
+}
+
+// FeeSource provides the dynamic gas price components of the global fee rules
+type FeeSource interface {
+	BaseGasPriceSource
+	GasPriceDiscountSource
+}
+
+// EngagementPointsSource provides the engagement points of an address
+type EngagementPointsSource interface {
+	GetEngagementPoints(ctx sdk.Context, addr sdk.AccAddress) (uint64, error)
+}
+
+// ValidatorOperatorSource decides if an address is a validator operator
+type ValidatorOperatorSource interface {
+	IsValidatorOperator(ctx sdk.Context, addr sdk.AccAddress) bool
+}
+
+var (
+	_ FeeSource   = Keeper{}
+	_ RateLimiter = Keeper{}
+)
+
+// Keeper maintains the dynamic base gas price, the engagement discount and the rate limit usage
+type Keeper struct {
+	storeKey         sdk.StoreKey
+	tStoreKey        sdk.StoreKey
+	paramSpace       paramtypes.Subspace
+	engagementSource EngagementPointsSource
+	validatorSource  ValidatorOperatorSource
+}
+
+// NewKeeper constructor
+func NewKeeper(
+	storeKey, tStoreKey sdk.StoreKey,
+	paramSpace paramtypes.Subspace,
+	engagementSource EngagementPointsSource,
+	validatorSource ValidatorOperatorSource,
+) Keeper {
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+	return Keeper{
+		storeKey:         storeKey,
+		tStoreKey:        tStoreKey,
+		paramSpace:       paramSpace,
+		engagementSource: engagementSource,
+		validatorSource:  validatorSource,
+	}
+}
+
+// GetBaseGasPrice returns the base gas price and true when the dynamic base fee is enabled.
+// The min base gas price is returned when no price was stored, yet.
+func (k Keeper) GetBaseGasPrice(ctx sdk.Context) (sdk.DecCoin, bool) {
+	p, ok := k.dynamicBaseFeeParams(ctx)
+	if !ok || !p.Enabled {
+		return sdk.DecCoin{}, false
+	}
+	price, ok := k.getStoredBaseGasPrice(ctx)
+	if !ok {
+		price = p.MinBaseGasPrice
+	}
+	return sdk.NewDecCoinFromDec(p.Denom, price), true
+}
+
+// UpdateBaseGasPrice adjusts the base gas price towards the target block gas. Nothing is done when the
+// dynamic base fee is disabled.
+func (k Keeper) UpdateBaseGasPrice(ctx sdk.Context, blockGasUsed uint64) {
+	p, ok := k.dynamicBaseFeeParams(ctx)
+	if !ok || !p.Enabled {
+		return
+	}
+	current, ok := k.getStoredBaseGasPrice(ctx)
+	if !ok {
+		current = p.MinBaseGasPrice
+	}
+	k.setBaseGasPrice(ctx, p.NextBaseGasPrice(current, blockGasUsed))
+}
+
+// GetGasPriceMultiplier returns the multiplier of the engagement discount tier for the fee payer. The engagement
+// points are cached for the current block to keep the contract queries bounded. One is returned when no tiers are set
+// or the points can not be queried.
+func (k Keeper) GetGasPriceMultiplier(ctx sdk.Context, feePayer sdk.AccAddress) sdk.Dec {
+	if !k.paramSpace.Has(ctx, types.ParamStoreKeyEngagementDiscountTiers) {
+		return sdk.OneDec()
+	}
+	var tiers []types.EngagementDiscountTier
+	k.paramSpace.Get(ctx, types.ParamStoreKeyEngagementDiscountTiers, &tiers)
+	if len(tiers) == 0 {
+		return sdk.OneDec()
+	}
+	points, err := k.engagementPoints(ctx, feePayer)
+	if err != nil {
+		ctx.Logger().Debug("engagement points", "address", feePayer.String(), "error", err)
+		return sdk.OneDec()
+	}
+	return types.GasPriceMultiplier(tiers, points)
+}
+
+// engagementPoints returns the points from the block cache or queries the engagement contract
+func (k Keeper) engagementPoints(ctx sdk.Context, addr sdk.AccAddress) (uint64, error) {
+	cache := prefix.NewStore(ctx.TransientStore(k.tStoreKey), types.EngagementPointsCachePrefix)
+	key := address.MustLengthPrefix(addr)
+	if bz := cache.Get(key); bz != nil {
+		return sdk.BigEndianToUint64(bz), nil
+	}
+	points, err := k.engagementSource.GetEngagementPoints(ctx, addr)
+	if err != nil {
+		return 0, err
+	}
+	cache.Set(key, sdk.Uint64ToBigEndian(points))
+	return points, nil
+}
+
+func (k Keeper) dynamicBaseFeeParams(ctx sdk.Context) (types.DynamicBaseFeeParams, bool) {
+	var p types.DynamicBaseFeeParams
+	if !k.paramSpace.Has(ctx, types.ParamStoreKeyDynamicBaseFee) {
+		return p, false
+	}
+	k.paramSpace.Get(ctx, types.ParamStoreKeyDynamicBaseFee, &p)
+	return p, true
+}
+
+func (k Keeper) getStoredBaseGasPrice(ctx sdk.Context) (sdk.Dec, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.BaseGasPriceKey)
+	if bz == nil {
+		return sdk.Dec{}, false
+	}
+	var price sdk.Dec
+	if err := price.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return price, true
+}
+
+func (k Keeper) setBaseGasPrice(ctx sdk.Context, price sdk.Dec) {
+	bz, err := price.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	ctx.KVStore(k.storeKey).Set(types.BaseGasPriceKey, bz)
+}
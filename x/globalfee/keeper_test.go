@@ -0,0 +1,170 @@
+package globalfee
+
+import (
+	"errors"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/stretchr/testify/assert"
+	"github.com/tendermint/tendermint/libs/rand"
+
+	"github.com/confio/tgrade/x/globalfee/types"
+)
+
+func TestUpdateBaseGasPrice(t *testing.T) {
+	dynamicBaseFee := types.DynamicBaseFeeParams{
+		Enabled:         true,
+		Denom:           "ALX",
+		MinBaseGasPrice: sdk.NewDecWithPrec(1, 1),
+		MaxBaseGasPrice: sdk.NewDec(2),
+		TargetBlockGas:  1000,
+		MaxChangeRate:   sdk.NewDecWithPrec(125, 3),
+	}
+	specs := map[string]struct {
+		dynamicBaseFee types.DynamicBaseFeeParams
+		current        *sdk.Dec
+		blockGasUsed   uint64
+		expPrice       sdk.DecCoin
+		expEnabled     bool
+	}{
+		"at target": {
+			dynamicBaseFee: dynamicBaseFee,
+			current:        decPtr(sdk.OneDec()),
+			blockGasUsed:   1000,
+			expPrice:       sdk.NewDecCoinFromDec("ALX", sdk.OneDec()),
+			expEnabled:     true,
+		},
+		"above target": {
+			dynamicBaseFee: dynamicBaseFee,
+			current:        decPtr(sdk.OneDec()),
+			blockGasUsed:   1500,
+			expPrice:       sdk.NewDecCoinFromDec("ALX", sdk.MustNewDecFromStr("1.0625")),
+			expEnabled:     true,
+		},
+		"max change rate above twice the target": {
+			dynamicBaseFee: dynamicBaseFee,
+			current:        decPtr(sdk.OneDec()),
+			blockGasUsed:   5000,
+			expPrice:       sdk.NewDecCoinFromDec("ALX", sdk.MustNewDecFromStr("1.125")),
+			expEnabled:     true,
+		},
+		"empty block": {
+			dynamicBaseFee: dynamicBaseFee,
+			current:        decPtr(sdk.OneDec()),
+			expPrice:       sdk.NewDecCoinFromDec("ALX", sdk.MustNewDecFromStr("0.875")),
+			expEnabled:     true,
+		},
+		"bound by max": {
+			dynamicBaseFee: dynamicBaseFee,
+			current:        decPtr(sdk.NewDec(2)),
+			blockGasUsed:   2000,
+			expPrice:       sdk.NewDecCoinFromDec("ALX", sdk.NewDec(2)),
+			expEnabled:     true,
+		},
+		"bound by min": {
+			dynamicBaseFee: dynamicBaseFee,
+			current:        decPtr(sdk.NewDecWithPrec(1, 1)),
+			expPrice:       sdk.NewDecCoinFromDec("ALX", sdk.NewDecWithPrec(1, 1)),
+			expEnabled:     true,
+		},
+		"starts with min": {
+			dynamicBaseFee: dynamicBaseFee,
+			blockGasUsed:   2000,
+			expPrice:       sdk.NewDecCoinFromDec("ALX", sdk.MustNewDecFromStr("0.1125")),
+			expEnabled:     true,
+		},
+		"disabled": {
+			dynamicBaseFee: types.DefaultDynamicBaseFeeParams(),
+			current:        decPtr(sdk.OneDec()),
+			blockGasUsed:   2000,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _, keeper := setupTestKeeper(t)
+			keeper.paramSpace.Set(ctx, types.ParamStoreKeyDynamicBaseFee, spec.dynamicBaseFee)
+			if spec.current != nil {
+				keeper.setBaseGasPrice(ctx, *spec.current)
+			}
+
+			// when
+			keeper.UpdateBaseGasPrice(ctx, spec.blockGasUsed)
+
+			// then
+			gotPrice, gotEnabled := keeper.GetBaseGasPrice(ctx)
+			assert.Equal(t, spec.expEnabled, gotEnabled)
+			assert.Equal(t, spec.expPrice, gotPrice)
+		})
+	}
+}
+
+func TestGetGasPriceMultiplier(t *testing.T) {
+	tiers := []types.EngagementDiscountTier{
+		{MinPoints: 10, GasPriceMultiplier: sdk.NewDecWithPrec(8, 1)},
+		{MinPoints: 100, GasPriceMultiplier: sdk.NewDecWithPrec(5, 1)},
+	}
+	specs := map[string]struct {
+		tiers         []types.EngagementDiscountTier
+		points        uint64
+		queryErr      error
+		expMultiplier sdk.Dec
+	}{
+		"below first tier": {
+			tiers:         tiers,
+			points:        9,
+			expMultiplier: sdk.OneDec(),
+		},
+		"first tier": {
+			tiers:         tiers,
+			points:        10,
+			expMultiplier: sdk.NewDecWithPrec(8, 1),
+		},
+		"highest tier": {
+			tiers:         tiers,
+			points:        1000,
+			expMultiplier: sdk.NewDecWithPrec(5, 1),
+		},
+		"no tiers": {
+			points:        1000,
+			expMultiplier: sdk.OneDec(),
+		},
+		"query error": {
+			tiers:         tiers,
+			queryErr:      errors.New("testing"),
+			expMultiplier: sdk.OneDec(),
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _, keeper := setupTestKeeper(t)
+			keeper.paramSpace.Set(ctx, types.ParamStoreKeyEngagementDiscountTiers, spec.tiers)
+			var queries int
+			keeper.engagementSource = EngagementPointsSourceMock{
+				GetEngagementPointsFn: func(ctx sdk.Context, addr sdk.AccAddress) (uint64, error) {
+					queries++
+					return spec.points, spec.queryErr
+				},
+			}
+			feePayer := rand.Bytes(address.Len)
+
+			// when
+			gotMultiplier := keeper.GetGasPriceMultiplier(ctx, feePayer)
+
+			// then
+			assert.Equal(t, spec.expMultiplier.String(), gotMultiplier.String())
+
+			// and cached for the block when queried successfully
+			expQueries := queries
+			if spec.queryErr != nil {
+				expQueries++
+			}
+			assert.Equal(t, gotMultiplier.String(), keeper.GetGasPriceMultiplier(ctx, feePayer).String())
+			assert.Equal(t, expQueries, queries)
+		})
+	}
+}
+
+func decPtr(d sdk.Dec) *sdk.Dec {
+	return &d
+}
@@ -0,0 +1,50 @@
+package globalfee
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/confio/tgrade/x/globalfee/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	paramSpace paramstypes.Subspace
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(paramSpace paramstypes.Subspace) Migrator {
+	return Migrator{paramSpace: paramSpace}
+}
+
+// Migrate1to2 sets the bypass min fee params to their defaults
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	defaults := types.DefaultParams()
+	m.paramSpace.Set(ctx, types.ParamStoreKeyBypassMinFeeMsgTypes, defaults.BypassMinFeeMsgTypes)
+	m.paramSpace.Set(ctx, types.ParamStoreKeyMaxTotalBypassMinFeeMsgGasUsage, defaults.MaxTotalBypassMinFeeMsgGasUsage)
+	return nil
+}
+
+// Migrate2to3 sets the disabled dynamic base fee params
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	m.paramSpace.Set(ctx, types.ParamStoreKeyDynamicBaseFee, types.DefaultDynamicBaseFeeParams())
+	return nil
+}
+
+// Migrate3to4 sets empty engagement discount tiers
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	m.paramSpace.Set(ctx, types.ParamStoreKeyEngagementDiscountTiers, types.DefaultParams().EngagementDiscountTiers)
+	return nil
+}
+
+// Migrate4to5 sets an empty fee price table
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	m.paramSpace.Set(ctx, types.ParamStoreKeyFeePriceTable, types.DefaultFeePriceTable())
+	return nil
+}
+
+// Migrate5to6 sets the disabled rate limit params
+func (m Migrator) Migrate5to6(ctx sdk.Context) error {
+	m.paramSpace.Set(ctx, types.ParamStoreKeyRateLimit, types.DefaultRateLimitParams())
+	return nil
+}
@@ -0,0 +1,105 @@
+package globalfee
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/rand"
+
+	"github.com/confio/tgrade/x/globalfee/types"
+)
+
+func TestMigrate1to2(t *testing.T) {
+	ctx, _, subspace := setupTestStore(t)
+	minGasPrices := sdk.NewDecCoins(sdk.NewDecCoin("ALX", sdk.OneInt()))
+	subspace.Set(ctx, types.ParamStoreKeyMinGasPrices, minGasPrices)
+
+	// when
+	require.NoError(t, NewMigrator(subspace).Migrate1to2(ctx))
+
+	// then
+	var gotMinGasPrices sdk.DecCoins
+	subspace.Get(ctx, types.ParamStoreKeyMinGasPrices, &gotMinGasPrices)
+	assert.Equal(t, minGasPrices, gotMinGasPrices)
+	var gotBypassMsgTypes []string
+	subspace.Get(ctx, types.ParamStoreKeyBypassMinFeeMsgTypes, &gotBypassMsgTypes)
+	assert.Empty(t, gotBypassMsgTypes)
+	var gotMaxGas uint64
+	subspace.Get(ctx, types.ParamStoreKeyMaxTotalBypassMinFeeMsgGasUsage, &gotMaxGas)
+	assert.Equal(t, types.DefaultMaxTotalBypassMinFeeMsgGasUsage, gotMaxGas)
+}
+
+func TestMigrate2to3(t *testing.T) {
+	ctx, _, keeper := setupTestKeeper(t)
+	keeper.paramSpace.Set(ctx, types.ParamStoreKeyMinGasPrices, sdk.NewDecCoins())
+	m := NewMigrator(keeper.paramSpace)
+	require.NoError(t, m.Migrate1to2(ctx))
+
+	// when
+	require.NoError(t, m.Migrate2to3(ctx))
+
+	// then
+	var got types.DynamicBaseFeeParams
+	keeper.paramSpace.Get(ctx, types.ParamStoreKeyDynamicBaseFee, &got)
+	assert.Equal(t, types.DefaultDynamicBaseFeeParams(), got)
+	_, enabled := keeper.GetBaseGasPrice(ctx)
+	assert.False(t, enabled)
+}
+
+func TestMigrate3to4(t *testing.T) {
+	ctx, _, keeper := setupTestKeeper(t)
+	keeper.paramSpace.Set(ctx, types.ParamStoreKeyMinGasPrices, sdk.NewDecCoins())
+	m := NewMigrator(keeper.paramSpace)
+	require.NoError(t, m.Migrate1to2(ctx))
+	require.NoError(t, m.Migrate2to3(ctx))
+
+	// when
+	require.NoError(t, m.Migrate3to4(ctx))
+
+	// then
+	var got []types.EngagementDiscountTier
+	keeper.paramSpace.Get(ctx, types.ParamStoreKeyEngagementDiscountTiers, &got)
+	assert.Empty(t, got)
+	assert.Equal(t, sdk.OneDec(), keeper.GetGasPriceMultiplier(ctx, rand.Bytes(address.Len)))
+}
+
+func TestMigrate4to5(t *testing.T) {
+	ctx, _, keeper := setupTestKeeper(t)
+	keeper.paramSpace.Set(ctx, types.ParamStoreKeyMinGasPrices, sdk.NewDecCoins())
+	m := NewMigrator(keeper.paramSpace)
+	require.NoError(t, m.Migrate1to2(ctx))
+	require.NoError(t, m.Migrate2to3(ctx))
+	require.NoError(t, m.Migrate3to4(ctx))
+
+	// when
+	require.NoError(t, m.Migrate4to5(ctx))
+
+	// then
+	var got types.FeePriceTable
+	keeper.paramSpace.Get(ctx, types.ParamStoreKeyFeePriceTable, &got)
+	assert.Equal(t, "utgd", got.BaseDenom)
+	assert.Empty(t, got.Prices)
+}
+
+func TestMigrate5to6(t *testing.T) {
+	ctx, _, keeper := setupTestKeeper(t)
+	keeper.paramSpace.Set(ctx, types.ParamStoreKeyMinGasPrices, sdk.NewDecCoins())
+	m := NewMigrator(keeper.paramSpace)
+	require.NoError(t, m.Migrate1to2(ctx))
+	require.NoError(t, m.Migrate2to3(ctx))
+	require.NoError(t, m.Migrate3to4(ctx))
+	require.NoError(t, m.Migrate4to5(ctx))
+
+	// when
+	require.NoError(t, m.Migrate5to6(ctx))
+
+	// then
+	var got types.Params
+	keeper.paramSpace.GetParamSet(ctx, &got)
+	assert.Equal(t, types.DefaultRateLimitParams(), got.RateLimit)
+	assert.False(t, got.RateLimit.Enabled())
+	assert.NoError(t, keeper.ConsumeRateLimit(ctx, rand.Bytes(address.Len), 1_000_000))
+}
@@ -8,13 +8,13 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
-	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/gorilla/mux"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/spf13/cast"
 	"github.com/spf13/cobra"
 	abci "github.com/tendermint/tendermint/abci/types"
 
@@ -47,10 +47,7 @@ func (a AppModuleBasic) ValidateGenesis(marshaler codec.JSONCodec, config client
 	if err != nil {
 		return err
 	}
-	if err := data.Params.ValidateBasic(); err != nil {
-		return sdkerrors.Wrap(err, "params")
-	}
-	return nil
+	return data.ValidateBasic()
 }
 
 func (a AppModuleBasic) RegisterInterfaces(registry codectypes.InterfaceRegistry) {
@@ -78,28 +75,32 @@ func (a AppModuleBasic) RegisterLegacyAminoCodec(amino *codec.LegacyAmino) {
 
 type AppModule struct {
 	AppModuleBasic
-	paramSpace paramstypes.Subspace
+	keeper    Keeper
+	txDecoder sdk.TxDecoder
+	simulate  SimulateFn
 }
 
 // NewAppModule constructor
-func NewAppModule(paramSpace paramstypes.Subspace) *AppModule {
-	if !paramSpace.HasKeyTable() {
-		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
-	}
-
-	return &AppModule{paramSpace: paramSpace}
+func NewAppModule(keeper Keeper, txDecoder sdk.TxDecoder, simulate SimulateFn) *AppModule {
+	return &AppModule{keeper: keeper, txDecoder: txDecoder, simulate: simulate}
 }
 
 func (a AppModule) InitGenesis(ctx sdk.Context, marshaler codec.JSONCodec, message json.RawMessage) []abci.ValidatorUpdate {
 	var genesisState types.GenesisState
 	marshaler.MustUnmarshalJSON(message, &genesisState)
-	a.paramSpace.SetParamSet(ctx, &genesisState.Params)
+	a.keeper.paramSpace.SetParamSet(ctx, &genesisState.Params)
+	if !genesisState.BaseGasPrice.IsNil() && genesisState.BaseGasPrice.IsPositive() {
+		a.keeper.setBaseGasPrice(ctx, genesisState.BaseGasPrice)
+	}
 	return nil
 }
 
 func (a AppModule) ExportGenesis(ctx sdk.Context, marshaler codec.JSONCodec) json.RawMessage {
 	var genState types.GenesisState
-	a.paramSpace.GetParamSet(ctx, &genState.Params)
+	a.keeper.paramSpace.GetParamSet(ctx, &genState.Params)
+	if price, ok := a.keeper.getStoredBaseGasPrice(ctx); ok {
+		genState.BaseGasPrice = price
+	}
 	return marshaler.MustMarshalJSON(&genState)
 }
 
@@ -119,13 +120,33 @@ func (a AppModule) LegacyQuerierHandler(amino *codec.LegacyAmino) sdk.Querier {
 }
 
 func (a AppModule) RegisterServices(cfg module.Configurator) {
-	types.RegisterQueryServer(cfg.QueryServer(), NewQuerier(a.paramSpace))
+	types.RegisterQueryServer(cfg.QueryServer(), NewQuerier(a.keeper.paramSpace, a.keeper, a.keeper, a.txDecoder, a.simulate))
+	m := NewMigrator(a.keeper.paramSpace)
+	if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(err)
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 2, m.Migrate2to3); err != nil {
+		panic(err)
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 3, m.Migrate3to4); err != nil {
+		panic(err)
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 4, m.Migrate4to5); err != nil {
+		panic(err)
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 5, m.Migrate5to6); err != nil {
+		panic(err)
+	}
 }
 
 func (a AppModule) BeginBlock(context sdk.Context, block abci.RequestBeginBlock) {
 }
 
-func (a AppModule) EndBlock(context sdk.Context, block abci.RequestEndBlock) []abci.ValidatorUpdate {
+// EndBlock adjusts the dynamic base gas price to the gas used in the block and prunes the rate limit usage
+// at the end of a window
+func (a AppModule) EndBlock(ctx sdk.Context, block abci.RequestEndBlock) []abci.ValidatorUpdate {
+	a.keeper.UpdateBaseGasPrice(ctx, ctx.BlockGasMeter().GasConsumedToLimit())
+	a.keeper.PruneRateLimitUsage(ctx)
 	return nil
 }
 
@@ -134,7 +155,7 @@ func (a AppModule) EndBlock(context sdk.Context, block abci.RequestEndBlock) []a
 // introduced by the module. To avoid wrong/empty versions, the initial version
 // should be set to 1.
 func (a AppModule) ConsensusVersion() uint64 {
-	return 1
+	return 6
 }
 
 // GenerateGenesisState genesis state for simulations only. Set to empty global fee
@@ -159,3 +180,32 @@ func (a AppModule) RegisterStoreDecoder(registry sdk.StoreDecoderRegistry) {
 func (a AppModule) WeightedOperations(simState module.SimulationState) []simtypes.WeightedOperation {
 	return nil
 }
+
+const (
+	flagTxPriorityEnabled = "globalfee.tx_priority_enabled"
+	flagMaxTxPriority     = "globalfee.max_tx_priority"
+)
+
+// AddModuleInitFlags implements servertypes.ModuleInitFlags interface.
+func AddModuleInitFlags(startCmd *cobra.Command) {
+	defaults := types.DefaultTxPriorityConfig()
+	startCmd.Flags().Bool(flagTxPriorityEnabled, defaults.Enabled, "Set the mempool priority of a TX by the gas price relative to the global minimum gas price")
+	startCmd.Flags().Int64(flagMaxTxPriority, defaults.MaxPriority, "Set the upper bound for the mempool priority of a TX")
+}
+
+// ReadTxPriorityConfig reads the tx priority configuration
+func ReadTxPriorityConfig(opts servertypes.AppOptions) (types.TxPriorityConfig, error) {
+	cfg := types.DefaultTxPriorityConfig()
+	var err error
+	if v := opts.Get(flagTxPriorityEnabled); v != nil {
+		if cfg.Enabled, err = cast.ToBoolE(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagMaxTxPriority); v != nil {
+		if cfg.MaxPriority, err = cast.ToInt64E(v); err != nil {
+			return cfg, err
+		}
+	}
+	return cfg, nil
+}
@@ -0,0 +1,79 @@
+package globalfee
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/confio/tgrade/x/globalfee/types"
+)
+
+// TxPriorityScale is the priority of a tx that pays exactly the global minimum gas price
+const TxPriorityScale = 1_000
+
+// TxPrioritizer calculates the tx priority for the mempool from the gas price relative to the global minimum gas
+// price of the fee denom. As the minimum gas prices are set by governance, they act as conversion table for
+// multi-denom fees and the highest priority of all fee denoms is taken. The fee price table is used to value the
+// fees in the base denom in addition.
+//
+// The SDK version in use neither returns the ante handler events nor a context priority from CheckTx, so that the
+// priority is calculated by the app after a successful CheckTx.
+type TxPrioritizer struct {
+	paramSource paramSource
+	feeSource   FeeSource
+	config      types.TxPriorityConfig
+}
+
+// NewTxPrioritizer constructor
+func NewTxPrioritizer(paramSpace paramtypes.Subspace, feeSource FeeSource, config types.TxPriorityConfig) TxPrioritizer {
+	if !paramSpace.HasKeyTable() {
+		panic("paramspace was not set up via module")
+	}
+	return TxPrioritizer{
+		paramSource: paramSpace,
+		feeSource:   feeSource,
+		config:      config,
+	}
+}
+
+// TxPriority returns the priority of the tx or 0 when disabled or the tx is not a fee tx
+func (p TxPrioritizer) TxPriority(ctx sdk.Context, tx sdk.Tx) int64 {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !p.config.Enabled || !ok {
+		return 0
+	}
+	return txPriority(ctx, p.paramSource, p.feeSource, feeTx.GetFee(), feeTx.GetGas(), p.config.MaxPriority)
+}
+
+// txPriority returns the highest gas price of the fees relative to the global minimum gas price of their denom,
+// multiplied by TxPriorityScale and capped by max. Fee denoms without a minimum gas price are ignored unless
+// they can be valued by the fee price table.
+func txPriority(ctx sdk.Context, paramSource paramSource, feeSource FeeSource, fees sdk.Coins, gas uint64, max int64) int64 {
+	if gas == 0 || max <= 0 {
+		return 0
+	}
+	// the engagement discount is not applied to not favour discounted fee payers for the same fee
+	minGasPrices, _ := effectiveMinGasPrices(ctx, paramSource, feeSource, nil)
+	maxDec := sdk.NewDec(max)
+	glDec := sdk.NewDec(int64(gas))
+	relativePrice := func(amount, minGasPrice sdk.Dec) sdk.Dec {
+		if !minGasPrice.IsPositive() {
+			return sdk.ZeroDec()
+		}
+		return amount.Quo(glDec).Quo(minGasPrice).MulInt64(TxPriorityScale)
+	}
+	result := sdk.ZeroDec()
+	for _, fee := range fees {
+		if p := relativePrice(fee.Amount.ToDec(), minGasPrices.AmountOf(fee.Denom)); p.GT(result) {
+			result = p
+		}
+	}
+	if table, ok := feePriceTable(ctx, paramSource); ok {
+		if p := relativePrice(table.Value(fees), minGasPrices.AmountOf(table.BaseDenom)); p.GT(result) {
+			result = p
+		}
+	}
+	if result.GT(maxDec) {
+		return max
+	}
+	return result.TruncateInt64()
+}
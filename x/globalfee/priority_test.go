@@ -0,0 +1,100 @@
+package globalfee
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/confio/tgrade/x/globalfee/types"
+)
+
+func TestTxPriority(t *testing.T) {
+	setupMinGasPrices := func(ctx sdk.Context, s paramstypes.Subspace) {
+		s.SetParamSet(ctx, &types.Params{
+			MinimumGasPrices: sdk.NewDecCoins(sdk.NewDecCoin("ALX", sdk.NewInt(2)), sdk.NewDecCoinFromDec("BLX", sdk.NewDecWithPrec(1, 1))),
+		})
+	}
+	specs := map[string]struct {
+		setupStore  func(ctx sdk.Context, s paramstypes.Subspace)
+		config      *types.TxPriorityConfig
+		feeAmount   sdk.Coins
+		gasLimit    sdk.Gas
+		expPriority int64
+	}{
+		"min gas price": {
+			setupStore:  setupMinGasPrices,
+			feeAmount:   sdk.NewCoins(sdk.NewCoin("ALX", sdk.NewInt(200))),
+			gasLimit:    100,
+			expPriority: TxPriorityScale,
+		},
+		"double min gas price": {
+			setupStore:  setupMinGasPrices,
+			feeAmount:   sdk.NewCoins(sdk.NewCoin("ALX", sdk.NewInt(400))),
+			gasLimit:    100,
+			expPriority: 2 * TxPriorityScale,
+		},
+		"multiple denoms - highest relative price": {
+			setupStore:  setupMinGasPrices,
+			feeAmount:   sdk.NewCoins(sdk.NewCoin("ALX", sdk.NewInt(200)), sdk.NewCoin("BLX", sdk.NewInt(30))),
+			gasLimit:    100,
+			expPriority: 3 * TxPriorityScale,
+		},
+		"unknown denom ignored": {
+			setupStore:  setupMinGasPrices,
+			feeAmount:   sdk.NewCoins(sdk.NewCoin("CLX", sdk.NewInt(1000))),
+			gasLimit:    100,
+			expPriority: 0,
+		},
+		"fee price table": {
+			setupStore:  setupFeePriceTableParams,
+			feeAmount:   sdk.NewCoins(sdk.NewCoin("BLX", sdk.NewInt(200)), sdk.NewCoin("CLX", sdk.NewInt(25))),
+			gasLimit:    100,
+			expPriority: 2 * TxPriorityScale,
+		},
+		"no min gas prices": {
+			setupStore:  func(ctx sdk.Context, s paramstypes.Subspace) { s.SetParamSet(ctx, &types.Params{}) },
+			feeAmount:   sdk.NewCoins(sdk.NewCoin("ALX", sdk.NewInt(200))),
+			gasLimit:    100,
+			expPriority: 0,
+		},
+		"capped by max": {
+			setupStore:  setupMinGasPrices,
+			config:      &types.TxPriorityConfig{Enabled: true, MaxPriority: 1500},
+			feeAmount:   sdk.NewCoins(sdk.NewCoin("ALX", sdk.NewInt(400))),
+			gasLimit:    100,
+			expPriority: 1500,
+		},
+		"disabled": {
+			setupStore: setupMinGasPrices,
+			config:     &types.TxPriorityConfig{Enabled: false, MaxPriority: 1500},
+			feeAmount:  sdk.NewCoins(sdk.NewCoin("ALX", sdk.NewInt(400))),
+			gasLimit:   100,
+		},
+		"zero gas": {
+			setupStore: setupMinGasPrices,
+			feeAmount:  sdk.NewCoins(sdk.NewCoin("ALX", sdk.NewInt(400))),
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, encCfg, keeper := setupTestKeeper(t)
+			spec.setupStore(ctx, keeper.paramSpace)
+			config := types.DefaultTxPriorityConfig()
+			if spec.config != nil {
+				config = *spec.config
+			}
+
+			txBuilder := encCfg.TxConfig.NewTxBuilder()
+			txBuilder.SetFeeAmount(spec.feeAmount)
+			txBuilder.SetGasLimit(spec.gasLimit)
+
+			// when
+			got := NewTxPrioritizer(keeper.paramSpace, keeper, config).TxPriority(ctx, txBuilder.GetTx())
+
+			// then
+			assert.Equal(t, spec.expPriority, got)
+		})
+	}
+}
@@ -4,18 +4,42 @@ import (
 	"context"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/confio/tgrade/x/globalfee/types"
 )
 
 var _ types.QueryServer = &Querier{}
 
+// DefaultEstimateGasAdjustment is applied to the simulated gas used when no gas adjustment is requested
+var DefaultEstimateGasAdjustment = sdk.NewDecWithPrec(13, 1)
+
+// SimulateFn simulates an encoded TX and returns the gas info
+type SimulateFn func(txBytes []byte) (sdk.GasInfo, *sdk.Result, error)
+
+// RateLimitSource provides the rate limit usage of accounts
+type RateLimitSource interface {
+	GetRateLimitUsage(ctx sdk.Context, addr sdk.AccAddress) types.RateLimitUsage
+	IsRateLimitExempt(ctx sdk.Context, addr sdk.AccAddress) bool
+}
+
 type Querier struct {
-	paramSource paramSource
+	paramSource     paramSource
+	feeSource       FeeSource
+	rateLimitSource RateLimitSource
+	txDecoder       sdk.TxDecoder
+	simulate        SimulateFn
 }
 
-func NewQuerier(paramSource paramSource) Querier {
-	return Querier{paramSource: paramSource}
+func NewQuerier(paramSource paramSource, feeSource FeeSource, rateLimitSource RateLimitSource, txDecoder sdk.TxDecoder, simulate SimulateFn) Querier {
+	return Querier{
+		paramSource:     paramSource,
+		feeSource:       feeSource,
+		rateLimitSource: rateLimitSource,
+		txDecoder:       txDecoder,
+		simulate:        simulate,
+	}
 }
 
 // MinimumGasPrices return minimum gas prices
@@ -29,3 +53,130 @@ func (g Querier) MinimumGasPrices(stdCtx context.Context, _ *types.QueryMinimumG
 		MinimumGasPrices: minGasPrices,
 	}, nil
 }
+
+// BypassMinFeeMsgTypes return the message types that are free of the global minimum fee
+func (g Querier) BypassMinFeeMsgTypes(stdCtx context.Context, _ *types.QueryBypassMinFeeMsgTypesRequest) (*types.QueryBypassMinFeeMsgTypesResponse, error) {
+	var rsp types.QueryBypassMinFeeMsgTypesResponse
+	ctx := sdk.UnwrapSDKContext(stdCtx)
+	if g.paramSource.Has(ctx, types.ParamStoreKeyBypassMinFeeMsgTypes) {
+		g.paramSource.Get(ctx, types.ParamStoreKeyBypassMinFeeMsgTypes, &rsp.BypassMinFeeMsgTypes)
+	}
+	if g.paramSource.Has(ctx, types.ParamStoreKeyMaxTotalBypassMinFeeMsgGasUsage) {
+		g.paramSource.Get(ctx, types.ParamStoreKeyMaxTotalBypassMinFeeMsgGasUsage, &rsp.MaxTotalBypassMinFeeMsgGasUsage)
+	}
+	return &rsp, nil
+}
+
+// BaseGasPrice return the current dynamic base gas price
+func (g Querier) BaseGasPrice(stdCtx context.Context, _ *types.QueryBaseGasPriceRequest) (*types.QueryBaseGasPriceResponse, error) {
+	var rsp types.QueryBaseGasPriceResponse
+	if price, ok := g.feeSource.GetBaseGasPrice(sdk.UnwrapSDKContext(stdCtx)); ok {
+		rsp.BaseGasPrice = &price
+	}
+	return &rsp, nil
+}
+
+// EstimateFee returns the fees required by the global fee rules. The gas used is simulated when a TX is given and
+// the engagement discount of its fee payer is applied. As the fees are checked against the gas limit of a TX, the
+// simulated gas is multiplied with the gas adjustment and returned as gas limit to submit.
+func (g Querier) EstimateFee(stdCtx context.Context, req *types.QueryEstimateFeeRequest) (*types.QueryEstimateFeeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(stdCtx)
+	gas, msgs, feePayer := req.Gas, []sdk.Msg(nil), sdk.AccAddress(nil)
+	switch {
+	case len(req.TxBytes) != 0:
+		tx, err := g.txDecoder(req.TxBytes)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		gasInfo, _, err := g.simulate(req.TxBytes)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		adjustment := DefaultEstimateGasAdjustment
+		if !req.GasAdjustment.IsNil() && !req.GasAdjustment.IsZero() {
+			adjustment = req.GasAdjustment
+		}
+		if adjustment.LT(sdk.OneDec()) {
+			return nil, status.Error(codes.InvalidArgument, "gas adjustment must not be less than 1")
+		}
+		gas = adjustment.MulInt64(int64(gasInfo.GasUsed)).Ceil().TruncateInt().Uint64()
+		msgs = tx.GetMsgs()
+		if feeTx, ok := tx.(sdk.FeeTx); ok {
+			feePayer = feeTx.FeePayer()
+		}
+	case req.Gas == 0:
+		return nil, status.Error(codes.InvalidArgument, "tx bytes or gas required")
+	}
+	return &types.QueryEstimateFeeResponse{
+		Gas:  gas,
+		Fees: withPriceTableFees(ctx, g.paramSource, requiredFees(ctx, g.paramSource, g.feeSource, feePayer, msgs, gas)),
+	}, nil
+}
+
+// withPriceTableFees adds the required fee in the base denom converted to the denoms of the fee price table
+func withPriceTableFees(ctx sdk.Context, paramSource paramSource, requiredFees sdk.Coins) sdk.Coins {
+	table, ok := feePriceTable(ctx, paramSource)
+	if !ok {
+		return requiredFees
+	}
+	required := requiredFees.AmountOf(table.BaseDenom)
+	if !required.IsPositive() {
+		return requiredFees
+	}
+	r := requiredFees
+	for _, p := range table.Prices {
+		if requiredFees.AmountOf(p.Denom).IsPositive() {
+			continue
+		}
+		r = r.Add(sdk.NewCoin(p.Denom, required.ToDec().Quo(p.Price).Ceil().TruncateInt()))
+	}
+	return r
+}
+
+// EffectiveMinGasPrices returns the minimum gas prices for the fee payer after the engagement discount
+func (g Querier) EffectiveMinGasPrices(stdCtx context.Context, req *types.QueryEffectiveMinGasPricesRequest) (*types.QueryEffectiveMinGasPricesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	feePayer, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	minGasPrices, multiplier := effectiveMinGasPrices(sdk.UnwrapSDKContext(stdCtx), g.paramSource, g.feeSource, feePayer)
+	return &types.QueryEffectiveMinGasPricesResponse{
+		MinimumGasPrices:   minGasPrices,
+		GasPriceMultiplier: multiplier,
+	}, nil
+}
+
+// FeePriceTable returns the fixed prices of accepted fee denoms in the base denom
+func (g Querier) FeePriceTable(stdCtx context.Context, _ *types.QueryFeePriceTableRequest) (*types.QueryFeePriceTableResponse, error) {
+	var rsp types.QueryFeePriceTableResponse
+	ctx := sdk.UnwrapSDKContext(stdCtx)
+	if g.paramSource.Has(ctx, types.ParamStoreKeyFeePriceTable) {
+		g.paramSource.Get(ctx, types.ParamStoreKeyFeePriceTable, &rsp.FeePriceTable)
+	}
+	return &rsp, nil
+}
+
+// RateLimitUsage returns the TXs and gas of an account within the current rate limit window
+func (g Querier) RateLimitUsage(stdCtx context.Context, req *types.QueryRateLimitUsageRequest) (*types.QueryRateLimitUsageResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(stdCtx)
+	var rsp types.QueryRateLimitUsageResponse
+	if g.paramSource.Has(ctx, types.ParamStoreKeyRateLimit) {
+		g.paramSource.Get(ctx, types.ParamStoreKeyRateLimit, &rsp.Limits)
+	}
+	rsp.Usage = g.rateLimitSource.GetRateLimitUsage(ctx, addr)
+	rsp.Exempt = g.rateLimitSource.IsRateLimitExempt(ctx, addr)
+	return &rsp, nil
+}
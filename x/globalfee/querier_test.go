@@ -4,9 +4,14 @@ import (
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/rand"
 
 	"github.com/confio/tgrade/x/globalfee/types"
 )
@@ -44,9 +49,9 @@ func TestQueryMinimumGasPrices(t *testing.T) {
 	}
 	for name, spec := range specs {
 		t.Run(name, func(t *testing.T) {
-			ctx, _, subspace := setupTestStore(t)
-			spec.setupStore(ctx, subspace)
-			q := NewQuerier(subspace)
+			ctx, _, keeper := setupTestKeeper(t)
+			spec.setupStore(ctx, keeper.paramSpace)
+			q := NewQuerier(keeper.paramSpace, keeper, keeper, nil, nil)
 			gotResp, gotErr := q.MinimumGasPrices(sdk.WrapSDKContext(ctx), nil)
 			require.NoError(t, gotErr)
 			require.NotNil(t, gotResp)
@@ -54,3 +59,366 @@ func TestQueryMinimumGasPrices(t *testing.T) {
 		})
 	}
 }
+
+func TestQueryBypassMinFeeMsgTypes(t *testing.T) {
+	specs := map[string]struct {
+		setupStore func(ctx sdk.Context, s paramtypes.Subspace)
+		exp        types.QueryBypassMinFeeMsgTypesResponse
+	}{
+		"msg types set": {
+			setupStore: func(ctx sdk.Context, s paramtypes.Subspace) {
+				s.SetParamSet(ctx, &types.Params{
+					BypassMinFeeMsgTypes:            []string{"/ibc.core.channel.v1.MsgRecvPacket"},
+					MaxTotalBypassMinFeeMsgGasUsage: 1,
+				})
+			},
+			exp: types.QueryBypassMinFeeMsgTypesResponse{
+				BypassMinFeeMsgTypes:            []string{"/ibc.core.channel.v1.MsgRecvPacket"},
+				MaxTotalBypassMinFeeMsgGasUsage: 1,
+			},
+		},
+		"no msg types set": {
+			setupStore: func(ctx sdk.Context, s paramtypes.Subspace) {
+				s.SetParamSet(ctx, &types.Params{})
+			},
+		},
+		"no param set": {
+			setupStore: func(ctx sdk.Context, s paramtypes.Subspace) {
+			},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _, keeper := setupTestKeeper(t)
+			spec.setupStore(ctx, keeper.paramSpace)
+			q := NewQuerier(keeper.paramSpace, keeper, keeper, nil, nil)
+			gotResp, gotErr := q.BypassMinFeeMsgTypes(sdk.WrapSDKContext(ctx), nil)
+			require.NoError(t, gotErr)
+			require.NotNil(t, gotResp)
+			assert.Equal(t, spec.exp, *gotResp)
+		})
+	}
+}
+
+func TestQueryBaseGasPrice(t *testing.T) {
+	specs := map[string]struct {
+		setup func(ctx sdk.Context, k Keeper)
+		exp   *sdk.DecCoin
+	}{
+		"enabled": {
+			setup: func(ctx sdk.Context, k Keeper) {
+				k.paramSpace.Set(ctx, types.ParamStoreKeyDynamicBaseFee, types.DynamicBaseFeeParams{
+					Enabled:         true,
+					Denom:           "ALX",
+					MinBaseGasPrice: sdk.OneDec(),
+					MaxBaseGasPrice: sdk.NewDec(10),
+					TargetBlockGas:  1,
+					MaxChangeRate:   sdk.OneDec(),
+				})
+				k.setBaseGasPrice(ctx, sdk.NewDec(2))
+			},
+			exp: &sdk.DecCoin{Denom: "ALX", Amount: sdk.NewDec(2)},
+		},
+		"disabled": {
+			setup: func(ctx sdk.Context, k Keeper) {
+				k.paramSpace.Set(ctx, types.ParamStoreKeyDynamicBaseFee, types.DefaultDynamicBaseFeeParams())
+				k.setBaseGasPrice(ctx, sdk.NewDec(2))
+			},
+		},
+		"no param set": {
+			setup: func(ctx sdk.Context, k Keeper) {},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _, keeper := setupTestKeeper(t)
+			spec.setup(ctx, keeper)
+			q := NewQuerier(keeper.paramSpace, keeper, keeper, nil, nil)
+			gotResp, gotErr := q.BaseGasPrice(sdk.WrapSDKContext(ctx), nil)
+			require.NoError(t, gotErr)
+			require.NotNil(t, gotResp)
+			assert.Equal(t, spec.exp, gotResp.BaseGasPrice)
+		})
+	}
+}
+
+func TestQueryEstimateFee(t *testing.T) {
+	ctx, encCfg, keeper := setupTestKeeper(t)
+	setupBypassParams(ctx, keeper.paramSpace)
+	channeltypes.RegisterInterfaces(encCfg.InterfaceRegistry)
+
+	feePayer := sdk.AccAddress(rand.Bytes(address.Len))
+	encodeTx := func(t *testing.T, msgs ...sdk.Msg) []byte {
+		txBuilder := encCfg.TxConfig.NewTxBuilder()
+		require.NoError(t, txBuilder.SetMsgs(msgs...))
+		setFeePayer(txBuilder, feePayer)
+		bz, err := encCfg.TxConfig.TxEncoder()(txBuilder.GetTx())
+		require.NoError(t, err)
+		return bz
+	}
+	simulateGas := func(gas uint64) SimulateFn {
+		return func(txBytes []byte) (sdk.GasInfo, *sdk.Result, error) {
+			return sdk.GasInfo{GasUsed: gas}, &sdk.Result{}, nil
+		}
+	}
+	specs := map[string]struct {
+		req      *types.QueryEstimateFeeRequest
+		simulate SimulateFn
+		exp      *types.QueryEstimateFeeResponse
+		expErr   bool
+	}{
+		"gas only": {
+			req: &types.QueryEstimateFeeRequest{Gas: 100},
+			exp: &types.QueryEstimateFeeResponse{Gas: 100, Fees: sdk.NewCoins(sdk.NewCoin("ALX", sdk.NewInt(100)))},
+		},
+		"tx simulated": {
+			req:      &types.QueryEstimateFeeRequest{TxBytes: encodeTx(t, &banktypes.MsgSend{})},
+			simulate: simulateGas(200),
+			exp:      &types.QueryEstimateFeeResponse{Gas: 260, Fees: sdk.NewCoins(sdk.NewCoin("ALX", sdk.NewInt(260)))},
+		},
+		"tx simulated with gas adjustment": {
+			req:      &types.QueryEstimateFeeRequest{TxBytes: encodeTx(t, &banktypes.MsgSend{}), GasAdjustment: sdk.NewDecWithPrec(15, 1)},
+			simulate: simulateGas(201),
+			exp:      &types.QueryEstimateFeeResponse{Gas: 302, Fees: sdk.NewCoins(sdk.NewCoin("ALX", sdk.NewInt(302)))},
+		},
+		"tx simulated without gas adjustment": {
+			req:      &types.QueryEstimateFeeRequest{TxBytes: encodeTx(t, &banktypes.MsgSend{}), GasAdjustment: sdk.OneDec()},
+			simulate: simulateGas(200),
+			exp:      &types.QueryEstimateFeeResponse{Gas: 200, Fees: sdk.NewCoins(sdk.NewCoin("ALX", sdk.NewInt(200)))},
+		},
+		"gas adjustment less than 1": {
+			req:      &types.QueryEstimateFeeRequest{TxBytes: encodeTx(t, &banktypes.MsgSend{}), GasAdjustment: sdk.NewDecWithPrec(9, 1)},
+			simulate: simulateGas(200),
+			expErr:   true,
+		},
+		"tx gas takes precedence": {
+			req:      &types.QueryEstimateFeeRequest{TxBytes: encodeTx(t, &banktypes.MsgSend{}), Gas: 100},
+			simulate: simulateGas(200),
+			exp:      &types.QueryEstimateFeeResponse{Gas: 260, Fees: sdk.NewCoins(sdk.NewCoin("ALX", sdk.NewInt(260)))},
+		},
+		"gas adjustment not applied to requested gas": {
+			req: &types.QueryEstimateFeeRequest{Gas: 100, GasAdjustment: sdk.NewDecWithPrec(15, 1)},
+			exp: &types.QueryEstimateFeeResponse{Gas: 100, Fees: sdk.NewCoins(sdk.NewCoin("ALX", sdk.NewInt(100)))},
+		},
+		"tx with bypass msg types only": {
+			req:      &types.QueryEstimateFeeRequest{TxBytes: encodeTx(t, &channeltypes.MsgRecvPacket{})},
+			simulate: simulateGas(200),
+			exp:      &types.QueryEstimateFeeResponse{Gas: 260},
+		},
+		"tx with bypass msg types above gas limit": {
+			req:      &types.QueryEstimateFeeRequest{TxBytes: encodeTx(t, &channeltypes.MsgRecvPacket{})},
+			simulate: simulateGas(770),
+			exp:      &types.QueryEstimateFeeResponse{Gas: 1_001, Fees: sdk.NewCoins(sdk.NewCoin("ALX", sdk.NewInt(1_001)))},
+		},
+		"simulation fails": {
+			req: &types.QueryEstimateFeeRequest{TxBytes: encodeTx(t, &banktypes.MsgSend{})},
+			simulate: func(txBytes []byte) (sdk.GasInfo, *sdk.Result, error) {
+				return sdk.GasInfo{}, nil, sdkerrors.ErrOutOfGas
+			},
+			expErr: true,
+		},
+		"invalid tx bytes": {
+			req:    &types.QueryEstimateFeeRequest{TxBytes: []byte("invalid")},
+			expErr: true,
+		},
+		"neither tx nor gas": {
+			req:    &types.QueryEstimateFeeRequest{},
+			expErr: true,
+		},
+		"nil request": {
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			q := NewQuerier(keeper.paramSpace, keeper, keeper, encCfg.TxConfig.TxDecoder(), spec.simulate)
+			gotResp, gotErr := q.EstimateFee(sdk.WrapSDKContext(ctx), spec.req)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.exp, gotResp)
+		})
+	}
+}
+
+func TestQueryEstimateFeeWithFeePriceTable(t *testing.T) {
+	ctx, _, keeper := setupTestKeeper(t)
+	setupFeePriceTableParams(ctx, keeper.paramSpace)
+	q := NewQuerier(keeper.paramSpace, keeper, keeper, nil, nil)
+
+	gotResp, gotErr := q.EstimateFee(sdk.WrapSDKContext(ctx), &types.QueryEstimateFeeRequest{Gas: 101})
+	require.NoError(t, gotErr)
+	exp := sdk.NewCoins(sdk.NewCoin("ALX", sdk.NewInt(101)), sdk.NewCoin("BLX", sdk.NewInt(202)), sdk.NewCoin("CLX", sdk.NewInt(26)))
+	assert.Equal(t, exp, gotResp.Fees)
+}
+
+func TestQueryFeePriceTable(t *testing.T) {
+	specs := map[string]struct {
+		setupStore func(ctx sdk.Context, s paramtypes.Subspace)
+		exp        types.FeePriceTable
+	}{
+		"with prices": {
+			setupStore: setupFeePriceTableParams,
+			exp: types.FeePriceTable{
+				BaseDenom: "ALX",
+				Prices: []types.DenomPrice{
+					{Denom: "BLX", Price: sdk.NewDecWithPrec(5, 1)},
+					{Denom: "CLX", Price: sdk.NewDec(4)},
+				},
+			},
+		},
+		"no param set": {
+			setupStore: func(ctx sdk.Context, s paramtypes.Subspace) {},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _, keeper := setupTestKeeper(t)
+			spec.setupStore(ctx, keeper.paramSpace)
+			q := NewQuerier(keeper.paramSpace, keeper, keeper, nil, nil)
+			gotResp, gotErr := q.FeePriceTable(sdk.WrapSDKContext(ctx), nil)
+			require.NoError(t, gotErr)
+			require.NotNil(t, gotResp)
+			assert.Equal(t, spec.exp, gotResp.FeePriceTable)
+		})
+	}
+}
+
+func TestQueryEffectiveMinGasPrices(t *testing.T) {
+	myAddr := sdk.AccAddress(rand.Bytes(address.Len))
+	specs := map[string]struct {
+		setupStore func(ctx sdk.Context, s paramtypes.Subspace)
+		points     uint64
+		req        *types.QueryEffectiveMinGasPricesRequest
+		exp        *types.QueryEffectiveMinGasPricesResponse
+		expErr     bool
+	}{
+		"discounted": {
+			setupStore: setupEngagementDiscountParams(sdk.NewDecWithPrec(5, 1)),
+			points:     10,
+			req:        &types.QueryEffectiveMinGasPricesRequest{Address: myAddr.String()},
+			exp: &types.QueryEffectiveMinGasPricesResponse{
+				MinimumGasPrices:   sdk.NewDecCoins(sdk.NewDecCoin("ALX", sdk.OneInt())),
+				GasPriceMultiplier: sdk.NewDecWithPrec(5, 1),
+			},
+		},
+		"not discounted": {
+			setupStore: setupEngagementDiscountParams(sdk.NewDecWithPrec(5, 1)),
+			req:        &types.QueryEffectiveMinGasPricesRequest{Address: myAddr.String()},
+			exp: &types.QueryEffectiveMinGasPricesResponse{
+				MinimumGasPrices:   sdk.NewDecCoins(sdk.NewDecCoin("ALX", sdk.NewInt(2))),
+				GasPriceMultiplier: sdk.OneDec(),
+			},
+		},
+		"with dynamic base fee": {
+			setupStore: func(ctx sdk.Context, s paramtypes.Subspace) {
+				setupDynamicBaseFeeParams(sdk.NewDec(4))(ctx, s)
+				s.Set(ctx, types.ParamStoreKeyEngagementDiscountTiers, []types.EngagementDiscountTier{{MinPoints: 10, GasPriceMultiplier: sdk.NewDecWithPrec(5, 1)}})
+			},
+			points: 10,
+			req:    &types.QueryEffectiveMinGasPricesRequest{Address: myAddr.String()},
+			exp: &types.QueryEffectiveMinGasPricesResponse{
+				MinimumGasPrices:   sdk.NewDecCoins(sdk.NewDecCoin("ALX", sdk.NewInt(2))),
+				GasPriceMultiplier: sdk.NewDecWithPrec(5, 1),
+			},
+		},
+		"invalid address": {
+			setupStore: setupEngagementDiscountParams(sdk.NewDecWithPrec(5, 1)),
+			req:        &types.QueryEffectiveMinGasPricesRequest{Address: "invalid"},
+			expErr:     true,
+		},
+		"nil request": {
+			setupStore: setupEngagementDiscountParams(sdk.NewDecWithPrec(5, 1)),
+			expErr:     true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _, keeper := setupTestKeeper(t)
+			keeper.engagementSource = EngagementPointsSourceMock{
+				GetEngagementPointsFn: func(ctx sdk.Context, addr sdk.AccAddress) (uint64, error) { return spec.points, nil },
+			}
+			spec.setupStore(ctx, keeper.paramSpace)
+			q := NewQuerier(keeper.paramSpace, keeper, keeper, nil, nil)
+			gotResp, gotErr := q.EffectiveMinGasPrices(sdk.WrapSDKContext(ctx), spec.req)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.exp.MinimumGasPrices.String(), gotResp.MinimumGasPrices.String())
+			assert.Equal(t, spec.exp.GasPriceMultiplier.String(), gotResp.GasPriceMultiplier.String())
+		})
+	}
+}
+
+func TestQueryRateLimitUsage(t *testing.T) {
+	myAddr := sdk.AccAddress(rand.Bytes(address.Len))
+	limits := types.RateLimitParams{WindowBlocks: 10, MaxTxs: 5, MaxGas: 1000}
+	specs := map[string]struct {
+		setupStore func(ctx sdk.Context, keeper Keeper)
+		exempt     bool
+		req        *types.QueryRateLimitUsageRequest
+		exp        *types.QueryRateLimitUsageResponse
+		expErr     bool
+	}{
+		"with usage": {
+			setupStore: func(ctx sdk.Context, keeper Keeper) {
+				keeper.paramSpace.Set(ctx, types.ParamStoreKeyRateLimit, limits)
+				require.NoError(t, keeper.ConsumeRateLimit(ctx, myAddr, 100))
+			},
+			req: &types.QueryRateLimitUsageRequest{Address: myAddr.String()},
+			exp: &types.QueryRateLimitUsageResponse{
+				Usage:  types.RateLimitUsage{WindowStart: 1234560, Txs: 1, Gas: 100},
+				Limits: limits,
+			},
+		},
+		"exempt": {
+			setupStore: func(ctx sdk.Context, keeper Keeper) {
+				keeper.paramSpace.Set(ctx, types.ParamStoreKeyRateLimit, limits)
+			},
+			exempt: true,
+			req:    &types.QueryRateLimitUsageRequest{Address: myAddr.String()},
+			exp: &types.QueryRateLimitUsageResponse{
+				Usage:  types.RateLimitUsage{WindowStart: 1234560},
+				Limits: limits,
+				Exempt: true,
+			},
+		},
+		"params not set": {
+			setupStore: func(ctx sdk.Context, keeper Keeper) {},
+			req:        &types.QueryRateLimitUsageRequest{Address: myAddr.String()},
+			exp: &types.QueryRateLimitUsageResponse{
+				Usage: types.RateLimitUsage{WindowStart: 1234567},
+			},
+		},
+		"invalid address": {
+			setupStore: func(ctx sdk.Context, keeper Keeper) {},
+			req:        &types.QueryRateLimitUsageRequest{Address: "invalid"},
+			expErr:     true,
+		},
+		"nil request": {
+			setupStore: func(ctx sdk.Context, keeper Keeper) {},
+			expErr:     true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _, keeper := setupTestKeeper(t)
+			keeper.validatorSource = ValidatorOperatorSourceMock{
+				IsValidatorOperatorFn: func(ctx sdk.Context, addr sdk.AccAddress) bool { return spec.exempt },
+			}
+			spec.setupStore(ctx, keeper)
+			q := NewQuerier(keeper.paramSpace, keeper, keeper, nil, nil)
+			gotResp, gotErr := q.RateLimitUsage(sdk.WrapSDKContext(ctx), spec.req)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.exp, gotResp)
+		})
+	}
+}
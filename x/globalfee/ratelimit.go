@@ -0,0 +1,132 @@
+package globalfee
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/confio/tgrade/x/globalfee/types"
+)
+
+// RateLimiter tracks the TXs and gas per account
+type RateLimiter interface {
+	// ConsumeRateLimit adds a TX with the given gas to the usage of the account
+	ConsumeRateLimit(ctx sdk.Context, addr sdk.AccAddress, gas uint64) error
+}
+
+var _ sdk.AnteDecorator = RateLimitDecorator{}
+
+// RateLimitDecorator Ante decorator that rejects TXs of a fee payer that exceeds the max TXs or gas within the
+// rate limit window. The usage is not tracked in simulations and for re-checks of mempool TXs.
+type RateLimitDecorator struct {
+	rateLimiter RateLimiter
+}
+
+// NewRateLimitDecorator constructor
+func NewRateLimitDecorator(rateLimiter RateLimiter) RateLimitDecorator {
+	return RateLimitDecorator{rateLimiter: rateLimiter}
+}
+
+// AnteHandle method that performs custom pre- and post-processing.
+func (d RateLimitDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	if simulate || ctx.IsReCheckTx() {
+		return next(ctx, tx, simulate)
+	}
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "tx must be a sdk FeeTx")
+	}
+	if err := d.rateLimiter.ConsumeRateLimit(ctx, feeTx.FeePayer(), feeTx.GetGas()); err != nil {
+		return ctx, err
+	}
+	return next(ctx, tx, simulate)
+}
+
+// ConsumeRateLimit adds a TX with the given gas to the usage of the account in the current window.
+// Returns ErrRateLimitExceeded when a limit is exceeded and the account is not exempt.
+func (k Keeper) ConsumeRateLimit(ctx sdk.Context, addr sdk.AccAddress, gas uint64) error {
+	p, ok := k.rateLimitParams(ctx)
+	if !ok || !p.Enabled() {
+		return nil
+	}
+	usage := k.getRateLimitUsage(ctx, p, addr)
+	usage.Txs++
+	if usage.Gas += gas; usage.Gas < gas { // overflow
+		usage.Gas = ^uint64(0)
+	}
+	if p.Exceeded(usage) {
+		if k.IsRateLimitExempt(ctx, addr) {
+			return nil
+		}
+		return sdkerrors.Wrapf(types.ErrRateLimitExceeded, "txs: %d of %d, gas: %d of %d in window starting at %d",
+			usage.Txs, p.MaxTxs, usage.Gas, p.MaxGas, usage.WindowStart)
+	}
+	bz, err := usage.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	k.rateLimitStore(ctx).Set(address.MustLengthPrefix(addr), bz)
+	return nil
+}
+
+// GetRateLimitUsage returns the TXs and gas of the account in the current window
+func (k Keeper) GetRateLimitUsage(ctx sdk.Context, addr sdk.AccAddress) types.RateLimitUsage {
+	p, _ := k.rateLimitParams(ctx)
+	return k.getRateLimitUsage(ctx, p, addr)
+}
+
+// IsRateLimitExempt returns true for validator operators. The usage is tracked per fee payer, which is always a
+// signer of the TX, so contracts and the accounts that pay the fees for the fee payer are not considered.
+func (k Keeper) IsRateLimitExempt(ctx sdk.Context, addr sdk.AccAddress) bool {
+	return k.validatorSource.IsValidatorOperator(ctx, addr)
+}
+
+// PruneRateLimitUsage deletes the usage of all accounts when the current block is the last of a window
+// or the rate limit is disabled
+func (k Keeper) PruneRateLimitUsage(ctx sdk.Context) {
+	p, ok := k.rateLimitParams(ctx)
+	if ok && p.Enabled() && p.WindowStart(ctx.BlockHeight()+1) == p.WindowStart(ctx.BlockHeight()) {
+		return
+	}
+	store := k.rateLimitStore(ctx)
+	iter := store.Iterator(nil, nil)
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// getRateLimitUsage returns the stored usage or an empty one when stored for a previous window
+func (k Keeper) getRateLimitUsage(ctx sdk.Context, p types.RateLimitParams, addr sdk.AccAddress) types.RateLimitUsage {
+	usage := types.RateLimitUsage{WindowStart: p.WindowStart(ctx.BlockHeight())}
+	bz := k.rateLimitStore(ctx).Get(address.MustLengthPrefix(addr))
+	if bz == nil {
+		return usage
+	}
+	var stored types.RateLimitUsage
+	if err := stored.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	if stored.WindowStart != usage.WindowStart {
+		return usage
+	}
+	return stored
+}
+
+func (k Keeper) rateLimitStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.RateLimitUsagePrefix)
+}
+
+func (k Keeper) rateLimitParams(ctx sdk.Context) (types.RateLimitParams, bool) {
+	var p types.RateLimitParams
+	if !k.paramSpace.Has(ctx, types.ParamStoreKeyRateLimit) {
+		return p, false
+	}
+	k.paramSpace.Get(ctx, types.ParamStoreKeyRateLimit, &p)
+	return p, true
+}
@@ -0,0 +1,187 @@
+package globalfee
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/rand"
+
+	"github.com/confio/tgrade/x/globalfee/types"
+)
+
+func TestRateLimitDecorator(t *testing.T) {
+	myAddr := sdk.AccAddress(rand.Bytes(address.Len))
+	specs := map[string]struct {
+		limits     types.RateLimitParams
+		usage      *types.RateLimitUsage
+		gasLimit   sdk.Gas
+		exempt     bool
+		recheck    bool
+		simulation bool
+		expErr     *sdkerrors.Error
+		expUsage   types.RateLimitUsage
+	}{
+		"first tx in window": {
+			limits:   types.RateLimitParams{WindowBlocks: 10, MaxTxs: 2, MaxGas: 1000},
+			gasLimit: 100,
+			expUsage: types.RateLimitUsage{WindowStart: 1234560, Txs: 1, Gas: 100},
+		},
+		"max txs reached": {
+			limits:   types.RateLimitParams{WindowBlocks: 10, MaxTxs: 2},
+			usage:    &types.RateLimitUsage{WindowStart: 1234560, Txs: 1, Gas: 100},
+			gasLimit: 100,
+			expUsage: types.RateLimitUsage{WindowStart: 1234560, Txs: 2, Gas: 200},
+		},
+		"max txs exceeded": {
+			limits:   types.RateLimitParams{WindowBlocks: 10, MaxTxs: 2},
+			usage:    &types.RateLimitUsage{WindowStart: 1234560, Txs: 2, Gas: 200},
+			gasLimit: 100,
+			expErr:   types.ErrRateLimitExceeded,
+			expUsage: types.RateLimitUsage{WindowStart: 1234560, Txs: 2, Gas: 200},
+		},
+		"max gas exceeded": {
+			limits:   types.RateLimitParams{WindowBlocks: 10, MaxGas: 1000},
+			usage:    &types.RateLimitUsage{WindowStart: 1234560, Txs: 1, Gas: 950},
+			gasLimit: 100,
+			expErr:   types.ErrRateLimitExceeded,
+			expUsage: types.RateLimitUsage{WindowStart: 1234560, Txs: 1, Gas: 950},
+		},
+		"usage of previous window reset": {
+			limits:   types.RateLimitParams{WindowBlocks: 10, MaxTxs: 2},
+			usage:    &types.RateLimitUsage{WindowStart: 1234550, Txs: 2, Gas: 200},
+			gasLimit: 100,
+			expUsage: types.RateLimitUsage{WindowStart: 1234560, Txs: 1, Gas: 100},
+		},
+		"exempt account": {
+			limits:   types.RateLimitParams{WindowBlocks: 10, MaxTxs: 2},
+			usage:    &types.RateLimitUsage{WindowStart: 1234560, Txs: 2, Gas: 200},
+			gasLimit: 100,
+			exempt:   true,
+			expUsage: types.RateLimitUsage{WindowStart: 1234560, Txs: 2, Gas: 200},
+		},
+		"disabled": {
+			limits:   types.DefaultRateLimitParams(),
+			gasLimit: 100,
+			expUsage: types.RateLimitUsage{WindowStart: 1234560},
+		},
+		"recheck not tracked": {
+			limits:   types.RateLimitParams{WindowBlocks: 10, MaxTxs: 2},
+			usage:    &types.RateLimitUsage{WindowStart: 1234560, Txs: 2, Gas: 200},
+			gasLimit: 100,
+			recheck:  true,
+			expUsage: types.RateLimitUsage{WindowStart: 1234560, Txs: 2, Gas: 200},
+		},
+		"simulation not tracked": {
+			limits:     types.RateLimitParams{WindowBlocks: 10, MaxTxs: 2},
+			usage:      &types.RateLimitUsage{WindowStart: 1234560, Txs: 2, Gas: 200},
+			gasLimit:   100,
+			simulation: true,
+			expUsage:   types.RateLimitUsage{WindowStart: 1234560, Txs: 2, Gas: 200},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, encCfg, keeper := setupTestKeeper(t)
+			ctx = ctx.WithIsReCheckTx(spec.recheck)
+			keeper.paramSpace.Set(ctx, types.ParamStoreKeyRateLimit, spec.limits)
+			keeper.validatorSource = ValidatorOperatorSourceMock{
+				IsValidatorOperatorFn: func(ctx sdk.Context, addr sdk.AccAddress) bool { return spec.exempt },
+			}
+			if spec.usage != nil {
+				bz, err := spec.usage.Marshal()
+				require.NoError(t, err)
+				keeper.rateLimitStore(ctx).Set(address.MustLengthPrefix(myAddr), bz)
+			}
+
+			txBuilder := encCfg.TxConfig.NewTxBuilder()
+			txBuilder.SetGasLimit(spec.gasLimit)
+			setFeePayer(txBuilder, myAddr)
+			tx := txBuilder.GetTx()
+			captured := &CapturingAnteHandler{}
+			anteHandler := sdk.ChainAnteDecorators(
+				NewRateLimitDecorator(keeper),
+				captured,
+			)
+
+			// when
+			_, gotErr := anteHandler(ctx, tx, spec.simulation)
+
+			// then
+			assert.Equal(t, spec.expUsage, keeper.GetRateLimitUsage(ctx, myAddr))
+			require.True(t, spec.expErr.Is(gotErr), "exp : %s but got %#+v", spec.expErr, gotErr)
+			if spec.expErr != nil {
+				require.Empty(t, captured.txs)
+				return
+			}
+			assert.Equal(t, []sdk.Tx{tx}, captured.txs)
+		})
+	}
+}
+
+func TestIsRateLimitExempt(t *testing.T) {
+	specs := map[string]struct {
+		validator bool
+		exp       bool
+	}{
+		"validator operator": {
+			validator: true,
+			exp:       true,
+		},
+		"other account": {},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _, keeper := setupTestKeeper(t)
+			keeper.validatorSource = ValidatorOperatorSourceMock{
+				IsValidatorOperatorFn: func(ctx sdk.Context, addr sdk.AccAddress) bool { return spec.validator },
+			}
+			got := keeper.IsRateLimitExempt(ctx, rand.Bytes(address.Len))
+			assert.Equal(t, spec.exp, got)
+		})
+	}
+}
+
+func TestPruneRateLimitUsage(t *testing.T) {
+	specs := map[string]struct {
+		height   int64
+		limits   types.RateLimitParams
+		expEmpty bool
+	}{
+		"last block of window": {
+			height:   1234569,
+			limits:   types.RateLimitParams{WindowBlocks: 10, MaxTxs: 2},
+			expEmpty: true,
+		},
+		"within window": {
+			height: 1234567,
+			limits: types.RateLimitParams{WindowBlocks: 10, MaxTxs: 2},
+		},
+		"disabled": {
+			height:   1234567,
+			limits:   types.DefaultRateLimitParams(),
+			expEmpty: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _, keeper := setupTestKeeper(t)
+			ctx = ctx.WithBlockHeight(spec.height)
+			keeper.paramSpace.Set(ctx, types.ParamStoreKeyRateLimit, types.RateLimitParams{WindowBlocks: 10, MaxTxs: 2})
+			myAddr := sdk.AccAddress(rand.Bytes(address.Len))
+			require.NoError(t, keeper.ConsumeRateLimit(ctx, myAddr, 100))
+			keeper.paramSpace.Set(ctx, types.ParamStoreKeyRateLimit, spec.limits)
+
+			// when
+			keeper.PruneRateLimitUsage(ctx)
+
+			// then
+			iter := keeper.rateLimitStore(ctx).Iterator(nil, nil)
+			defer iter.Close()
+			assert.Equal(t, !spec.expEmpty, iter.Valid())
+		})
+	}
+}
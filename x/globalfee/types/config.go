@@ -0,0 +1,19 @@
+package types
+
+import "math"
+
+// TxPriorityConfig node operator settings for the fee based tx priority in CheckTx
+type TxPriorityConfig struct {
+	// Enabled when set the priority is calculated from the gas price relative to the global minimum
+	Enabled bool
+	// MaxPriority is the upper bound for a tx priority
+	MaxPriority int64
+}
+
+// DefaultTxPriorityConfig returns the default settings
+func DefaultTxPriorityConfig() TxPriorityConfig {
+	return TxPriorityConfig{
+		Enabled:     true,
+		MaxPriority: math.MaxInt64,
+	}
+}
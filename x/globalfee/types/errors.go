@@ -0,0 +1,6 @@
+package types
+
+import sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+// ErrRateLimitExceeded is returned when an account exceeds the TXs or gas of the rate limit window
+var ErrRateLimitExceeded = sdkerrors.Register(ModuleName, 2, "rate limit exceeded")
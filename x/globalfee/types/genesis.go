@@ -0,0 +1,17 @@
+package types
+
+import (
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ValidateBasic performs basic validation.
+func (g GenesisState) ValidateBasic() error {
+	if err := g.Params.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "params")
+	}
+	if !g.BaseGasPrice.IsNil() && g.BaseGasPrice.IsNegative() {
+		return sdkerrors.Wrap(wasmtypes.ErrInvalid, "base gas price must not be negative")
+	}
+	return nil
+}
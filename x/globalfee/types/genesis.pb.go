@@ -34,6 +34,9 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	// Params of this module
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	// BaseGasPrice is the current dynamic base gas price in the denom of the
+	// dynamic base fee params
+	BaseGasPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=base_gas_price,json=baseGasPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"base_gas_price,omitempty" yaml:"base_gas_price"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -89,6 +92,28 @@ type Params struct {
 	// values allowed. For more information see
 	// https://docs.cosmos.network/master/modules/auth/01_concepts.html
 	MinimumGasPrices github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=minimum_gas_prices,json=minimumGasPrices,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"minimum_gas_prices,omitempty" yaml:"minimum_gas_prices"`
+	// BypassMinFeeMsgTypes defines a list of message type urls, e.g.
+	// "/ibc.core.channel.v1.MsgRecvPacket", that are free of the global minimum
+	// fee. A TX bypasses the global minimum fee only when all its messages are
+	// listed and the TX gas does not exceed MaxTotalBypassMinFeeMsgGasUsage.
+	BypassMinFeeMsgTypes []string `protobuf:"bytes,2,rep,name=bypass_min_fee_msg_types,json=bypassMinFeeMsgTypes,proto3" json:"bypass_min_fee_msg_types,omitempty" yaml:"bypass_min_fee_msg_types"`
+	// MaxTotalBypassMinFeeMsgGasUsage defines the total gas limit for a TX with
+	// bypass messages only
+	MaxTotalBypassMinFeeMsgGasUsage uint64 `protobuf:"varint,3,opt,name=max_total_bypass_min_fee_msg_gas_usage,json=maxTotalBypassMinFeeMsgGasUsage,proto3" json:"max_total_bypass_min_fee_msg_gas_usage,omitempty" yaml:"max_total_bypass_min_fee_msg_gas_usage"`
+	// DynamicBaseFee configures the optional EIP-1559 style base gas price
+	DynamicBaseFee DynamicBaseFeeParams `protobuf:"bytes,4,opt,name=dynamic_base_fee,json=dynamicBaseFee,proto3" json:"dynamic_base_fee" yaml:"dynamic_base_fee"`
+	// EngagementDiscountTiers map the engagement points of the fee payer to a
+	// multiplier of the minimum gas prices. The tiers must be sorted by min
+	// points asc. No discount is given when empty.
+	EngagementDiscountTiers []EngagementDiscountTier `protobuf:"bytes,5,rep,name=engagement_discount_tiers,json=engagementDiscountTiers,proto3" json:"engagement_discount_tiers,omitempty" yaml:"engagement_discount_tiers"`
+	// FeePriceTable converts accepted fee denoms, like IBC denoms, to the base
+	// denom at a fixed rate. Fees are accepted when their value is equivalent to
+	// the minimum fee in the base denom.
+	FeePriceTable FeePriceTable `protobuf:"bytes,6,opt,name=fee_price_table,json=feePriceTable,proto3" json:"fee_price_table" yaml:"fee_price_table"`
+	// RateLimit limits the TXs and gas per fee payer account within a window of
+	// blocks. Validator operators are exempt. The fee payer is always a signer,
+	// so fee sponsors and fee granters are not considered.
+	RateLimit RateLimitParams `protobuf:"bytes,7,opt,name=rate_limit,json=rateLimit,proto3" json:"rate_limit" yaml:"rate_limit"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -136,9 +161,450 @@ func (m *Params) GetMinimumGasPrices() github_com_cosmos_cosmos_sdk_types.DecCoi
 	return nil
 }
 
+func (m *Params) GetBypassMinFeeMsgTypes() []string {
+	if m != nil {
+		return m.BypassMinFeeMsgTypes
+	}
+	return nil
+}
+
+func (m *Params) GetMaxTotalBypassMinFeeMsgGasUsage() uint64 {
+	if m != nil {
+		return m.MaxTotalBypassMinFeeMsgGasUsage
+	}
+	return 0
+}
+
+func (m *Params) GetDynamicBaseFee() DynamicBaseFeeParams {
+	if m != nil {
+		return m.DynamicBaseFee
+	}
+	return DynamicBaseFeeParams{}
+}
+
+func (m *Params) GetEngagementDiscountTiers() []EngagementDiscountTier {
+	if m != nil {
+		return m.EngagementDiscountTiers
+	}
+	return nil
+}
+
+func (m *Params) GetFeePriceTable() FeePriceTable {
+	if m != nil {
+		return m.FeePriceTable
+	}
+	return FeePriceTable{}
+}
+
+func (m *Params) GetRateLimit() RateLimitParams {
+	if m != nil {
+		return m.RateLimit
+	}
+	return RateLimitParams{}
+}
+
+// RateLimitParams defines the per account limits within a window of blocks.
+// The rate limit is disabled when neither max TXs nor max gas are set.
+type RateLimitParams struct {
+	// WindowBlocks is the number of blocks in a rate limit window
+	WindowBlocks uint64 `protobuf:"varint,1,opt,name=window_blocks,json=windowBlocks,proto3" json:"window_blocks,omitempty" yaml:"window_blocks"`
+	// MaxTxs is the max number of TXs per account within a window. 0 for no
+	// limit.
+	MaxTxs uint64 `protobuf:"varint,2,opt,name=max_txs,json=maxTxs,proto3" json:"max_txs,omitempty" yaml:"max_txs"`
+	// MaxGas is the max total gas limit of the TXs per account within a window. 0
+	// for no limit.
+	MaxGas uint64 `protobuf:"varint,3,opt,name=max_gas,json=maxGas,proto3" json:"max_gas,omitempty" yaml:"max_gas"`
+}
+
+func (m *RateLimitParams) Reset()         { *m = RateLimitParams{} }
+func (m *RateLimitParams) String() string { return proto.CompactTextString(m) }
+func (*RateLimitParams) ProtoMessage()    {}
+func (*RateLimitParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e1fd18b564cbff8, []int{2}
+}
+
+func (m *RateLimitParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *RateLimitParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RateLimitParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *RateLimitParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimitParams.Merge(m, src)
+}
+
+func (m *RateLimitParams) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *RateLimitParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimitParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateLimitParams proto.InternalMessageInfo
+
+func (m *RateLimitParams) GetWindowBlocks() uint64 {
+	if m != nil {
+		return m.WindowBlocks
+	}
+	return 0
+}
+
+func (m *RateLimitParams) GetMaxTxs() uint64 {
+	if m != nil {
+		return m.MaxTxs
+	}
+	return 0
+}
+
+func (m *RateLimitParams) GetMaxGas() uint64 {
+	if m != nil {
+		return m.MaxGas
+	}
+	return 0
+}
+
+// RateLimitUsage defines the TXs and gas of an account within a window
+type RateLimitUsage struct {
+	// WindowStart is the first block height of the window
+	WindowStart int64 `protobuf:"varint,1,opt,name=window_start,json=windowStart,proto3" json:"window_start,omitempty" yaml:"window_start"`
+	// Txs is the number of TXs within the window
+	Txs uint64 `protobuf:"varint,2,opt,name=txs,proto3" json:"txs,omitempty"`
+	// Gas is the total gas limit of the TXs within the window
+	Gas uint64 `protobuf:"varint,3,opt,name=gas,proto3" json:"gas,omitempty"`
+}
+
+func (m *RateLimitUsage) Reset()         { *m = RateLimitUsage{} }
+func (m *RateLimitUsage) String() string { return proto.CompactTextString(m) }
+func (*RateLimitUsage) ProtoMessage()    {}
+func (*RateLimitUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e1fd18b564cbff8, []int{3}
+}
+
+func (m *RateLimitUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *RateLimitUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RateLimitUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *RateLimitUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimitUsage.Merge(m, src)
+}
+
+func (m *RateLimitUsage) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *RateLimitUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimitUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateLimitUsage proto.InternalMessageInfo
+
+func (m *RateLimitUsage) GetWindowStart() int64 {
+	if m != nil {
+		return m.WindowStart
+	}
+	return 0
+}
+
+func (m *RateLimitUsage) GetTxs() uint64 {
+	if m != nil {
+		return m.Txs
+	}
+	return 0
+}
+
+func (m *RateLimitUsage) GetGas() uint64 {
+	if m != nil {
+		return m.Gas
+	}
+	return 0
+}
+
+// FeePriceTable defines the fixed prices of accepted fee denoms in the base
+// denom. The table is not applied when the minimum gas prices have no entry
+// for the base denom.
+type FeePriceTable struct {
+	// BaseDenom is the denom that the prices are quoted in
+	BaseDenom string `protobuf:"bytes,1,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty" yaml:"base_denom"`
+	// Prices of the accepted fee denoms. The list must be sorted by denom asc.
+	Prices []DenomPrice `protobuf:"bytes,2,rep,name=prices,proto3" json:"prices,omitempty"`
+}
+
+func (m *FeePriceTable) Reset()         { *m = FeePriceTable{} }
+func (m *FeePriceTable) String() string { return proto.CompactTextString(m) }
+func (*FeePriceTable) ProtoMessage()    {}
+func (*FeePriceTable) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e1fd18b564cbff8, []int{4}
+}
+
+func (m *FeePriceTable) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *FeePriceTable) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeePriceTable.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *FeePriceTable) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeePriceTable.Merge(m, src)
+}
+
+func (m *FeePriceTable) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *FeePriceTable) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeePriceTable.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeePriceTable proto.InternalMessageInfo
+
+func (m *FeePriceTable) GetBaseDenom() string {
+	if m != nil {
+		return m.BaseDenom
+	}
+	return ""
+}
+
+func (m *FeePriceTable) GetPrices() []DenomPrice {
+	if m != nil {
+		return m.Prices
+	}
+	return nil
+}
+
+// DenomPrice defines the value of one unit of a denom in base denom units
+type DenomPrice struct {
+	// Denom of the accepted fee coin
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// Price is the amount of base denom units per denom unit. It must be
+	// positive.
+	Price github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
+}
+
+func (m *DenomPrice) Reset()         { *m = DenomPrice{} }
+func (m *DenomPrice) String() string { return proto.CompactTextString(m) }
+func (*DenomPrice) ProtoMessage()    {}
+func (*DenomPrice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e1fd18b564cbff8, []int{5}
+}
+
+func (m *DenomPrice) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *DenomPrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomPrice.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *DenomPrice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomPrice.Merge(m, src)
+}
+
+func (m *DenomPrice) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *DenomPrice) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomPrice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomPrice proto.InternalMessageInfo
+
+func (m *DenomPrice) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// EngagementDiscountTier defines the gas price multiplier for fee payers with
+// at least the min engagement points
+type EngagementDiscountTier struct {
+	// MinPoints is the minimum engagement points of the fee payer for this tier
+	MinPoints uint64 `protobuf:"varint,1,opt,name=min_points,json=minPoints,proto3" json:"min_points,omitempty" yaml:"min_points"`
+	// GasPriceMultiplier is applied to the minimum gas prices. It must be in
+	// [0, 1].
+	GasPriceMultiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=gas_price_multiplier,json=gasPriceMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"gas_price_multiplier" yaml:"gas_price_multiplier"`
+}
+
+func (m *EngagementDiscountTier) Reset()         { *m = EngagementDiscountTier{} }
+func (m *EngagementDiscountTier) String() string { return proto.CompactTextString(m) }
+func (*EngagementDiscountTier) ProtoMessage()    {}
+func (*EngagementDiscountTier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e1fd18b564cbff8, []int{6}
+}
+
+func (m *EngagementDiscountTier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *EngagementDiscountTier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EngagementDiscountTier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *EngagementDiscountTier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EngagementDiscountTier.Merge(m, src)
+}
+
+func (m *EngagementDiscountTier) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *EngagementDiscountTier) XXX_DiscardUnknown() {
+	xxx_messageInfo_EngagementDiscountTier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EngagementDiscountTier proto.InternalMessageInfo
+
+func (m *EngagementDiscountTier) GetMinPoints() uint64 {
+	if m != nil {
+		return m.MinPoints
+	}
+	return 0
+}
+
+// DynamicBaseFeeParams defines the EIP-1559 style base gas price. When enabled,
+// the base gas price is adjusted at the end of each block towards the target
+// block gas and enforced as minimum gas price in addition to the
+// MinimumGasPrices.
+type DynamicBaseFeeParams struct {
+	// Enabled turns the dynamic base fee on or off
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// Denom of the base gas price
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// MinBaseGasPrice is the lower bound and initial value of the base gas price
+	MinBaseGasPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=min_base_gas_price,json=minBaseGasPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_base_gas_price" yaml:"min_base_gas_price"`
+	// MaxBaseGasPrice is the upper bound of the base gas price
+	MaxBaseGasPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=max_base_gas_price,json=maxBaseGasPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_base_gas_price" yaml:"max_base_gas_price"`
+	// TargetBlockGas is the block gas utilisation where the base gas price is
+	// kept
+	TargetBlockGas uint64 `protobuf:"varint,5,opt,name=target_block_gas,json=targetBlockGas,proto3" json:"target_block_gas,omitempty" yaml:"target_block_gas"`
+	// MaxChangeRate is the maximum relative change of the base gas price per
+	// block, reached with an empty block or a block that uses twice the target
+	// gas
+	MaxChangeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=max_change_rate,json=maxChangeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_change_rate" yaml:"max_change_rate"`
+}
+
+func (m *DynamicBaseFeeParams) Reset()         { *m = DynamicBaseFeeParams{} }
+func (m *DynamicBaseFeeParams) String() string { return proto.CompactTextString(m) }
+func (*DynamicBaseFeeParams) ProtoMessage()    {}
+func (*DynamicBaseFeeParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e1fd18b564cbff8, []int{7}
+}
+
+func (m *DynamicBaseFeeParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *DynamicBaseFeeParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DynamicBaseFeeParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *DynamicBaseFeeParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DynamicBaseFeeParams.Merge(m, src)
+}
+
+func (m *DynamicBaseFeeParams) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *DynamicBaseFeeParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_DynamicBaseFeeParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DynamicBaseFeeParams proto.InternalMessageInfo
+
+func (m *DynamicBaseFeeParams) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *DynamicBaseFeeParams) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *DynamicBaseFeeParams) GetTargetBlockGas() uint64 {
+	if m != nil {
+		return m.TargetBlockGas
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "confio.globalfee.v1beta1.GenesisState")
 	proto.RegisterType((*Params)(nil), "confio.globalfee.v1beta1.Params")
+	proto.RegisterType((*RateLimitParams)(nil), "confio.globalfee.v1beta1.RateLimitParams")
+	proto.RegisterType((*RateLimitUsage)(nil), "confio.globalfee.v1beta1.RateLimitUsage")
+	proto.RegisterType((*FeePriceTable)(nil), "confio.globalfee.v1beta1.FeePriceTable")
+	proto.RegisterType((*DenomPrice)(nil), "confio.globalfee.v1beta1.DenomPrice")
+	proto.RegisterType((*EngagementDiscountTier)(nil), "confio.globalfee.v1beta1.EngagementDiscountTier")
+	proto.RegisterType((*DynamicBaseFeeParams)(nil), "confio.globalfee.v1beta1.DynamicBaseFeeParams")
 }
 
 func init() {
@@ -146,29 +612,77 @@ func init() {
 }
 
 var fileDescriptor_9e1fd18b564cbff8 = []byte{
-	// 342 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0x3f, 0x4f, 0xc2, 0x40,
-	0x18, 0x87, 0xdb, 0x98, 0x30, 0x14, 0x07, 0xd2, 0x38, 0x20, 0x21, 0x57, 0xd2, 0xc1, 0x10, 0xff,
-	0xdc, 0x05, 0xdc, 0x1c, 0x91, 0x84, 0x95, 0xe0, 0xe6, 0x42, 0xae, 0xe5, 0x38, 0x2f, 0x72, 0x7d,
-	0x9b, 0xde, 0x81, 0xf2, 0x2d, 0xfc, 0x1c, 0x7e, 0x06, 0x77, 0x19, 0x19, 0x9d, 0xd0, 0xc0, 0xe6,
-	0xe8, 0x27, 0x30, 0xdc, 0x55, 0x40, 0x89, 0x4b, 0xdb, 0xf4, 0x9e, 0xf7, 0xf7, 0xdc, 0xfb, 0xbe,
-	0xde, 0x49, 0x0c, 0xc9, 0x50, 0x00, 0xe1, 0x23, 0x88, 0xe8, 0x68, 0xc8, 0x18, 0x99, 0x34, 0x22,
-	0xa6, 0x69, 0x83, 0x70, 0x96, 0x30, 0x25, 0x14, 0x4e, 0x33, 0xd0, 0xe0, 0x97, 0x2d, 0x87, 0x37,
-	0x1c, 0xce, 0xb9, 0xca, 0x11, 0x07, 0x0e, 0x06, 0x22, 0xeb, 0x2f, 0xcb, 0x57, 0x50, 0x0c, 0x4a,
-	0x82, 0x22, 0x11, 0x55, 0xdb, 0xc8, 0x18, 0x44, 0xb2, 0x7b, 0xfe, 0x40, 0x95, 0x24, 0xe6, 0x31,
-	0xf9, 0xe3, 0x0b, 0x23, 0xef, 0xb0, 0x63, 0x7f, 0xdc, 0x68, 0xaa, 0x99, 0xdf, 0xf3, 0x0a, 0x29,
-	0xcd, 0xa8, 0x54, 0x65, 0xb7, 0xe6, 0xd6, 0x8b, 0xcd, 0x1a, 0xfe, 0xef, 0x42, 0xb8, 0x6b, 0xb8,
-	0x56, 0x79, 0xb6, 0x08, 0x9c, 0xcf, 0x45, 0x50, 0xb2, 0x75, 0xe7, 0x20, 0x85, 0x66, 0x32, 0xd5,
-	0xd3, 0x5e, 0x9e, 0x14, 0xbe, 0xba, 0x5e, 0xc1, 0xc2, 0xfe, 0x8b, 0xeb, 0xf9, 0x52, 0x24, 0x42,
-	0x8e, 0x65, 0x9f, 0x53, 0xd5, 0x4f, 0x33, 0x11, 0xb3, 0xb5, 0xeb, 0xa0, 0x5e, 0x6c, 0x56, 0xb1,
-	0x6d, 0x06, 0xaf, 0x9b, 0xd9, 0x68, 0xda, 0x2c, 0xbe, 0x06, 0x91, 0xb4, 0xd2, 0xdc, 0x53, 0xdd,
-	0xaf, 0xdf, 0x3a, 0xbf, 0x16, 0xc1, 0xf1, 0x94, 0xca, 0xd1, 0x55, 0xb8, 0x4f, 0x85, 0xcf, 0xef,
-	0xc1, 0x19, 0x17, 0xfa, 0x6e, 0x1c, 0xe1, 0x18, 0x24, 0xc9, 0x27, 0x67, 0x5f, 0x17, 0x6a, 0x70,
-	0x4f, 0xf4, 0x34, 0x65, 0xea, 0x47, 0xa8, 0x7a, 0xa5, 0x3c, 0xa3, 0x43, 0x55, 0xd7, 0x24, 0xb4,
-	0xda, 0xb3, 0x25, 0x72, 0xe7, 0x4b, 0xe4, 0x7e, 0x2c, 0x91, 0xfb, 0xb4, 0x42, 0xce, 0x7c, 0x85,
-	0x9c, 0xb7, 0x15, 0x72, 0x6e, 0x4f, 0x7f, 0x05, 0x9b, 0x55, 0x6b, 0x9e, 0xd1, 0x01, 0x23, 0x8f,
-	0x3b, 0x3b, 0x37, 0x82, 0xa8, 0x60, 0x46, 0x7f, 0xf9, 0x1d, 0x00, 0x00, 0xff, 0xff, 0xf2, 0x3e,
-	0x9f, 0x79, 0x14, 0x02, 0x00, 0x00,
+	// 1120 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xcb, 0x6e, 0x1b, 0x37,
+	0x17, 0xf6, 0x58, 0xb6, 0x1c, 0xd1, 0xd7, 0x9f, 0xbf, 0x62, 0x4f, 0x2e, 0xd0, 0x08, 0x6c, 0xe1,
+	0xb8, 0x4d, 0x23, 0xc5, 0x49, 0x57, 0x01, 0xba, 0x99, 0x28, 0x71, 0x8b, 0xd6, 0xa8, 0xc1, 0xb8,
+	0x5d, 0x74, 0x33, 0xa0, 0x46, 0xf4, 0x98, 0x88, 0x38, 0xa3, 0x8a, 0x74, 0x3c, 0x5e, 0x15, 0xed,
+	0xae, 0x8b, 0x02, 0x7d, 0x81, 0xbe, 0x40, 0x1e, 0xa0, 0xab, 0x76, 0x9f, 0x45, 0x81, 0x66, 0x59,
+	0x74, 0x31, 0x2d, 0xec, 0x9d, 0x17, 0x5d, 0xa8, 0x2f, 0x50, 0x90, 0x1c, 0x6b, 0x2e, 0x91, 0x0a,
+	0x1b, 0xdd, 0x48, 0x43, 0xf2, 0x3b, 0xe7, 0xfb, 0xce, 0xe1, 0xe1, 0x21, 0xc1, 0xa6, 0x1f, 0x85,
+	0x07, 0x2c, 0x6a, 0x07, 0xfd, 0xa8, 0x4b, 0xfa, 0x07, 0x94, 0xb6, 0x5f, 0x6c, 0x77, 0xa9, 0x24,
+	0xdb, 0xed, 0x80, 0x86, 0x54, 0x30, 0xd1, 0x1a, 0x0c, 0x23, 0x19, 0x41, 0xdb, 0xe0, 0x5a, 0x63,
+	0x5c, 0x2b, 0xc5, 0xdd, 0xac, 0x07, 0x51, 0x10, 0x69, 0x50, 0x5b, 0x7d, 0x19, 0xfc, 0xcd, 0x86,
+	0x1f, 0x09, 0x1e, 0x89, 0x76, 0x97, 0x88, 0xcc, 0xa5, 0x1f, 0xb1, 0x30, 0xbf, 0x7e, 0x4c, 0x04,
+	0x6f, 0xeb, 0x9f, 0x17, 0x25, 0x3e, 0xf4, 0x97, 0x05, 0x96, 0x76, 0xcc, 0xcc, 0x33, 0x49, 0x24,
+	0x85, 0x18, 0x54, 0x07, 0x64, 0x48, 0xb8, 0xb0, 0xad, 0xa6, 0xb5, 0xb5, 0xf8, 0xa0, 0xd9, 0x9a,
+	0xa6, 0xa8, 0xb5, 0xa7, 0x71, 0xae, 0xfd, 0x2a, 0x71, 0x66, 0xce, 0x13, 0x67, 0xcd, 0xd8, 0xbd,
+	0x17, 0x71, 0x26, 0x29, 0x1f, 0xc8, 0x13, 0x9c, 0x7a, 0x82, 0xdf, 0x5a, 0x60, 0x45, 0x09, 0xf4,
+	0x02, 0x22, 0xbc, 0xc1, 0x90, 0xf9, 0xd4, 0x9e, 0x6d, 0x5a, 0x5b, 0x35, 0xd7, 0x57, 0xa6, 0xbf,
+	0x27, 0xce, 0x66, 0xc0, 0xe4, 0xe1, 0x51, 0xb7, 0xe5, 0x47, 0xbc, 0x9d, 0x06, 0x64, 0xfe, 0xee,
+	0x89, 0xde, 0xf3, 0xb6, 0x3c, 0x19, 0x50, 0xd1, 0xea, 0x50, 0xff, 0x3c, 0x71, 0xec, 0xa2, 0x9f,
+	0x8c, 0x6c, 0x94, 0x38, 0xd7, 0x4f, 0x08, 0xef, 0x3f, 0x42, 0x45, 0x04, 0xc2, 0x4b, 0x6a, 0x62,
+	0x87, 0x88, 0x3d, 0x3d, 0xfc, 0xe5, 0x1a, 0xa8, 0x1a, 0xe1, 0xf0, 0x27, 0x0b, 0x40, 0xce, 0x42,
+	0xc6, 0x8f, 0x78, 0x86, 0x57, 0x71, 0x57, 0xb6, 0x16, 0x1f, 0xdc, 0x6e, 0x19, 0x05, 0x2d, 0x65,
+	0x3d, 0x0e, 0xb9, 0x43, 0xfd, 0xc7, 0x11, 0x0b, 0xdd, 0x41, 0x1a, 0xf3, 0xed, 0x37, 0xed, 0x0b,
+	0x92, 0x6e, 0x18, 0x49, 0x6f, 0xa2, 0xd0, 0xcb, 0x3f, 0x9c, 0xbb, 0x97, 0x8b, 0x5a, 0x11, 0x0a,
+	0xbc, 0x96, 0xfa, 0xb8, 0x08, 0x44, 0xc0, 0xaf, 0x2d, 0x60, 0x77, 0x4f, 0x06, 0x44, 0x08, 0x8f,
+	0xb3, 0xd0, 0x3b, 0xa0, 0xd4, 0xe3, 0x22, 0xf0, 0xb4, 0x9d, 0x3d, 0xdb, 0xac, 0x6c, 0xd5, 0xdc,
+	0x8f, 0xce, 0x13, 0x07, 0x4d, 0xc3, 0x14, 0x84, 0x3a, 0x69, 0xee, 0xa6, 0x60, 0x11, 0xae, 0x9b,
+	0xa5, 0x5d, 0x16, 0x3e, 0xa5, 0x74, 0x57, 0x04, 0xfb, 0x6a, 0x1a, 0xfe, 0x68, 0x81, 0x4d, 0x4e,
+	0x62, 0x4f, 0x46, 0x92, 0xf4, 0xbd, 0x09, 0xd6, 0x2a, 0xe2, 0x23, 0x41, 0x02, 0x6a, 0x57, 0x9a,
+	0xd6, 0xd6, 0x9c, 0x4b, 0xcf, 0x13, 0xe7, 0xfe, 0xe5, 0x2c, 0x0a, 0xfa, 0xee, 0xa5, 0x89, 0xbc,
+	0x94, 0x25, 0xc2, 0x0e, 0x27, 0xf1, 0xbe, 0xc2, 0xb9, 0x45, 0xd5, 0x3b, 0x44, 0x7c, 0xa6, 0x10,
+	0xf0, 0x3b, 0x0b, 0xac, 0xf5, 0x4e, 0x42, 0xc2, 0x99, 0xef, 0xe9, 0x82, 0x39, 0xa0, 0xd4, 0x9e,
+	0xd3, 0x15, 0xdf, 0x9a, 0x5e, 0xf1, 0x1d, 0x63, 0xe1, 0x12, 0x41, 0x9f, 0x52, 0x9a, 0xd6, 0xff,
+	0xc3, 0x8b, 0xfa, 0x2f, 0xfb, 0x1b, 0x25, 0xce, 0x86, 0x91, 0x5d, 0x5e, 0x41, 0x78, 0xa5, 0x57,
+	0x70, 0x05, 0x7f, 0xb6, 0xc0, 0x0d, 0x1a, 0x06, 0x24, 0xa0, 0x9c, 0x86, 0xd2, 0xeb, 0x31, 0xe1,
+	0x47, 0x47, 0xa1, 0xf4, 0x24, 0xa3, 0x43, 0x61, 0xcf, 0xeb, 0x92, 0xbc, 0x3f, 0x5d, 0xd8, 0x93,
+	0xb1, 0x69, 0x27, 0xb5, 0xdc, 0x67, 0x74, 0xe8, 0x7e, 0x9a, 0x4a, 0x7b, 0x6b, 0xaa, 0xeb, 0x42,
+	0x92, 0x9b, 0x46, 0xed, 0x54, 0x30, 0xc2, 0x1b, 0x74, 0x22, 0x91, 0x80, 0xdf, 0x58, 0x60, 0x55,
+	0xed, 0x83, 0xae, 0x6e, 0x4f, 0x92, 0x6e, 0x9f, 0xda, 0x55, 0x9d, 0xce, 0x3b, 0xd3, 0x55, 0xab,
+	0x1c, 0x2a, 0xfc, 0xbe, 0x82, 0xbb, 0xdb, 0xa9, 0xd8, 0xb2, 0x9f, 0x51, 0xe2, 0xac, 0x1b, 0x61,
+	0xa5, 0x05, 0x84, 0x97, 0x0f, 0xf2, 0x1e, 0xe0, 0x97, 0x00, 0x0c, 0x89, 0xa4, 0x5e, 0x9f, 0x71,
+	0x26, 0xed, 0x05, 0x4d, 0xff, 0xce, 0x74, 0x7a, 0x4c, 0x24, 0xfd, 0x44, 0x41, 0xd3, 0x8d, 0xbc,
+	0x93, 0x0a, 0xc8, 0x39, 0x19, 0x25, 0xce, 0xff, 0x0c, 0x77, 0x36, 0x87, 0x70, 0x6d, 0x78, 0x61,
+	0x89, 0x5e, 0x5a, 0x60, 0xb5, 0xe4, 0x07, 0x7e, 0x00, 0x96, 0x8f, 0x59, 0xd8, 0x8b, 0x8e, 0xbd,
+	0x6e, 0x3f, 0xf2, 0x9f, 0x9b, 0x4e, 0x3a, 0xe7, 0xda, 0xa3, 0xc4, 0xa9, 0x1b, 0x67, 0x85, 0x65,
+	0x84, 0x97, 0xcc, 0xd8, 0xd5, 0x43, 0x78, 0x17, 0x2c, 0xe8, 0x32, 0x8f, 0x85, 0xee, 0x92, 0x73,
+	0x2e, 0x1c, 0x25, 0xce, 0x4a, 0xae, 0xfe, 0x63, 0x81, 0x70, 0x55, 0x15, 0x78, 0x3c, 0x06, 0x07,
+	0x44, 0xd8, 0x95, 0x49, 0xe0, 0x80, 0xa4, 0xe0, 0x1d, 0x22, 0xd0, 0x00, 0xac, 0x8c, 0xb5, 0x9a,
+	0x63, 0xf0, 0x08, 0xa4, 0xdc, 0x9e, 0x90, 0x64, 0x28, 0xb5, 0xd2, 0x8a, 0xbb, 0x31, 0x4a, 0x9c,
+	0xff, 0x17, 0x94, 0xea, 0x55, 0x84, 0x17, 0xcd, 0xf0, 0x99, 0x1a, 0xc1, 0x35, 0x50, 0x19, 0x6b,
+	0xc4, 0xea, 0x53, 0xcd, 0x8c, 0x85, 0x60, 0xf5, 0x89, 0x7e, 0xb0, 0xc0, 0x72, 0x61, 0x97, 0xe1,
+	0xfb, 0x00, 0xe8, 0x53, 0xd0, 0xa3, 0x61, 0xc4, 0x35, 0x5f, 0xcd, 0xbd, 0x9e, 0xa5, 0x39, 0x5b,
+	0x43, 0xb8, 0xa6, 0x06, 0x1d, 0xf5, 0x0d, 0x3f, 0x07, 0xd5, 0xb4, 0x3b, 0xcf, 0xea, 0xa3, 0xf0,
+	0xf6, 0xbf, 0x9c, 0x51, 0x65, 0xa0, 0x09, 0x73, 0x37, 0x53, 0xa9, 0x33, 0xe3, 0xd4, 0x1b, 0x3a,
+	0x04, 0x20, 0xc3, 0xc3, 0x3a, 0x98, 0xcf, 0xc9, 0xc2, 0x66, 0x00, 0x3b, 0x60, 0x3e, 0x7f, 0x67,
+	0xb5, 0xae, 0x76, 0x67, 0x61, 0x63, 0x8c, 0x7e, 0xb5, 0xc0, 0xfa, 0xe4, 0x53, 0xaa, 0x52, 0xa2,
+	0xda, 0xd8, 0x20, 0x62, 0xa1, 0xbc, 0x28, 0x96, 0x5c, 0x4a, 0xb2, 0x35, 0x84, 0x6b, 0x9c, 0x85,
+	0x7b, 0xfa, 0x1b, 0x7e, 0x05, 0xea, 0xe3, 0xeb, 0xc4, 0xe3, 0x47, 0x7d, 0xc9, 0x06, 0x7d, 0x46,
+	0x87, 0xa9, 0xca, 0xdd, 0xab, 0xa9, 0x1c, 0x25, 0xce, 0x2d, 0xc3, 0x36, 0xc9, 0x27, 0xc2, 0x30,
+	0x48, 0xaf, 0x9d, 0xdd, 0x6c, 0xf2, 0xef, 0x0a, 0xa8, 0x4f, 0x6a, 0x88, 0xd0, 0x06, 0x0b, 0x34,
+	0x54, 0x9b, 0xdd, 0xd3, 0xc1, 0x5c, 0xc3, 0x17, 0xc3, 0x2c, 0xc1, 0xb3, 0xf9, 0x04, 0xc7, 0xfa,
+	0x1a, 0xf6, 0x4a, 0x2f, 0x84, 0x8a, 0x8e, 0xe3, 0xe3, 0x2b, 0xc7, 0x91, 0x5d, 0xb9, 0x5e, 0xf9,
+	0x25, 0xb0, 0xca, 0x59, 0xe8, 0xe6, 0x1e, 0x03, 0x9a, 0x99, 0xc4, 0x65, 0xe6, 0xb9, 0xff, 0xc8,
+	0x4c, 0xe2, 0x09, 0xcc, 0x24, 0x2e, 0x30, 0x3f, 0x01, 0x6b, 0x92, 0x0c, 0x03, 0x2a, 0x4d, 0x13,
+	0xd0, 0x07, 0x78, 0x5e, 0xef, 0xfc, 0xad, 0xec, 0xda, 0x28, 0x23, 0x10, 0x5e, 0x31, 0x53, 0xba,
+	0x53, 0xec, 0x10, 0x01, 0x07, 0x40, 0x79, 0xf6, 0xfc, 0x43, 0x12, 0x06, 0xd4, 0x53, 0x6d, 0x49,
+	0x77, 0xdd, 0x9a, 0xfb, 0xe1, 0x95, 0xd5, 0xaf, 0x67, 0xea, 0x73, 0xee, 0x10, 0x5e, 0xe6, 0x24,
+	0x7e, 0xac, 0x27, 0x54, 0xef, 0x70, 0x3b, 0xaf, 0x4e, 0x1b, 0xd6, 0xeb, 0xd3, 0x86, 0xf5, 0xe7,
+	0x69, 0xc3, 0xfa, 0xfe, 0xac, 0x31, 0xf3, 0xfa, 0xac, 0x31, 0xf3, 0xdb, 0x59, 0x63, 0xe6, 0x8b,
+	0x77, 0x0b, 0x54, 0xfa, 0xb5, 0x2b, 0x83, 0x21, 0xe9, 0xd1, 0x76, 0x9c, 0x7b, 0xf6, 0x6a, 0xca,
+	0x6e, 0x55, 0xbf, 0x3e, 0x1f, 0xfe, 0x33, 0x00, 0x98, 0x22, 0xdb, 0x65, 0x17, 0x0b, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -191,6 +705,16 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.BaseGasPrice.Size()
+		i -= size
+		if _, err := m.BaseGasPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -224,70 +748,1370 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if len(m.MinimumGasPrices) > 0 {
-		for iNdEx := len(m.MinimumGasPrices) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.MinimumGasPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
+	{
+		size, err := m.RateLimit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size, err := m.FeePriceTable.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.EngagementDiscountTiers) > 0 {
+		for iNdEx := len(m.EngagementDiscountTiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EngagementDiscountTiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size, err := m.DynamicBaseFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.MaxTotalBypassMinFeeMsgGasUsage != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxTotalBypassMinFeeMsgGasUsage))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.BypassMinFeeMsgTypes) > 0 {
+		for iNdEx := len(m.BypassMinFeeMsgTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BypassMinFeeMsgTypes[iNdEx])
+			copy(dAtA[i:], m.BypassMinFeeMsgTypes[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.BypassMinFeeMsgTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.MinimumGasPrices) > 0 {
+		for iNdEx := len(m.MinimumGasPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinimumGasPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RateLimitParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateLimitParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateLimitParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxGas != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxGas))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxTxs != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxTxs))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.WindowBlocks != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.WindowBlocks))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RateLimitUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateLimitUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateLimitUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Gas != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Gas))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Txs != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Txs))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.WindowStart != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.WindowStart))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeePriceTable) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeePriceTable) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeePriceTable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Prices) > 0 {
+		for iNdEx := len(m.Prices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Prices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomPrice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomPrice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EngagementDiscountTier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EngagementDiscountTier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EngagementDiscountTier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.GasPriceMultiplier.Size()
+		i -= size
+		if _, err := m.GasPriceMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.MinPoints != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MinPoints))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DynamicBaseFeeParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DynamicBaseFeeParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DynamicBaseFeeParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxChangeRate.Size()
+		i -= size
+		if _, err := m.MaxChangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.TargetBlockGas != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TargetBlockGas))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.MaxBaseGasPrice.Size()
+		i -= size
+		if _, err := m.MaxBaseGasPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MinBaseGasPrice.Size()
+		i -= size
+		if _, err := m.MinBaseGasPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.BaseGasPrice.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MinimumGasPrices) > 0 {
+		for _, e := range m.MinimumGasPrices {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BypassMinFeeMsgTypes) > 0 {
+		for _, s := range m.BypassMinFeeMsgTypes {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.MaxTotalBypassMinFeeMsgGasUsage != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxTotalBypassMinFeeMsgGasUsage))
+	}
+	l = m.DynamicBaseFee.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.EngagementDiscountTiers) > 0 {
+		for _, e := range m.EngagementDiscountTiers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.FeePriceTable.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.RateLimit.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *RateLimitParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.WindowBlocks != 0 {
+		n += 1 + sovGenesis(uint64(m.WindowBlocks))
+	}
+	if m.MaxTxs != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxTxs))
+	}
+	if m.MaxGas != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxGas))
+	}
+	return n
+}
+
+func (m *RateLimitUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.WindowStart != 0 {
+		n += 1 + sovGenesis(uint64(m.WindowStart))
+	}
+	if m.Txs != 0 {
+		n += 1 + sovGenesis(uint64(m.Txs))
+	}
+	if m.Gas != 0 {
+		n += 1 + sovGenesis(uint64(m.Gas))
+	}
+	return n
+}
+
+func (m *FeePriceTable) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Prices) > 0 {
+		for _, e := range m.Prices {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DenomPrice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *EngagementDiscountTier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MinPoints != 0 {
+		n += 1 + sovGenesis(uint64(m.MinPoints))
+	}
+	l = m.GasPriceMultiplier.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *DynamicBaseFeeParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.MinBaseGasPrice.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.MaxBaseGasPrice.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.TargetBlockGas != 0 {
+		n += 1 + sovGenesis(uint64(m.TargetBlockGas))
+	}
+	l = m.MaxChangeRate.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseGasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinimumGasPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinimumGasPrices = append(m.MinimumGasPrices, types.DecCoin{})
+			if err := m.MinimumGasPrices[len(m.MinimumGasPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BypassMinFeeMsgTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BypassMinFeeMsgTypes = append(m.BypassMinFeeMsgTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTotalBypassMinFeeMsgGasUsage", wireType)
+			}
+			m.MaxTotalBypassMinFeeMsgGasUsage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTotalBypassMinFeeMsgGasUsage |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DynamicBaseFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DynamicBaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EngagementDiscountTiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EngagementDiscountTiers = append(m.EngagementDiscountTiers, EngagementDiscountTier{})
+			if err := m.EngagementDiscountTiers[len(m.EngagementDiscountTiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePriceTable", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeePriceTable.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *RateLimitParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimitParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimitParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowBlocks", wireType)
+			}
+			m.WindowBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTxs", wireType)
+			}
+			m.MaxTxs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTxs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGas", wireType)
+			}
+			m.MaxGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *RateLimitUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimitUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimitUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowStart", wireType)
+			}
+			m.WindowStart = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowStart |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
+			}
+			m.Txs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Txs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
+			}
+			m.Gas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Gas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *FeePriceTable) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeePriceTable: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeePriceTable: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prices = append(m.Prices, DenomPrice{})
+			if err := m.Prices[len(m.Prices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *DenomPrice) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomPrice: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomPrice: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
-			i--
-			dAtA[i] = 0xa
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	return n
-}
 
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.MinimumGasPrices) > 0 {
-		for _, e := range m.MinimumGasPrices {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
 
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
+func (m *EngagementDiscountTier) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -310,17 +2134,36 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			return fmt.Errorf("proto: EngagementDiscountTier: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EngagementDiscountTier: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinPoints", wireType)
+			}
+			m.MinPoints = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinPoints |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPriceMultiplier", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -330,22 +2173,23 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.GasPriceMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -371,7 +2215,7 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *DynamicBaseFeeParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -394,17 +2238,37 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: DynamicBaseFeeParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DynamicBaseFeeParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinimumGasPrices", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -414,23 +2278,142 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MinimumGasPrices = append(m.MinimumGasPrices, types.DecCoin{})
-			if err := m.MinimumGasPrices[len(m.MinimumGasPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBaseGasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinBaseGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBaseGasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxBaseGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetBlockGas", wireType)
+			}
+			m.TargetBlockGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetBlockGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxChangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
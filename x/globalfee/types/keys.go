@@ -4,5 +4,21 @@ const (
 	// ModuleName is the name of the this module
 	ModuleName = "globalfee"
 
+	// StoreKey is the store key string for the global fee module
+	StoreKey = ModuleName
+
+	// TStoreKey is the transient store key string for the global fee module
+	TStoreKey = "transient_" + ModuleName
+
 	QuerierRoute = ModuleName
 )
+
+var (
+	// BaseGasPriceKey store key for the dynamic base gas price
+	BaseGasPriceKey = []byte{0x01}
+	// RateLimitUsagePrefix store key prefix for the rate limit usage of accounts in the current window
+	RateLimitUsagePrefix = []byte{0x02}
+)
+
+// EngagementPointsCachePrefix transient store key prefix for the engagement points of fee payers in the current block
+var EngagementPointsCachePrefix = []byte{0x01}
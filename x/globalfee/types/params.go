@@ -1,18 +1,71 @@
 package types
 
 import (
+	"math"
+	"strings"
+
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
-// ParamStoreKeyMinGasPrices store key
-var ParamStoreKeyMinGasPrices = []byte("MinimumGasPricesParam")
+var (
+	// ParamStoreKeyMinGasPrices store key
+	ParamStoreKeyMinGasPrices = []byte("MinimumGasPricesParam")
+	// ParamStoreKeyBypassMinFeeMsgTypes store key
+	ParamStoreKeyBypassMinFeeMsgTypes = []byte("BypassMinFeeMsgTypes")
+	// ParamStoreKeyMaxTotalBypassMinFeeMsgGasUsage store key
+	ParamStoreKeyMaxTotalBypassMinFeeMsgGasUsage = []byte("MaxTotalBypassMinFeeMsgGasUsage")
+	// ParamStoreKeyDynamicBaseFee store key
+	ParamStoreKeyDynamicBaseFee = []byte("DynamicBaseFee")
+	// ParamStoreKeyEngagementDiscountTiers store key
+	ParamStoreKeyEngagementDiscountTiers = []byte("EngagementDiscountTiers")
+	// ParamStoreKeyFeePriceTable store key
+	ParamStoreKeyFeePriceTable = []byte("FeePriceTable")
+	// ParamStoreKeyRateLimit store key
+	ParamStoreKeyRateLimit = []byte("RateLimit")
+)
+
+// DefaultMaxTotalBypassMinFeeMsgGasUsage default gas limit for a TX with bypass messages only
+const DefaultMaxTotalBypassMinFeeMsgGasUsage uint64 = 1_000_000
 
 // DefaultParams returns default wasm parameters
 func DefaultParams() Params {
-	return Params{MinimumGasPrices: sdk.DecCoins{}}
+	return Params{
+		MinimumGasPrices:                sdk.DecCoins{},
+		BypassMinFeeMsgTypes:            []string{},
+		MaxTotalBypassMinFeeMsgGasUsage: DefaultMaxTotalBypassMinFeeMsgGasUsage,
+		DynamicBaseFee:                  DefaultDynamicBaseFeeParams(),
+		EngagementDiscountTiers:         []EngagementDiscountTier{},
+		FeePriceTable:                   DefaultFeePriceTable(),
+		RateLimit:                       DefaultRateLimitParams(),
+	}
+}
+
+// DefaultRateLimitParams returns the disabled rate limit with a window of 10 blocks
+func DefaultRateLimitParams() RateLimitParams {
+	return RateLimitParams{WindowBlocks: 10}
+}
+
+// DefaultFeePriceTable returns an empty price table for the bond denom
+func DefaultFeePriceTable() FeePriceTable {
+	return FeePriceTable{
+		BaseDenom: "utgd",
+		Prices:    []DenomPrice{},
+	}
+}
+
+// DefaultDynamicBaseFeeParams returns the disabled dynamic base fee with bounds and change rate as in EIP-1559
+func DefaultDynamicBaseFeeParams() DynamicBaseFeeParams {
+	return DynamicBaseFeeParams{
+		Enabled:         false,
+		Denom:           "utgd",
+		MinBaseGasPrice: sdk.NewDecWithPrec(1, 2),
+		MaxBaseGasPrice: sdk.NewDec(10),
+		TargetBlockGas:  10_000_000,
+		MaxChangeRate:   sdk.NewDecWithPrec(125, 3),
+	}
 }
 
 func ParamKeyTable() paramtypes.KeyTable {
@@ -21,7 +74,25 @@ func ParamKeyTable() paramtypes.KeyTable {
 
 // ValidateBasic performs basic validation.
 func (p Params) ValidateBasic() error {
-	return validateMinimumGasPrices(p.MinimumGasPrices)
+	if err := validateMinimumGasPrices(p.MinimumGasPrices); err != nil {
+		return sdkerrors.Wrap(err, "minimum gas prices")
+	}
+	if err := validateBypassMinFeeMsgTypes(p.BypassMinFeeMsgTypes); err != nil {
+		return sdkerrors.Wrap(err, "bypass min fee msg types")
+	}
+	if err := validateMaxTotalBypassMinFeeMsgGasUsage(p.MaxTotalBypassMinFeeMsgGasUsage); err != nil {
+		return err
+	}
+	if err := p.DynamicBaseFee.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "dynamic base fee")
+	}
+	if err := validateEngagementDiscountTiers(p.EngagementDiscountTiers); err != nil {
+		return sdkerrors.Wrap(err, "engagement discount tiers")
+	}
+	if err := p.FeePriceTable.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "fee price table")
+	}
+	return sdkerrors.Wrap(p.RateLimit.ValidateBasic(), "rate limit")
 }
 
 // ParamSetPairs returns the parameter set pairs.
@@ -30,6 +101,24 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(
 			ParamStoreKeyMinGasPrices, &p.MinimumGasPrices, validateMinimumGasPrices,
 		),
+		paramtypes.NewParamSetPair(
+			ParamStoreKeyBypassMinFeeMsgTypes, &p.BypassMinFeeMsgTypes, validateBypassMinFeeMsgTypes,
+		),
+		paramtypes.NewParamSetPair(
+			ParamStoreKeyMaxTotalBypassMinFeeMsgGasUsage, &p.MaxTotalBypassMinFeeMsgGasUsage, validateMaxTotalBypassMinFeeMsgGasUsage,
+		),
+		paramtypes.NewParamSetPair(
+			ParamStoreKeyDynamicBaseFee, &p.DynamicBaseFee, validateDynamicBaseFee,
+		),
+		paramtypes.NewParamSetPair(
+			ParamStoreKeyEngagementDiscountTiers, &p.EngagementDiscountTiers, validateEngagementDiscountTiers,
+		),
+		paramtypes.NewParamSetPair(
+			ParamStoreKeyFeePriceTable, &p.FeePriceTable, validateFeePriceTable,
+		),
+		paramtypes.NewParamSetPair(
+			ParamStoreKeyRateLimit, &p.RateLimit, validateRateLimit,
+		),
 	}
 }
 
@@ -40,3 +129,195 @@ func validateMinimumGasPrices(i interface{}) error {
 	}
 	return v.Validate()
 }
+
+func validateBypassMinFeeMsgTypes(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return sdkerrors.Wrapf(wasmtypes.ErrInvalid, "type: %T", i)
+	}
+	unique := make(map[string]struct{}, len(v))
+	for _, msgType := range v {
+		if !strings.HasPrefix(msgType, "/") || strings.TrimSpace(msgType) != msgType {
+			return sdkerrors.Wrapf(wasmtypes.ErrInvalid, "msg type url: %q", msgType)
+		}
+		if _, exists := unique[msgType]; exists {
+			return sdkerrors.Wrapf(wasmtypes.ErrDuplicate, "msg type url: %q", msgType)
+		}
+		unique[msgType] = struct{}{}
+	}
+	return nil
+}
+
+func validateMaxTotalBypassMinFeeMsgGasUsage(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return sdkerrors.Wrapf(wasmtypes.ErrInvalid, "type: %T", i)
+	}
+	return nil
+}
+
+func validateDynamicBaseFee(i interface{}) error {
+	v, ok := i.(DynamicBaseFeeParams)
+	if !ok {
+		return sdkerrors.Wrapf(wasmtypes.ErrInvalid, "type: %T", i)
+	}
+	return v.ValidateBasic()
+}
+
+func validateEngagementDiscountTiers(i interface{}) error {
+	v, ok := i.([]EngagementDiscountTier)
+	if !ok {
+		return sdkerrors.Wrapf(wasmtypes.ErrInvalid, "type: %T", i)
+	}
+	for i, t := range v {
+		if t.MinPoints == 0 {
+			return sdkerrors.Wrapf(wasmtypes.ErrInvalid, "tier %d: min points must not be empty", i)
+		}
+		if i != 0 && t.MinPoints <= v[i-1].MinPoints {
+			return sdkerrors.Wrapf(wasmtypes.ErrInvalid, "tier %d: min points must be sorted asc without duplicates", i)
+		}
+		if t.GasPriceMultiplier.IsNil() || t.GasPriceMultiplier.IsNegative() || t.GasPriceMultiplier.GT(sdk.OneDec()) {
+			return sdkerrors.Wrapf(wasmtypes.ErrInvalid, "tier %d: gas price multiplier must be in [0, 1]", i)
+		}
+	}
+	return nil
+}
+
+func validateFeePriceTable(i interface{}) error {
+	v, ok := i.(FeePriceTable)
+	if !ok {
+		return sdkerrors.Wrapf(wasmtypes.ErrInvalid, "type: %T", i)
+	}
+	return v.ValidateBasic()
+}
+
+func validateRateLimit(i interface{}) error {
+	v, ok := i.(RateLimitParams)
+	if !ok {
+		return sdkerrors.Wrapf(wasmtypes.ErrInvalid, "type: %T", i)
+	}
+	return v.ValidateBasic()
+}
+
+// GasPriceMultiplier returns the multiplier of the highest tier that the engagement points qualify for.
+// One is returned when no tier matches.
+func GasPriceMultiplier(tiers []EngagementDiscountTier, points uint64) sdk.Dec {
+	multiplier := sdk.OneDec()
+	for _, t := range tiers {
+		if points < t.MinPoints {
+			break
+		}
+		multiplier = t.GasPriceMultiplier
+	}
+	return multiplier
+}
+
+// ValidateBasic performs basic validation. Params of a disabled dynamic base fee are not validated.
+func (p DynamicBaseFeeParams) ValidateBasic() error {
+	if !p.Enabled {
+		return nil
+	}
+	if err := sdk.ValidateDenom(p.Denom); err != nil {
+		return sdkerrors.Wrap(err, "denom")
+	}
+	if p.MinBaseGasPrice.IsNil() || !p.MinBaseGasPrice.IsPositive() {
+		return sdkerrors.Wrap(wasmtypes.ErrInvalid, "min base gas price must be positive")
+	}
+	if p.MaxBaseGasPrice.IsNil() || p.MaxBaseGasPrice.LT(p.MinBaseGasPrice) {
+		return sdkerrors.Wrap(wasmtypes.ErrInvalid, "max base gas price must not be lower than min")
+	}
+	if p.TargetBlockGas == 0 {
+		return sdkerrors.Wrap(wasmtypes.ErrInvalid, "target block gas must not be empty")
+	}
+	if p.MaxChangeRate.IsNil() || !p.MaxChangeRate.IsPositive() || p.MaxChangeRate.GT(sdk.OneDec()) {
+		return sdkerrors.Wrap(wasmtypes.ErrInvalid, "max change rate must be in (0, 1]")
+	}
+	return nil
+}
+
+// NextBaseGasPrice returns the base gas price for the next block. The price changes by up to the max change rate,
+// relative to the deviation of the block gas used from the target, and stays within the min and max bounds.
+func (p DynamicBaseFeeParams) NextBaseGasPrice(current sdk.Dec, blockGasUsed uint64) sdk.Dec {
+	target := sdk.NewDecFromInt(sdk.NewIntFromUint64(p.TargetBlockGas))
+	deviation := sdk.NewDecFromInt(sdk.NewIntFromUint64(blockGasUsed)).Sub(target).Quo(target)
+	if deviation.GT(sdk.OneDec()) {
+		deviation = sdk.OneDec()
+	}
+	next := current.Add(current.Mul(p.MaxChangeRate).Mul(deviation))
+	switch {
+	case next.LT(p.MinBaseGasPrice):
+		return p.MinBaseGasPrice
+	case next.GT(p.MaxBaseGasPrice):
+		return p.MaxBaseGasPrice
+	}
+	return next
+}
+
+// ValidateBasic performs basic validation. The base denom is optional for an empty table.
+func (t FeePriceTable) ValidateBasic() error {
+	if len(t.Prices) == 0 && t.BaseDenom == "" {
+		return nil
+	}
+	if err := sdk.ValidateDenom(t.BaseDenom); err != nil {
+		return sdkerrors.Wrap(err, "base denom")
+	}
+	for i, p := range t.Prices {
+		if err := sdk.ValidateDenom(p.Denom); err != nil {
+			return sdkerrors.Wrapf(err, "price %d: denom", i)
+		}
+		if p.Denom == t.BaseDenom {
+			return sdkerrors.Wrapf(wasmtypes.ErrInvalid, "price %d: must not be the base denom", i)
+		}
+		if i != 0 && p.Denom <= t.Prices[i-1].Denom {
+			return sdkerrors.Wrapf(wasmtypes.ErrInvalid, "price %d: denoms must be sorted asc without duplicates", i)
+		}
+		if p.Price.IsNil() || !p.Price.IsPositive() {
+			return sdkerrors.Wrapf(wasmtypes.ErrInvalid, "price %d: must be positive", i)
+		}
+	}
+	return nil
+}
+
+// Value returns the value of the coins in the base denom. Coins without a price are ignored.
+func (t FeePriceTable) Value(coins sdk.Coins) sdk.Dec {
+	prices := make(map[string]sdk.Dec, len(t.Prices)+1)
+	prices[t.BaseDenom] = sdk.OneDec()
+	for _, p := range t.Prices {
+		prices[p.Denom] = p.Price
+	}
+	r := sdk.ZeroDec()
+	for _, c := range coins {
+		if price, ok := prices[c.Denom]; ok {
+			r = r.Add(price.MulInt(c.Amount))
+		}
+	}
+	return r
+}
+
+// ValidateBasic performs basic validation. The window is optional for a disabled rate limit.
+func (p RateLimitParams) ValidateBasic() error {
+	if p.Enabled() && p.WindowBlocks == 0 {
+		return sdkerrors.Wrap(wasmtypes.ErrInvalid, "window blocks must not be empty")
+	}
+	if p.WindowBlocks > math.MaxInt64 {
+		return sdkerrors.Wrap(wasmtypes.ErrInvalid, "window blocks exceed max int64")
+	}
+	return nil
+}
+
+// Enabled returns true when any limit is set
+func (p RateLimitParams) Enabled() bool {
+	return p.MaxTxs != 0 || p.MaxGas != 0
+}
+
+// WindowStart returns the first block height of the window that contains the given height
+func (p RateLimitParams) WindowStart(height int64) int64 {
+	if p.WindowBlocks == 0 || height <= 0 {
+		return height
+	}
+	return height - height%int64(p.WindowBlocks)
+}
+
+// Exceeded returns true when the usage is above any limit
+func (p RateLimitParams) Exceeded(u RateLimitUsage) bool {
+	return (p.MaxTxs != 0 && u.Txs > p.MaxTxs) || (p.MaxGas != 0 && u.Gas > p.MaxGas)
+}
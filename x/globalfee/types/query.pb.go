@@ -128,228 +128,2766 @@ func (m *QueryMinimumGasPricesResponse) GetMinimumGasPrices() github_com_cosmos_
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryMinimumGasPricesRequest)(nil), "confio.globalfee.v1beta1.QueryMinimumGasPricesRequest")
-	proto.RegisterType((*QueryMinimumGasPricesResponse)(nil), "confio.globalfee.v1beta1.QueryMinimumGasPricesResponse")
+// QueryBypassMinFeeMsgTypesRequest is the request type for the
+// Query/BypassMinFeeMsgTypes RPC method.
+type QueryBypassMinFeeMsgTypesRequest struct{}
+
+func (m *QueryBypassMinFeeMsgTypesRequest) Reset()         { *m = QueryBypassMinFeeMsgTypesRequest{} }
+func (m *QueryBypassMinFeeMsgTypesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBypassMinFeeMsgTypesRequest) ProtoMessage()    {}
+func (*QueryBypassMinFeeMsgTypesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1265df7e439588bb, []int{2}
 }
 
-func init() {
-	proto.RegisterFile("confio/globalfee/v1beta1/query.proto", fileDescriptor_1265df7e439588bb)
+func (m *QueryBypassMinFeeMsgTypesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
 
-var fileDescriptor_1265df7e439588bb = []byte{
-	// 388 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0x4f, 0x8b, 0xd3, 0x40,
-	0x18, 0xc6, 0x33, 0x8a, 0x1e, 0xe2, 0xa5, 0x04, 0x0f, 0xb5, 0xc4, 0x89, 0x04, 0x0f, 0xa2, 0x75,
-	0x86, 0x56, 0x51, 0xf0, 0x58, 0x0b, 0x9e, 0x04, 0xed, 0xd1, 0x4b, 0x99, 0xa4, 0xd3, 0x71, 0x30,
-	0x33, 0x6f, 0x9a, 0x99, 0x88, 0xb9, 0xfa, 0x09, 0x04, 0xbf, 0x85, 0xdf, 0x40, 0xf0, 0xe6, 0xa5,
-	0xc7, 0x82, 0x17, 0x4f, 0xd9, 0xa5, 0xdd, 0xd3, 0x1e, 0xf7, 0x13, 0x2c, 0x4d, 0xd2, 0xfd, 0xd3,
-	0x6e, 0x17, 0xf6, 0x94, 0xc0, 0xf3, 0x7b, 0xdf, 0x87, 0xe7, 0x99, 0xd7, 0x7d, 0x1c, 0x83, 0x9e,
-	0x4a, 0xa0, 0x22, 0x81, 0x88, 0x25, 0x53, 0xce, 0xe9, 0xd7, 0x5e, 0xc4, 0x2d, 0xeb, 0xd1, 0x59,
-	0xce, 0xb3, 0x82, 0xa4, 0x19, 0x58, 0xf0, 0xda, 0x35, 0x45, 0xce, 0x28, 0xd2, 0x50, 0x9d, 0xfb,
-	0x02, 0x04, 0x54, 0x10, 0x5d, 0xff, 0xd5, 0x7c, 0xc7, 0x17, 0x00, 0x22, 0xe1, 0x94, 0xa5, 0x92,
-	0x32, 0xad, 0xc1, 0x32, 0x2b, 0x41, 0x9b, 0x46, 0xc5, 0x31, 0x18, 0x05, 0x86, 0x46, 0xcc, 0x9c,
-	0xdb, 0xc5, 0x20, 0x75, 0xad, 0x87, 0xd8, 0xf5, 0x3f, 0xae, 0xcd, 0xdf, 0x4b, 0x2d, 0x55, 0xae,
-	0xde, 0x31, 0xf3, 0x21, 0x93, 0x31, 0x37, 0x23, 0x3e, 0xcb, 0xb9, 0xb1, 0x61, 0x89, 0xdc, 0x87,
-	0x7b, 0x00, 0x93, 0x82, 0x36, 0xdc, 0xfb, 0x83, 0x5c, 0x4f, 0xd5, 0xe2, 0x58, 0x30, 0x33, 0x4e,
-	0x2b, 0xb9, 0x8d, 0x1e, 0xdd, 0x7e, 0x72, 0xaf, 0xef, 0x93, 0xda, 0x9f, 0xac, 0xfd, 0x37, 0x41,
-	0xc8, 0x90, 0xc7, 0x6f, 0x41, 0xea, 0x41, 0x3a, 0x2f, 0x03, 0xe7, 0xb8, 0x0c, 0xfc, 0xdd, 0xf9,
-	0x2e, 0x28, 0x69, 0xb9, 0x4a, 0x6d, 0x71, 0x52, 0x06, 0x0f, 0x0a, 0xa6, 0x92, 0x37, 0xe1, 0x2e,
-	0x15, 0xfe, 0x3a, 0x08, 0x9e, 0x09, 0x69, 0x3f, 0xe7, 0x11, 0x89, 0x41, 0xd1, 0x26, 0x6c, 0xfd,
-	0x79, 0x6e, 0x26, 0x5f, 0xa8, 0x2d, 0x52, 0x6e, 0x36, 0x86, 0x66, 0xd4, 0x52, 0x5b, 0x31, 0xfa,
-	0x7f, 0x91, 0x7b, 0xa7, 0x0a, 0xe8, 0xfd, 0x46, 0x6e, 0x6b, 0x3b, 0xa5, 0xf7, 0x8a, 0xec, 0x7b,
-	0x0e, 0x72, 0x5d, 0x6f, 0x9d, 0xd7, 0x37, 0x9e, 0xab, 0xeb, 0x0c, 0x5f, 0x7e, 0xff, 0x77, 0xf4,
-	0xf3, 0x16, 0xf1, 0xba, 0xd4, 0x8a, 0x8c, 0x4d, 0xf8, 0x15, 0xd7, 0xb2, 0xdb, 0xc3, 0x60, 0x38,
-	0x5f, 0x62, 0xb4, 0x58, 0x62, 0x74, 0xb8, 0xc4, 0xe8, 0xc7, 0x0a, 0x3b, 0x8b, 0x15, 0x76, 0xfe,
-	0xaf, 0xb0, 0xf3, 0xe9, 0xe9, 0xa5, 0x7a, 0xaa, 0xfb, 0x6b, 0x16, 0x7f, 0xbb, 0xb0, 0xba, 0xaa,
-	0x29, 0xba, 0x5b, 0xdd, 0xc4, 0x8b, 0xd3, 0x00, 0x00, 0x00, 0xff, 0xff, 0xef, 0x81, 0x03, 0xd0,
-	0xa9, 0x02, 0x00, 0x00,
+func (m *QueryBypassMinFeeMsgTypesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBypassMinFeeMsgTypesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *QueryBypassMinFeeMsgTypesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBypassMinFeeMsgTypesRequest.Merge(m, src)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *QueryBypassMinFeeMsgTypesRequest) XXX_Size() int {
+	return m.Size()
+}
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	MinimumGasPrices(ctx context.Context, in *QueryMinimumGasPricesRequest, opts ...grpc.CallOption) (*QueryMinimumGasPricesResponse, error)
+func (m *QueryBypassMinFeeMsgTypesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBypassMinFeeMsgTypesRequest.DiscardUnknown(m)
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+var xxx_messageInfo_QueryBypassMinFeeMsgTypesRequest proto.InternalMessageInfo
+
+// QueryBypassMinFeeMsgTypesResponse is the response type for the
+// Query/BypassMinFeeMsgTypes RPC method.
+type QueryBypassMinFeeMsgTypesResponse struct {
+	BypassMinFeeMsgTypes            []string `protobuf:"bytes,1,rep,name=bypass_min_fee_msg_types,json=bypassMinFeeMsgTypes,proto3" json:"bypass_min_fee_msg_types,omitempty" yaml:"bypass_min_fee_msg_types"`
+	MaxTotalBypassMinFeeMsgGasUsage uint64   `protobuf:"varint,2,opt,name=max_total_bypass_min_fee_msg_gas_usage,json=maxTotalBypassMinFeeMsgGasUsage,proto3" json:"max_total_bypass_min_fee_msg_gas_usage,omitempty" yaml:"max_total_bypass_min_fee_msg_gas_usage"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryBypassMinFeeMsgTypesResponse) Reset()         { *m = QueryBypassMinFeeMsgTypesResponse{} }
+func (m *QueryBypassMinFeeMsgTypesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBypassMinFeeMsgTypesResponse) ProtoMessage()    {}
+func (*QueryBypassMinFeeMsgTypesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1265df7e439588bb, []int{3}
 }
 
-func (c *queryClient) MinimumGasPrices(ctx context.Context, in *QueryMinimumGasPricesRequest, opts ...grpc.CallOption) (*QueryMinimumGasPricesResponse, error) {
-	out := new(QueryMinimumGasPricesResponse)
-	err := c.cc.Invoke(ctx, "/confio.globalfee.v1beta1.Query/MinimumGasPrices", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryBypassMinFeeMsgTypesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryBypassMinFeeMsgTypesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBypassMinFeeMsgTypesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	MinimumGasPrices(context.Context, *QueryMinimumGasPricesRequest) (*QueryMinimumGasPricesResponse, error)
+func (m *QueryBypassMinFeeMsgTypesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBypassMinFeeMsgTypesResponse.Merge(m, src)
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct{}
+func (m *QueryBypassMinFeeMsgTypesResponse) XXX_Size() int {
+	return m.Size()
+}
 
-func (*UnimplementedQueryServer) MinimumGasPrices(ctx context.Context, req *QueryMinimumGasPricesRequest) (*QueryMinimumGasPricesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MinimumGasPrices not implemented")
+func (m *QueryBypassMinFeeMsgTypesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBypassMinFeeMsgTypesResponse.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+var xxx_messageInfo_QueryBypassMinFeeMsgTypesResponse proto.InternalMessageInfo
+
+func (m *QueryBypassMinFeeMsgTypesResponse) GetBypassMinFeeMsgTypes() []string {
+	if m != nil {
+		return m.BypassMinFeeMsgTypes
+	}
+	return nil
 }
 
-func _Query_MinimumGasPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryMinimumGasPricesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *QueryBypassMinFeeMsgTypesResponse) GetMaxTotalBypassMinFeeMsgGasUsage() uint64 {
+	if m != nil {
+		return m.MaxTotalBypassMinFeeMsgGasUsage
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).MinimumGasPrices(ctx, in)
+	return 0
+}
+
+// QueryBaseGasPriceRequest is the request type for the Query/BaseGasPrice RPC
+// method.
+type QueryBaseGasPriceRequest struct{}
+
+func (m *QueryBaseGasPriceRequest) Reset()         { *m = QueryBaseGasPriceRequest{} }
+func (m *QueryBaseGasPriceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBaseGasPriceRequest) ProtoMessage()    {}
+func (*QueryBaseGasPriceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1265df7e439588bb, []int{4}
+}
+
+func (m *QueryBaseGasPriceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryBaseGasPriceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBaseGasPriceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/confio.globalfee.v1beta1.Query/MinimumGasPrices",
+}
+
+func (m *QueryBaseGasPriceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBaseGasPriceRequest.Merge(m, src)
+}
+
+func (m *QueryBaseGasPriceRequest) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QueryBaseGasPriceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBaseGasPriceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBaseGasPriceRequest proto.InternalMessageInfo
+
+// QueryBaseGasPriceResponse is the response type for the Query/BaseGasPrice
+// RPC method.
+type QueryBaseGasPriceResponse struct {
+	// BaseGasPrice is empty when the dynamic base fee is disabled
+	BaseGasPrice *types.DecCoin `protobuf:"bytes,1,opt,name=base_gas_price,json=baseGasPrice,proto3" json:"base_gas_price,omitempty" yaml:"base_gas_price"`
+}
+
+func (m *QueryBaseGasPriceResponse) Reset()         { *m = QueryBaseGasPriceResponse{} }
+func (m *QueryBaseGasPriceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBaseGasPriceResponse) ProtoMessage()    {}
+func (*QueryBaseGasPriceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1265df7e439588bb, []int{5}
+}
+
+func (m *QueryBaseGasPriceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryBaseGasPriceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBaseGasPriceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).MinimumGasPrices(ctx, req.(*QueryMinimumGasPricesRequest))
+}
+
+func (m *QueryBaseGasPriceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBaseGasPriceResponse.Merge(m, src)
+}
+
+func (m *QueryBaseGasPriceResponse) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QueryBaseGasPriceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBaseGasPriceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBaseGasPriceResponse proto.InternalMessageInfo
+
+func (m *QueryBaseGasPriceResponse) GetBaseGasPrice() *types.DecCoin {
+	if m != nil {
+		return m.BaseGasPrice
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "confio.globalfee.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "MinimumGasPrices",
-			Handler:    _Query_MinimumGasPrices_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "confio/globalfee/v1beta1/query.proto",
+// QueryEstimateFeeRequest is the request type for the Query/EstimateFee RPC
+// method. Either the TX or the gas must be set.
+type QueryEstimateFeeRequest struct {
+	// TxBytes is the encoded TX that is simulated for the gas used
+	TxBytes []byte `protobuf:"bytes,1,opt,name=tx_bytes,json=txBytes,proto3" json:"tx_bytes,omitempty"`
+	// Gas is used as gas limit when no TX is given
+	Gas uint64 `protobuf:"varint,2,opt,name=gas,proto3" json:"gas,omitempty"`
+	// GasAdjustment is multiplied with the simulated gas used to get the gas
+	// limit. It must not be less than 1. A default of 1.3 is used when not set.
+	GasAdjustment github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=gas_adjustment,json=gasAdjustment,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"gas_adjustment"`
 }
 
-func (m *QueryMinimumGasPricesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryEstimateFeeRequest) Reset()         { *m = QueryEstimateFeeRequest{} }
+func (m *QueryEstimateFeeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEstimateFeeRequest) ProtoMessage()    {}
+func (*QueryEstimateFeeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1265df7e439588bb, []int{6}
+}
+
+func (m *QueryEstimateFeeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryEstimateFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEstimateFeeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
 
-func (m *QueryMinimumGasPricesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryEstimateFeeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEstimateFeeRequest.Merge(m, src)
 }
 
-func (m *QueryMinimumGasPricesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryEstimateFeeRequest) XXX_Size() int {
+	return m.Size()
 }
 
-func (m *QueryMinimumGasPricesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryEstimateFeeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEstimateFeeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEstimateFeeRequest proto.InternalMessageInfo
+
+func (m *QueryEstimateFeeRequest) GetTxBytes() []byte {
+	if m != nil {
+		return m.TxBytes
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryMinimumGasPricesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryEstimateFeeRequest) GetGas() uint64 {
+	if m != nil {
+		return m.Gas
+	}
+	return 0
 }
 
-func (m *QueryMinimumGasPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.MinimumGasPrices) > 0 {
-		for iNdEx := len(m.MinimumGasPrices) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.MinimumGasPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
+// QueryEstimateFeeResponse is the response type for the Query/EstimateFee RPC
+// method.
+type QueryEstimateFeeResponse struct {
+	// Gas is the gas limit for the TX that the fees are calculated for. This is
+	// the adjusted simulated gas or the requested gas. The fees must be scaled
+	// when a different gas limit is submitted.
+	Gas uint64 `protobuf:"varint,1,opt,name=gas,proto3" json:"gas,omitempty"`
+	// Fees contains the required fee in each accepted denom, including the
+	// denoms of the fee price table. Any of them is sufficient. Empty when no
+	// fee is required.
+	Fees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=fees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fees"`
+}
+
+func (m *QueryEstimateFeeResponse) Reset()         { *m = QueryEstimateFeeResponse{} }
+func (m *QueryEstimateFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEstimateFeeResponse) ProtoMessage()    {}
+func (*QueryEstimateFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1265df7e439588bb, []int{7}
+}
+
+func (m *QueryEstimateFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryEstimateFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEstimateFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *QueryEstimateFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEstimateFeeResponse.Merge(m, src)
+}
+
+func (m *QueryEstimateFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QueryEstimateFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEstimateFeeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEstimateFeeResponse proto.InternalMessageInfo
+
+func (m *QueryEstimateFeeResponse) GetGas() uint64 {
+	if m != nil {
+		return m.Gas
+	}
+	return 0
+}
+
+func (m *QueryEstimateFeeResponse) GetFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Fees
+	}
+	return nil
+}
+
+// QueryEffectiveMinGasPricesRequest is the request type for the
+// Query/EffectiveMinGasPrices RPC method.
+type QueryEffectiveMinGasPricesRequest struct {
+	// Address is the bech32 address of the fee payer
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryEffectiveMinGasPricesRequest) Reset()         { *m = QueryEffectiveMinGasPricesRequest{} }
+func (m *QueryEffectiveMinGasPricesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEffectiveMinGasPricesRequest) ProtoMessage()    {}
+func (*QueryEffectiveMinGasPricesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1265df7e439588bb, []int{8}
+}
+
+func (m *QueryEffectiveMinGasPricesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryEffectiveMinGasPricesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEffectiveMinGasPricesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *QueryEffectiveMinGasPricesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEffectiveMinGasPricesRequest.Merge(m, src)
+}
+
+func (m *QueryEffectiveMinGasPricesRequest) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QueryEffectiveMinGasPricesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEffectiveMinGasPricesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEffectiveMinGasPricesRequest proto.InternalMessageInfo
+
+func (m *QueryEffectiveMinGasPricesRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryEffectiveMinGasPricesResponse is the response type for the
+// Query/EffectiveMinGasPrices RPC method.
+type QueryEffectiveMinGasPricesResponse struct {
+	// MinimumGasPrices contains the discounted minimum gas prices, including the
+	// dynamic base gas price
+	MinimumGasPrices github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=minimum_gas_prices,json=minimumGasPrices,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"minimum_gas_prices,omitempty" yaml:"minimum_gas_prices"`
+	// GasPriceMultiplier is the engagement discount applied to the fee payer
+	GasPriceMultiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=gas_price_multiplier,json=gasPriceMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"gas_price_multiplier" yaml:"gas_price_multiplier"`
+}
+
+func (m *QueryEffectiveMinGasPricesResponse) Reset()         { *m = QueryEffectiveMinGasPricesResponse{} }
+func (m *QueryEffectiveMinGasPricesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEffectiveMinGasPricesResponse) ProtoMessage()    {}
+func (*QueryEffectiveMinGasPricesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1265df7e439588bb, []int{9}
+}
+
+func (m *QueryEffectiveMinGasPricesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryEffectiveMinGasPricesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEffectiveMinGasPricesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *QueryEffectiveMinGasPricesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEffectiveMinGasPricesResponse.Merge(m, src)
+}
+
+func (m *QueryEffectiveMinGasPricesResponse) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QueryEffectiveMinGasPricesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEffectiveMinGasPricesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEffectiveMinGasPricesResponse proto.InternalMessageInfo
+
+func (m *QueryEffectiveMinGasPricesResponse) GetMinimumGasPrices() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.MinimumGasPrices
+	}
+	return nil
+}
+
+// QueryFeePriceTableRequest is the request type for the Query/FeePriceTable RPC
+// method.
+type QueryFeePriceTableRequest struct{}
+
+func (m *QueryFeePriceTableRequest) Reset()         { *m = QueryFeePriceTableRequest{} }
+func (m *QueryFeePriceTableRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeePriceTableRequest) ProtoMessage()    {}
+func (*QueryFeePriceTableRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1265df7e439588bb, []int{10}
+}
+
+func (m *QueryFeePriceTableRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryFeePriceTableRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeePriceTableRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *QueryFeePriceTableRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeePriceTableRequest.Merge(m, src)
+}
+
+func (m *QueryFeePriceTableRequest) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QueryFeePriceTableRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeePriceTableRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeePriceTableRequest proto.InternalMessageInfo
+
+// QueryFeePriceTableResponse is the response type for the Query/FeePriceTable
+// RPC method.
+type QueryFeePriceTableResponse struct {
+	FeePriceTable FeePriceTable `protobuf:"bytes,1,opt,name=fee_price_table,json=feePriceTable,proto3" json:"fee_price_table" yaml:"fee_price_table"`
+}
+
+func (m *QueryFeePriceTableResponse) Reset()         { *m = QueryFeePriceTableResponse{} }
+func (m *QueryFeePriceTableResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeePriceTableResponse) ProtoMessage()    {}
+func (*QueryFeePriceTableResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1265df7e439588bb, []int{11}
+}
+
+func (m *QueryFeePriceTableResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryFeePriceTableResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeePriceTableResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *QueryFeePriceTableResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeePriceTableResponse.Merge(m, src)
+}
+
+func (m *QueryFeePriceTableResponse) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QueryFeePriceTableResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeePriceTableResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeePriceTableResponse proto.InternalMessageInfo
+
+func (m *QueryFeePriceTableResponse) GetFeePriceTable() FeePriceTable {
+	if m != nil {
+		return m.FeePriceTable
+	}
+	return FeePriceTable{}
+}
+
+// QueryRateLimitUsageRequest is the request type for the Query/RateLimitUsage
+// RPC method.
+type QueryRateLimitUsageRequest struct {
+	// Address is the bech32 address of the account
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryRateLimitUsageRequest) Reset()         { *m = QueryRateLimitUsageRequest{} }
+func (m *QueryRateLimitUsageRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRateLimitUsageRequest) ProtoMessage()    {}
+func (*QueryRateLimitUsageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1265df7e439588bb, []int{12}
+}
+
+func (m *QueryRateLimitUsageRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryRateLimitUsageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRateLimitUsageRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *QueryRateLimitUsageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRateLimitUsageRequest.Merge(m, src)
+}
+
+func (m *QueryRateLimitUsageRequest) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QueryRateLimitUsageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRateLimitUsageRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRateLimitUsageRequest proto.InternalMessageInfo
+
+func (m *QueryRateLimitUsageRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryRateLimitUsageResponse is the response type for the
+// Query/RateLimitUsage RPC method.
+type QueryRateLimitUsageResponse struct {
+	// Usage of the account within the current window
+	Usage RateLimitUsage `protobuf:"bytes,1,opt,name=usage,proto3" json:"usage"`
+	// Limits are the current rate limit params
+	Limits RateLimitParams `protobuf:"bytes,2,opt,name=limits,proto3" json:"limits"`
+	// Exempt is true for accounts that are not rate limited
+	Exempt bool `protobuf:"varint,3,opt,name=exempt,proto3" json:"exempt,omitempty"`
+}
+
+func (m *QueryRateLimitUsageResponse) Reset()         { *m = QueryRateLimitUsageResponse{} }
+func (m *QueryRateLimitUsageResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRateLimitUsageResponse) ProtoMessage()    {}
+func (*QueryRateLimitUsageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1265df7e439588bb, []int{13}
+}
+
+func (m *QueryRateLimitUsageResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryRateLimitUsageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRateLimitUsageResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *QueryRateLimitUsageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRateLimitUsageResponse.Merge(m, src)
+}
+
+func (m *QueryRateLimitUsageResponse) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QueryRateLimitUsageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRateLimitUsageResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRateLimitUsageResponse proto.InternalMessageInfo
+
+func (m *QueryRateLimitUsageResponse) GetUsage() RateLimitUsage {
+	if m != nil {
+		return m.Usage
+	}
+	return RateLimitUsage{}
+}
+
+func (m *QueryRateLimitUsageResponse) GetLimits() RateLimitParams {
+	if m != nil {
+		return m.Limits
+	}
+	return RateLimitParams{}
+}
+
+func (m *QueryRateLimitUsageResponse) GetExempt() bool {
+	if m != nil {
+		return m.Exempt
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*QueryMinimumGasPricesRequest)(nil), "confio.globalfee.v1beta1.QueryMinimumGasPricesRequest")
+	proto.RegisterType((*QueryMinimumGasPricesResponse)(nil), "confio.globalfee.v1beta1.QueryMinimumGasPricesResponse")
+	proto.RegisterType((*QueryBypassMinFeeMsgTypesRequest)(nil), "confio.globalfee.v1beta1.QueryBypassMinFeeMsgTypesRequest")
+	proto.RegisterType((*QueryBypassMinFeeMsgTypesResponse)(nil), "confio.globalfee.v1beta1.QueryBypassMinFeeMsgTypesResponse")
+	proto.RegisterType((*QueryBaseGasPriceRequest)(nil), "confio.globalfee.v1beta1.QueryBaseGasPriceRequest")
+	proto.RegisterType((*QueryBaseGasPriceResponse)(nil), "confio.globalfee.v1beta1.QueryBaseGasPriceResponse")
+	proto.RegisterType((*QueryEstimateFeeRequest)(nil), "confio.globalfee.v1beta1.QueryEstimateFeeRequest")
+	proto.RegisterType((*QueryEstimateFeeResponse)(nil), "confio.globalfee.v1beta1.QueryEstimateFeeResponse")
+	proto.RegisterType((*QueryEffectiveMinGasPricesRequest)(nil), "confio.globalfee.v1beta1.QueryEffectiveMinGasPricesRequest")
+	proto.RegisterType((*QueryEffectiveMinGasPricesResponse)(nil), "confio.globalfee.v1beta1.QueryEffectiveMinGasPricesResponse")
+	proto.RegisterType((*QueryFeePriceTableRequest)(nil), "confio.globalfee.v1beta1.QueryFeePriceTableRequest")
+	proto.RegisterType((*QueryFeePriceTableResponse)(nil), "confio.globalfee.v1beta1.QueryFeePriceTableResponse")
+	proto.RegisterType((*QueryRateLimitUsageRequest)(nil), "confio.globalfee.v1beta1.QueryRateLimitUsageRequest")
+	proto.RegisterType((*QueryRateLimitUsageResponse)(nil), "confio.globalfee.v1beta1.QueryRateLimitUsageResponse")
+}
+
+func init() {
+	proto.RegisterFile("confio/globalfee/v1beta1/query.proto", fileDescriptor_1265df7e439588bb)
+}
+
+var fileDescriptor_1265df7e439588bb = []byte{
+	// 1118 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x57, 0xcd, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0x24, 0x69, 0xda, 0x4c, 0x3e, 0x88, 0x46, 0x69, 0x71, 0x36, 0xc1, 0x1b, 0x56, 0x28,
+	0xb8, 0x69, 0xbb, 0x9b, 0xb8, 0x21, 0x48, 0x21, 0x20, 0x61, 0xd2, 0x44, 0x48, 0x58, 0x2a, 0xab,
+	0xf4, 0x82, 0x84, 0x56, 0x63, 0x67, 0xbc, 0x0c, 0x78, 0x77, 0x5c, 0xcf, 0xb8, 0xb2, 0x41, 0x08,
+	0xc1, 0xbd, 0x12, 0x12, 0x67, 0xce, 0x88, 0x4a, 0x48, 0x9c, 0x90, 0x10, 0x1c, 0x39, 0x54, 0xe2,
+	0x52, 0x89, 0x4b, 0xc5, 0xc1, 0xad, 0x92, 0x9e, 0x72, 0xcc, 0x5f, 0x80, 0x66, 0x76, 0x1c, 0xc7,
+	0xf6, 0xae, 0x3f, 0x7a, 0xe4, 0x14, 0x6f, 0xe6, 0xfd, 0xde, 0xfb, 0xbd, 0xdf, 0xbc, 0x8f, 0x5d,
+	0xf8, 0x46, 0x91, 0x85, 0x25, 0xca, 0x1c, 0xbf, 0xcc, 0x0a, 0xb8, 0x5c, 0x22, 0xc4, 0x79, 0xb0,
+	0x59, 0x20, 0x02, 0x6f, 0x3a, 0xf7, 0x6b, 0xa4, 0xda, 0xb0, 0x2b, 0x55, 0x26, 0x18, 0x4a, 0x45,
+	0x56, 0xf6, 0xb9, 0x95, 0xad, 0xad, 0x8c, 0x45, 0x9f, 0xf9, 0x4c, 0x19, 0x39, 0xf2, 0x57, 0x64,
+	0x6f, 0xac, 0xf8, 0x8c, 0xf9, 0x65, 0xe2, 0xe0, 0x0a, 0x75, 0x70, 0x18, 0x32, 0x81, 0x05, 0x65,
+	0x21, 0xd7, 0xa7, 0xe9, 0x22, 0xe3, 0x01, 0xe3, 0x4e, 0x01, 0xf3, 0x76, 0xb8, 0x22, 0xa3, 0xa1,
+	0x3e, 0x5f, 0x4b, 0xe4, 0xe4, 0x93, 0x90, 0x70, 0xaa, 0xfd, 0x58, 0x69, 0xb8, 0xf2, 0xb1, 0x24,
+	0x99, 0xa7, 0x21, 0x0d, 0x6a, 0xc1, 0x01, 0xe6, 0x77, 0xab, 0xb4, 0x48, 0xb8, 0x4b, 0xee, 0xd7,
+	0x08, 0x17, 0x56, 0x13, 0xc0, 0xd7, 0x12, 0x0c, 0x78, 0x85, 0x85, 0x9c, 0xa0, 0x3f, 0x01, 0x44,
+	0x41, 0x74, 0xe8, 0xf9, 0x98, 0x7b, 0x15, 0x75, 0x9c, 0x02, 0xab, 0x13, 0x99, 0x99, 0xec, 0x8a,
+	0x1d, 0xf1, 0xb4, 0x25, 0xcf, 0x56, 0xc2, 0xf6, 0x1e, 0x29, 0x7e, 0xc0, 0x68, 0x98, 0xab, 0x3c,
+	0x6e, 0x9a, 0x63, 0xa7, 0x4d, 0x73, 0xa5, 0x17, 0x7f, 0x93, 0x05, 0x54, 0x90, 0xa0, 0x22, 0x1a,
+	0x67, 0x4d, 0x73, 0xa9, 0x81, 0x83, 0xf2, 0x8e, 0xd5, 0x6b, 0x65, 0x3d, 0x7a, 0x66, 0xde, 0xf0,
+	0xa9, 0xf8, 0xac, 0x56, 0xb0, 0x8b, 0x2c, 0x70, 0xb4, 0x28, 0xd1, 0x9f, 0x5b, 0xfc, 0xe8, 0x0b,
+	0x47, 0x34, 0x2a, 0x84, 0xb7, 0x02, 0x72, 0x77, 0x21, 0xe8, 0x4a, 0xc3, 0xb2, 0xe0, 0xaa, 0xca,
+	0x2f, 0xd7, 0xa8, 0x60, 0xce, 0xf3, 0x34, 0xdc, 0x27, 0x24, 0xcf, 0xfd, 0xc3, 0x46, 0xa5, 0x2d,
+	0xc2, 0xf3, 0x71, 0xf8, 0x7a, 0x1f, 0x23, 0x2d, 0xc4, 0xb7, 0x00, 0xa6, 0x0a, 0xca, 0xc0, 0x0b,
+	0x68, 0xe8, 0x95, 0x08, 0xf1, 0x02, 0xee, 0x7b, 0x8a, 0x81, 0x92, 0x63, 0x3a, 0xf7, 0xe1, 0x69,
+	0xd3, 0xb4, 0x92, 0x6c, 0x3a, 0x52, 0x36, 0xa3, 0x94, 0x93, 0x6c, 0x2d, 0x77, 0xb1, 0x10, 0xc3,
+	0x05, 0xfd, 0x06, 0xe0, 0x5a, 0x80, 0xeb, 0x9e, 0x60, 0x02, 0x97, 0xbd, 0x18, 0xb4, 0xd4, 0xae,
+	0xc6, 0xb1, 0x4f, 0x52, 0xe3, 0xab, 0x20, 0x33, 0x99, 0x23, 0xa7, 0x4d, 0x73, 0x63, 0x38, 0x44,
+	0x07, 0xbf, 0x5b, 0xfa, 0x4a, 0x86, 0x42, 0x5a, 0xae, 0x19, 0xe0, 0xfa, 0xa1, 0xb4, 0xeb, 0x52,
+	0xf0, 0x00, 0xf3, 0x7b, 0xca, 0xc2, 0x80, 0xa9, 0x48, 0x61, 0xcc, 0x49, 0xeb, 0x72, 0x5a, 0xf2,
+	0x7f, 0x09, 0x97, 0x62, 0xce, 0xb4, 0xea, 0x9f, 0xc2, 0x79, 0x59, 0x5b, 0xed, 0xa2, 0x48, 0x81,
+	0x55, 0x30, 0xb0, 0xf2, 0x96, 0xce, 0x9a, 0xe6, 0x55, 0x2d, 0x71, 0x07, 0xda, 0x72, 0x67, 0x0b,
+	0x17, 0xc2, 0x58, 0x3f, 0x02, 0xf8, 0xaa, 0x0a, 0x7e, 0x87, 0x0b, 0x1a, 0x60, 0x41, 0xf6, 0x49,
+	0x8b, 0x17, 0x5a, 0x82, 0x57, 0x44, 0xdd, 0x2b, 0x34, 0x84, 0xba, 0x5f, 0x90, 0x99, 0x75, 0x2f,
+	0x8b, 0x7a, 0x4e, 0x3e, 0xa2, 0x05, 0x38, 0xe1, 0x63, 0x1e, 0x69, 0xec, 0xca, 0x9f, 0xe8, 0x1e,
+	0x9c, 0x97, 0x41, 0xf0, 0xd1, 0xe7, 0x35, 0x2e, 0x02, 0x12, 0x8a, 0xd4, 0xc4, 0x2a, 0xc8, 0x4c,
+	0xe7, 0x6c, 0xd9, 0x03, 0xff, 0x36, 0xcd, 0xb5, 0xe1, 0xca, 0xd8, 0x9d, 0xf3, 0x31, 0x7f, 0xff,
+	0xdc, 0x89, 0xf5, 0x10, 0xc0, 0x54, 0x2f, 0x3f, 0xad, 0x8d, 0x66, 0x01, 0xda, 0x2c, 0x3c, 0x38,
+	0x59, 0x22, 0x44, 0x12, 0x93, 0xdd, 0xb9, 0x14, 0xab, 0x91, 0x12, 0x68, 0x43, 0xd2, 0x7a, 0xf4,
+	0xcc, 0xcc, 0x0c, 0x41, 0x2b, 0x6a, 0x2d, 0xe5, 0xd8, 0x7a, 0x57, 0x77, 0xca, 0x9d, 0x52, 0x89,
+	0x14, 0x05, 0x7d, 0x40, 0xf2, 0x34, 0xec, 0x1e, 0x2a, 0x28, 0x05, 0x2f, 0xe3, 0xa3, 0xa3, 0x2a,
+	0xe1, 0x11, 0xb7, 0x69, 0xb7, 0xf5, 0x68, 0xbd, 0x18, 0x87, 0x56, 0x3f, 0xfc, 0xff, 0x62, 0xe6,
+	0xa0, 0x6f, 0xe0, 0xe2, 0xb9, 0x3f, 0x2f, 0xa8, 0x95, 0x05, 0xad, 0x94, 0x29, 0xa9, 0xaa, 0x72,
+	0x99, 0xce, 0xe5, 0x47, 0xab, 0x88, 0xb3, 0xa6, 0xb9, 0x1c, 0x71, 0x8d, 0xf3, 0x69, 0xb9, 0xc8,
+	0xd7, 0x71, 0xf3, 0xed, 0x7f, 0x2e, 0xeb, 0x8e, 0xda, 0x27, 0x44, 0x1d, 0x1d, 0xe2, 0x42, 0xf9,
+	0xbc, 0xdd, 0x1e, 0x02, 0x68, 0xc4, 0x9d, 0x6a, 0xed, 0x19, 0x7c, 0x45, 0x36, 0x78, 0x14, 0x48,
+	0xc8, 0x23, 0xdd, 0x71, 0x6f, 0xda, 0x49, 0x1b, 0xce, 0xee, 0xf0, 0x94, 0x4b, 0xcb, 0x04, 0xcf,
+	0x9a, 0xe6, 0xb5, 0x88, 0x76, 0x97, 0x37, 0xcb, 0x9d, 0x2b, 0x5d, 0x34, 0xb7, 0xb6, 0x35, 0x1d,
+	0x17, 0x0b, 0xf2, 0x11, 0x0d, 0xa8, 0x50, 0x13, 0x63, 0x70, 0x2d, 0xfd, 0x05, 0xe0, 0x72, 0x2c,
+	0x50, 0x27, 0xb2, 0x07, 0x2f, 0x45, 0x93, 0x30, 0xa2, 0x9f, 0x49, 0xa6, 0xdf, 0xe9, 0x20, 0x37,
+	0x29, 0xf9, 0xbb, 0x11, 0x18, 0x1d, 0xc0, 0xa9, 0xb2, 0x3c, 0x8a, 0x9a, 0x7d, 0x26, 0x7b, 0x7d,
+	0x08, 0x37, 0x77, 0x71, 0x15, 0x07, 0x5c, 0xfb, 0xd1, 0x70, 0x74, 0x0d, 0x4e, 0x91, 0xba, 0xac,
+	0x3e, 0x35, 0x18, 0xae, 0xb8, 0xfa, 0x29, 0xfb, 0x2b, 0x84, 0x97, 0x54, 0x1a, 0xe8, 0x77, 0x00,
+	0x17, 0xba, 0xd7, 0x30, 0xda, 0x4e, 0x8e, 0xd7, 0x6f, 0xb1, 0x1b, 0x6f, 0x8f, 0x8c, 0x8b, 0x64,
+	0xb3, 0xb6, 0xbe, 0xfb, 0xe7, 0xc5, 0x0f, 0xe3, 0x36, 0xba, 0xe9, 0x08, 0xbf, 0x8a, 0x8f, 0x48,
+	0xcc, 0x2b, 0x46, 0x6f, 0xd3, 0xa0, 0xbf, 0x01, 0x5c, 0x8c, 0xdb, 0x9e, 0x68, 0x67, 0x00, 0x8f,
+	0x3e, 0x7b, 0xd9, 0x78, 0xe7, 0xa5, 0xb0, 0x3a, 0x8f, 0x1d, 0x95, 0xc7, 0x16, 0xca, 0x26, 0xe7,
+	0x91, 0xb4, 0x7d, 0xd1, 0xcf, 0x00, 0xce, 0x5e, 0xdc, 0x46, 0x28, 0x3b, 0x88, 0x49, 0xef, 0x5a,
+	0x33, 0x6e, 0x8f, 0x84, 0xd1, 0xac, 0x37, 0x14, 0xeb, 0x75, 0x94, 0xe9, 0xc3, 0xba, 0x63, 0xa1,
+	0xa1, 0x9f, 0x00, 0x9c, 0xb9, 0xb0, 0x1c, 0xd0, 0xe6, 0x80, 0xb0, 0xbd, 0x8b, 0xce, 0xc8, 0x8e,
+	0x02, 0xd1, 0x44, 0x37, 0x15, 0xd1, 0x1b, 0xd6, 0x5a, 0x32, 0x51, 0xa2, 0x61, 0x52, 0xdc, 0x1d,
+	0xb0, 0x8e, 0x9e, 0x02, 0x78, 0x35, 0x76, 0xee, 0xa3, 0x41, 0x17, 0xdd, 0x6f, 0xdb, 0x18, 0xbb,
+	0x2f, 0x07, 0xd6, 0x79, 0xec, 0xa9, 0x3c, 0xde, 0x43, 0xbb, 0x7d, 0xf2, 0x68, 0x39, 0x50, 0x95,
+	0xd2, 0x2e, 0x7a, 0xe7, 0x2b, 0x3d, 0x8a, 0xbe, 0x46, 0xbf, 0x00, 0x38, 0xd7, 0x31, 0x04, 0xd1,
+	0xa0, 0xdb, 0x8f, 0x1b, 0xcd, 0xc6, 0xd6, 0x68, 0xa0, 0xce, 0xab, 0x40, 0xd7, 0x93, 0x53, 0xe8,
+	0x9a, 0xc1, 0xe8, 0x0f, 0x00, 0xe7, 0x3b, 0xa7, 0x1e, 0x1a, 0x14, 0x3b, 0x76, 0x3c, 0x1b, 0x6f,
+	0x8d, 0x88, 0xd2, 0x94, 0x77, 0x15, 0xe5, 0x6d, 0xb4, 0x95, 0x4c, 0xb9, 0x2a, 0x2b, 0x47, 0xcd,
+	0xce, 0xe8, 0x25, 0xb3, 0xad, 0x76, 0x6e, 0xef, 0xf1, 0x71, 0x1a, 0x3c, 0x39, 0x4e, 0x83, 0xe7,
+	0xc7, 0x69, 0xf0, 0xfd, 0x49, 0x7a, 0xec, 0xc9, 0x49, 0x7a, 0xec, 0xe9, 0x49, 0x7a, 0xec, 0x93,
+	0xf5, 0x8e, 0x9d, 0xaa, 0xbe, 0x90, 0x74, 0x80, 0xfa, 0x85, 0x10, 0xaa, 0xc9, 0x0b, 0x53, 0xea,
+	0x0b, 0xe9, 0xf6, 0x7f, 0x03, 0x00, 0x35, 0x13, 0x50, 0x4b, 0xdf, 0x0d, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	MinimumGasPrices(ctx context.Context, in *QueryMinimumGasPricesRequest, opts ...grpc.CallOption) (*QueryMinimumGasPricesResponse, error)
+	// BypassMinFeeMsgTypes returns the message types that are free of the global
+	// minimum fee and the total gas limit for such TXs
+	BypassMinFeeMsgTypes(ctx context.Context, in *QueryBypassMinFeeMsgTypesRequest, opts ...grpc.CallOption) (*QueryBypassMinFeeMsgTypesResponse, error)
+	// BaseGasPrice returns the current dynamic base gas price
+	BaseGasPrice(ctx context.Context, in *QueryBaseGasPriceRequest, opts ...grpc.CallOption) (*QueryBaseGasPriceResponse, error)
+	// EstimateFee returns the fees required by the global fee rules for a TX or
+	// a gas amount
+	EstimateFee(ctx context.Context, in *QueryEstimateFeeRequest, opts ...grpc.CallOption) (*QueryEstimateFeeResponse, error)
+	// EffectiveMinGasPrices returns the minimum gas prices for a fee payer after
+	// the engagement discount
+	EffectiveMinGasPrices(ctx context.Context, in *QueryEffectiveMinGasPricesRequest, opts ...grpc.CallOption) (*QueryEffectiveMinGasPricesResponse, error)
+	// FeePriceTable returns the fixed prices of accepted fee denoms in the base
+	// denom
+	FeePriceTable(ctx context.Context, in *QueryFeePriceTableRequest, opts ...grpc.CallOption) (*QueryFeePriceTableResponse, error)
+	// RateLimitUsage returns the TXs and gas of an account within the current
+	// rate limit window
+	RateLimitUsage(ctx context.Context, in *QueryRateLimitUsageRequest, opts ...grpc.CallOption) (*QueryRateLimitUsageResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) MinimumGasPrices(ctx context.Context, in *QueryMinimumGasPricesRequest, opts ...grpc.CallOption) (*QueryMinimumGasPricesResponse, error) {
+	out := new(QueryMinimumGasPricesResponse)
+	err := c.cc.Invoke(ctx, "/confio.globalfee.v1beta1.Query/MinimumGasPrices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BypassMinFeeMsgTypes(ctx context.Context, in *QueryBypassMinFeeMsgTypesRequest, opts ...grpc.CallOption) (*QueryBypassMinFeeMsgTypesResponse, error) {
+	out := new(QueryBypassMinFeeMsgTypesResponse)
+	err := c.cc.Invoke(ctx, "/confio.globalfee.v1beta1.Query/BypassMinFeeMsgTypes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BaseGasPrice(ctx context.Context, in *QueryBaseGasPriceRequest, opts ...grpc.CallOption) (*QueryBaseGasPriceResponse, error) {
+	out := new(QueryBaseGasPriceResponse)
+	err := c.cc.Invoke(ctx, "/confio.globalfee.v1beta1.Query/BaseGasPrice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EstimateFee(ctx context.Context, in *QueryEstimateFeeRequest, opts ...grpc.CallOption) (*QueryEstimateFeeResponse, error) {
+	out := new(QueryEstimateFeeResponse)
+	err := c.cc.Invoke(ctx, "/confio.globalfee.v1beta1.Query/EstimateFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EffectiveMinGasPrices(ctx context.Context, in *QueryEffectiveMinGasPricesRequest, opts ...grpc.CallOption) (*QueryEffectiveMinGasPricesResponse, error) {
+	out := new(QueryEffectiveMinGasPricesResponse)
+	err := c.cc.Invoke(ctx, "/confio.globalfee.v1beta1.Query/EffectiveMinGasPrices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeePriceTable(ctx context.Context, in *QueryFeePriceTableRequest, opts ...grpc.CallOption) (*QueryFeePriceTableResponse, error) {
+	out := new(QueryFeePriceTableResponse)
+	err := c.cc.Invoke(ctx, "/confio.globalfee.v1beta1.Query/FeePriceTable", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RateLimitUsage(ctx context.Context, in *QueryRateLimitUsageRequest, opts ...grpc.CallOption) (*QueryRateLimitUsageResponse, error) {
+	out := new(QueryRateLimitUsageResponse)
+	err := c.cc.Invoke(ctx, "/confio.globalfee.v1beta1.Query/RateLimitUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	MinimumGasPrices(context.Context, *QueryMinimumGasPricesRequest) (*QueryMinimumGasPricesResponse, error)
+	// BypassMinFeeMsgTypes returns the message types that are free of the global
+	// minimum fee and the total gas limit for such TXs
+	BypassMinFeeMsgTypes(context.Context, *QueryBypassMinFeeMsgTypesRequest) (*QueryBypassMinFeeMsgTypesResponse, error)
+	// BaseGasPrice returns the current dynamic base gas price
+	BaseGasPrice(context.Context, *QueryBaseGasPriceRequest) (*QueryBaseGasPriceResponse, error)
+	// EstimateFee returns the fees required by the global fee rules for a TX or
+	// a gas amount
+	EstimateFee(context.Context, *QueryEstimateFeeRequest) (*QueryEstimateFeeResponse, error)
+	// EffectiveMinGasPrices returns the minimum gas prices for a fee payer after
+	// the engagement discount
+	EffectiveMinGasPrices(context.Context, *QueryEffectiveMinGasPricesRequest) (*QueryEffectiveMinGasPricesResponse, error)
+	// FeePriceTable returns the fixed prices of accepted fee denoms in the base
+	// denom
+	FeePriceTable(context.Context, *QueryFeePriceTableRequest) (*QueryFeePriceTableResponse, error)
+	// RateLimitUsage returns the TXs and gas of an account within the current
+	// rate limit window
+	RateLimitUsage(context.Context, *QueryRateLimitUsageRequest) (*QueryRateLimitUsageResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct{}
+
+func (*UnimplementedQueryServer) MinimumGasPrices(ctx context.Context, req *QueryMinimumGasPricesRequest) (*QueryMinimumGasPricesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MinimumGasPrices not implemented")
+}
+
+func (*UnimplementedQueryServer) BypassMinFeeMsgTypes(ctx context.Context, req *QueryBypassMinFeeMsgTypesRequest) (*QueryBypassMinFeeMsgTypesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BypassMinFeeMsgTypes not implemented")
+}
+
+func (*UnimplementedQueryServer) BaseGasPrice(ctx context.Context, req *QueryBaseGasPriceRequest) (*QueryBaseGasPriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BaseGasPrice not implemented")
+}
+
+func (*UnimplementedQueryServer) EstimateFee(ctx context.Context, req *QueryEstimateFeeRequest) (*QueryEstimateFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateFee not implemented")
+}
+
+func (*UnimplementedQueryServer) EffectiveMinGasPrices(ctx context.Context, req *QueryEffectiveMinGasPricesRequest) (*QueryEffectiveMinGasPricesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EffectiveMinGasPrices not implemented")
+}
+
+func (*UnimplementedQueryServer) FeePriceTable(ctx context.Context, req *QueryFeePriceTableRequest) (*QueryFeePriceTableResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeePriceTable not implemented")
+}
+
+func (*UnimplementedQueryServer) RateLimitUsage(ctx context.Context, req *QueryRateLimitUsageRequest) (*QueryRateLimitUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RateLimitUsage not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_MinimumGasPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMinimumGasPricesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MinimumGasPrices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/confio.globalfee.v1beta1.Query/MinimumGasPrices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MinimumGasPrices(ctx, req.(*QueryMinimumGasPricesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BypassMinFeeMsgTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBypassMinFeeMsgTypesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BypassMinFeeMsgTypes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/confio.globalfee.v1beta1.Query/BypassMinFeeMsgTypes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BypassMinFeeMsgTypes(ctx, req.(*QueryBypassMinFeeMsgTypesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BaseGasPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBaseGasPriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BaseGasPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/confio.globalfee.v1beta1.Query/BaseGasPrice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BaseGasPrice(ctx, req.(*QueryBaseGasPriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EstimateFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEstimateFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EstimateFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/confio.globalfee.v1beta1.Query/EstimateFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EstimateFee(ctx, req.(*QueryEstimateFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EffectiveMinGasPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEffectiveMinGasPricesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EffectiveMinGasPrices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/confio.globalfee.v1beta1.Query/EffectiveMinGasPrices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EffectiveMinGasPrices(ctx, req.(*QueryEffectiveMinGasPricesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeePriceTable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeePriceTableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeePriceTable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/confio.globalfee.v1beta1.Query/FeePriceTable",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeePriceTable(ctx, req.(*QueryFeePriceTableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RateLimitUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRateLimitUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RateLimitUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/confio.globalfee.v1beta1.Query/RateLimitUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RateLimitUsage(ctx, req.(*QueryRateLimitUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "confio.globalfee.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "MinimumGasPrices",
+			Handler:    _Query_MinimumGasPrices_Handler,
+		},
+		{
+			MethodName: "BypassMinFeeMsgTypes",
+			Handler:    _Query_BypassMinFeeMsgTypes_Handler,
+		},
+		{
+			MethodName: "BaseGasPrice",
+			Handler:    _Query_BaseGasPrice_Handler,
+		},
+		{
+			MethodName: "EstimateFee",
+			Handler:    _Query_EstimateFee_Handler,
+		},
+		{
+			MethodName: "EffectiveMinGasPrices",
+			Handler:    _Query_EffectiveMinGasPrices_Handler,
+		},
+		{
+			MethodName: "FeePriceTable",
+			Handler:    _Query_FeePriceTable_Handler,
+		},
+		{
+			MethodName: "RateLimitUsage",
+			Handler:    _Query_RateLimitUsage_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "confio/globalfee/v1beta1/query.proto",
+}
+
+func (m *QueryMinimumGasPricesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMinimumGasPricesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMinimumGasPricesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMinimumGasPricesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMinimumGasPricesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMinimumGasPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MinimumGasPrices) > 0 {
+		for iNdEx := len(m.MinimumGasPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinimumGasPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBypassMinFeeMsgTypesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBypassMinFeeMsgTypesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBypassMinFeeMsgTypesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBypassMinFeeMsgTypesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBypassMinFeeMsgTypesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBypassMinFeeMsgTypesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxTotalBypassMinFeeMsgGasUsage != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxTotalBypassMinFeeMsgGasUsage))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.BypassMinFeeMsgTypes) > 0 {
+		for iNdEx := len(m.BypassMinFeeMsgTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BypassMinFeeMsgTypes[iNdEx])
+			copy(dAtA[i:], m.BypassMinFeeMsgTypes[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.BypassMinFeeMsgTypes[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBaseGasPriceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBaseGasPriceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBaseGasPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBaseGasPriceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBaseGasPriceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBaseGasPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BaseGasPrice != nil {
+		{
+			size, err := m.BaseGasPrice.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEstimateFeeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEstimateFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEstimateFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.GasAdjustment.Size()
+		i -= size
+		if _, err := m.GasAdjustment.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Gas != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Gas))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TxBytes) > 0 {
+		i -= len(m.TxBytes)
+		copy(dAtA[i:], m.TxBytes)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TxBytes)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEstimateFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEstimateFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEstimateFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fees) > 0 {
+		for iNdEx := len(m.Fees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Gas != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Gas))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEffectiveMinGasPricesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEffectiveMinGasPricesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEffectiveMinGasPricesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEffectiveMinGasPricesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEffectiveMinGasPricesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEffectiveMinGasPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.GasPriceMultiplier.Size()
+		i -= size
+		if _, err := m.GasPriceMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.MinimumGasPrices) > 0 {
+		for iNdEx := len(m.MinimumGasPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinimumGasPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeePriceTableRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeePriceTableRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeePriceTableRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeePriceTableResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeePriceTableResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeePriceTableResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.FeePriceTable.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRateLimitUsageRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRateLimitUsageRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRateLimitUsageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRateLimitUsageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRateLimitUsageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRateLimitUsageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Exempt {
+		i--
+		if m.Exempt {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Limits.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Usage.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+
+func (m *QueryMinimumGasPricesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryMinimumGasPricesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MinimumGasPrices) > 0 {
+		for _, e := range m.MinimumGasPrices {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryBypassMinFeeMsgTypesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBypassMinFeeMsgTypesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BypassMinFeeMsgTypes) > 0 {
+		for _, s := range m.BypassMinFeeMsgTypes {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.MaxTotalBypassMinFeeMsgGasUsage != 0 {
+		n += 1 + sovQuery(uint64(m.MaxTotalBypassMinFeeMsgGasUsage))
+	}
+	return n
+}
+
+func (m *QueryBaseGasPriceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBaseGasPriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BaseGasPrice != nil {
+		l = m.BaseGasPrice.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEstimateFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxBytes)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Gas != 0 {
+		n += 1 + sovQuery(uint64(m.Gas))
+	}
+	l = m.GasAdjustment.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryEstimateFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Gas != 0 {
+		n += 1 + sovQuery(uint64(m.Gas))
+	}
+	if len(m.Fees) > 0 {
+		for _, e := range m.Fees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryEffectiveMinGasPricesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEffectiveMinGasPricesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MinimumGasPrices) > 0 {
+		for _, e := range m.MinimumGasPrices {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.GasPriceMultiplier.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryFeePriceTableRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryFeePriceTableResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FeePriceTable.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRateLimitUsageRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRateLimitUsageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Usage.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Limits.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Exempt {
+		n += 2
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+
+func (m *QueryMinimumGasPricesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMinimumGasPricesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMinimumGasPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryMinimumGasPricesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMinimumGasPricesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMinimumGasPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinimumGasPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinimumGasPrices = append(m.MinimumGasPrices, types.DecCoin{})
+			if err := m.MinimumGasPrices[len(m.MinimumGasPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryBypassMinFeeMsgTypesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBypassMinFeeMsgTypesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBypassMinFeeMsgTypesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryBypassMinFeeMsgTypesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBypassMinFeeMsgTypesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBypassMinFeeMsgTypesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BypassMinFeeMsgTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BypassMinFeeMsgTypes = append(m.BypassMinFeeMsgTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTotalBypassMinFeeMsgGasUsage", wireType)
+			}
+			m.MaxTotalBypassMinFeeMsgGasUsage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTotalBypassMinFeeMsgGasUsage |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryBaseGasPriceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBaseGasPriceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBaseGasPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryBaseGasPriceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBaseGasPriceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBaseGasPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseGasPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BaseGasPrice == nil {
+				m.BaseGasPrice = &types.DecCoin{}
+			}
+			if err := m.BaseGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryEstimateFeeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEstimateFeeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEstimateFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxBytes = append(m.TxBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxBytes == nil {
+				m.TxBytes = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
+			}
+			m.Gas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Gas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasAdjustment", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GasAdjustment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryEstimateFeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEstimateFeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEstimateFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
+			}
+			m.Gas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Gas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fees = append(m.Fees, types.Coin{})
+			if err := m.Fees[len(m.Fees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryEffectiveMinGasPricesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEffectiveMinGasPricesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEffectiveMinGasPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryEffectiveMinGasPricesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEffectiveMinGasPricesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEffectiveMinGasPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinimumGasPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinimumGasPrices = append(m.MinimumGasPrices, types.DecCoin{})
+			if err := m.MinimumGasPrices[len(m.MinimumGasPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPriceMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			i--
-			dAtA[i] = 0xa
+			if err := m.GasPriceMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
 
-func (m *QueryMinimumGasPricesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryFeePriceTableRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeePriceTableRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeePriceTableRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryMinimumGasPricesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.MinimumGasPrices) > 0 {
-		for _, e := range m.MinimumGasPrices {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
+func (m *QueryFeePriceTableResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeePriceTableResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeePriceTableResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePriceTable", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeePriceTable.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
 
-func (m *QueryMinimumGasPricesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRateLimitUsageRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -372,12 +2910,44 @@ func (m *QueryMinimumGasPricesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMinimumGasPricesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRateLimitUsageRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMinimumGasPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRateLimitUsageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -400,7 +2970,7 @@ func (m *QueryMinimumGasPricesRequest) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *QueryMinimumGasPricesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRateLimitUsageResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -423,15 +2993,15 @@ func (m *QueryMinimumGasPricesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMinimumGasPricesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRateLimitUsageResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMinimumGasPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRateLimitUsageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinimumGasPrices", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -458,11 +3028,63 @@ func (m *QueryMinimumGasPricesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MinimumGasPrices = append(m.MinimumGasPrices, types.DecCoin{})
-			if err := m.MinimumGasPrices[len(m.MinimumGasPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Usage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Limits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exempt", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Exempt = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1,17 +1,82 @@
 package poe
 
 import (
+	"encoding/json"
 	"fmt"
 
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/gogo/protobuf/proto"
 
 	"github.com/confio/tgrade/x/poe/keeper"
 	"github.com/confio/tgrade/x/poe/types"
+	twasmcontract "github.com/confio/tgrade/x/twasm/contract"
+	twasmtypes "github.com/confio/tgrade/x/twasm/types"
 )
 
+// FeeSponsorApprovalGasLimit is the max gas that a fee sponsor contract can consume to approve the fees
+const FeeSponsorApprovalGasLimit sdk.Gas = 200_000
+
+// FeeSplitSource provides the shares of the collected TX fees
+type FeeSplitSource interface {
+	GetFeeSplit(ctx sdk.Context) types.FeeSplit
+}
+
+// FeeSponsorKeeper is a subset of the twasm keeper to approve fees with a sponsor contract
+type FeeSponsorKeeper interface {
+	types.Sudoer
+	HasPrivilegedContract(ctx sdk.Context, contractAddr sdk.AccAddress, privilegeType twasmtypes.PrivilegeType) (bool, error)
+}
+
+// RejectExtensionOptionsDecorator is an AnteDecorator that rejects all extension
+// options other than a single FeeSponsorExtension
+type RejectExtensionOptionsDecorator struct{}
+
+// NewRejectExtensionOptionsDecorator constructor
+func NewRejectExtensionOptionsDecorator() RejectExtensionOptionsDecorator {
+	return RejectExtensionOptionsDecorator{}
+}
+
+// AnteHandle rejects TXs with unsupported extension options
+func (RejectExtensionOptionsDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	if _, err := feeSponsor(tx); err != nil {
+		return ctx, err
+	}
+	return next(ctx, tx, simulate)
+}
+
+// feeSponsor returns the contract address from the FeeSponsorExtension option of the TX or nil when not set.
+// Returns an error for any other or duplicate extension options.
+func feeSponsor(tx sdk.Tx) (sdk.AccAddress, error) {
+	extTx, ok := tx.(ante.HasExtensionOptionsTx)
+	if !ok {
+		return nil, nil
+	}
+	var sponsor sdk.AccAddress
+	for _, opt := range extTx.GetExtensionOptions() {
+		if opt.TypeUrl != "/"+proto.MessageName(&types.FeeSponsorExtension{}) {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownExtensionOptions, "type: %s", opt.TypeUrl)
+		}
+		if sponsor != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownExtensionOptions, "duplicate fee sponsor")
+		}
+		var ext types.FeeSponsorExtension
+		if err := ext.Unmarshal(opt.Value); err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "fee sponsor extension")
+		}
+		addr, err := sdk.AccAddressFromBech32(ext.Contract)
+		if err != nil {
+			return nil, sdkerrors.Wrap(err, "fee sponsor")
+		}
+		sponsor = addr
+	}
+	return sponsor, nil
+}
+
 // DeductFeeDecorator deducts fees from the first signer of the tx
 // If the first signer does not have the funds to pay for the fees, return with InsufficientFunds error
 // Call next AnteHandler if fees successfully deducted
@@ -21,18 +86,28 @@ type DeductFeeDecorator struct {
 	bankKeeper     types.BankKeeper
 	feegrantKeeper ante.FeegrantKeeper
 	contractSource keeper.ContractSource
+	feeSplitSource FeeSplitSource
 }
 
-func NewDeductFeeDecorator(ak types.AccountKeeper, bk types.BankKeeper, fk ante.FeegrantKeeper, cs keeper.ContractSource) DeductFeeDecorator {
+func NewDeductFeeDecorator(
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	fk ante.FeegrantKeeper,
+	cs keeper.ContractSource,
+	fs FeeSplitSource,
+) DeductFeeDecorator {
 	return DeductFeeDecorator{
 		ak:             ak,
 		bankKeeper:     bk,
 		feegrantKeeper: fk,
 		contractSource: cs,
+		feeSplitSource: fs,
 	}
 }
 
-// AnteHandle has the same logic as ante.DeductFeeDecorator except that the fees are transferred to a PoE VALSET contract
+// AnteHandle has the same logic as ante.DeductFeeDecorator except that the fees are split between the PoE VALSET
+// contract, the COMMUNITY_POOL contract and a burn share. TXs that name a fee sponsor contract are passed on without
+// deduction to the SponsoredFeeDecorator.
 func (dfd DeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
 	feeTx, ok := tx.(sdk.FeeTx)
 	if !ok {
@@ -43,6 +118,17 @@ func (dfd DeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bo
 	feePayer := feeTx.FeePayer()
 	feeGranter := feeTx.FeeGranter()
 
+	sponsor, err := feeSponsor(tx)
+	if err != nil {
+		return ctx, err
+	}
+	if sponsor != nil {
+		if feeGranter != nil {
+			return ctx, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "fee granter and fee sponsor must not be set both")
+		}
+		return next(ctx, tx, simulate)
+	}
+
 	deductFeesFrom := feePayer
 
 	// if feegranter set deduct fee from feegranter account.
@@ -60,16 +146,23 @@ func (dfd DeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bo
 		deductFeesFrom = feeGranter
 	}
 
+	if err := dfd.deductTxFees(ctx, feeTx, deductFeesFrom); err != nil {
+		return ctx, err
+	}
+	return next(ctx, tx, simulate)
+}
+
+// deductTxFees deducts the TX fees from the given account and emits the fee event
+func (dfd DeductFeeDecorator) deductTxFees(ctx sdk.Context, feeTx sdk.FeeTx, deductFeesFrom sdk.AccAddress) error {
 	deductFeesFromAcc := dfd.ak.GetAccount(ctx, deductFeesFrom)
 	if deductFeesFromAcc == nil {
-		return ctx, sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "fee payer address: %s does not exist", deductFeesFrom)
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "fee payer address: %s does not exist", deductFeesFrom)
 	}
 
 	// deduct the fees
 	if !feeTx.GetFee().IsZero() {
-		err = dfd.DeductFees(ctx, deductFeesFromAcc, feeTx.GetFee())
-		if err != nil {
-			return ctx, err
+		if err := dfd.DeductFees(ctx, deductFeesFromAcc, feeTx.GetFee()); err != nil {
+			return err
 		}
 	}
 
@@ -77,24 +170,143 @@ func (dfd DeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bo
 		sdk.NewAttribute(sdk.AttributeKeyFee, feeTx.GetFee().String()),
 	)}
 	ctx.EventManager().EmitEvents(events)
+	return nil
+}
+
+// SponsoredFeeDecorator deducts the fees of TXs that name a fee sponsor contract from the contract after it approved
+// them. It must run after the signature verification so that the contract is called for authenticated fee payers
+// only. TXs without fee sponsor are passed on.
+type SponsoredFeeDecorator struct {
+	fees          DeductFeeDecorator
+	sponsorKeeper FeeSponsorKeeper
+}
+
+func NewSponsoredFeeDecorator(
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	cs keeper.ContractSource,
+	fs FeeSplitSource,
+	sk FeeSponsorKeeper,
+) SponsoredFeeDecorator {
+	return SponsoredFeeDecorator{
+		fees:          NewDeductFeeDecorator(ak, bk, nil, cs, fs),
+		sponsorKeeper: sk,
+	}
+}
 
+// AnteHandle approves the fees with the fee sponsor contract and deducts them from its balance with the fee split
+func (sfd SponsoredFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	sponsor, err := feeSponsor(tx)
+	if err != nil {
+		return ctx, err
+	}
+	if sponsor == nil {
+		return next(ctx, tx, simulate)
+	}
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+	if feeTx.FeeGranter() != nil {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "fee granter and fee sponsor must not be set both")
+	}
+	if err := sfd.approveSponsoredFee(ctx, sponsor, feeTx.FeePayer(), tx.GetMsgs(), feeTx.GetFee()); err != nil {
+		return ctx, err
+	}
+	if err := sfd.fees.deductTxFees(ctx, feeTx, sponsor); err != nil {
+		return ctx, err
+	}
 	return next(ctx, tx, simulate)
 }
 
-// DeductFees deducts fees from the given account.
+// approveSponsoredFee calls the privileged fee sponsor contract to approve the fees within the FeeSponsorApprovalGasLimit
+func (sfd SponsoredFeeDecorator) approveSponsoredFee(ctx sdk.Context, sponsor, payer sdk.AccAddress, msgs []sdk.Msg, fee sdk.Coins) (err error) {
+	if sfd.sponsorKeeper == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "fee sponsors are not enabled")
+	}
+	ok, err := sfd.sponsorKeeper.HasPrivilegedContract(ctx, sponsor, twasmtypes.PrivilegeFeeSponsor)
+	if err != nil {
+		return sdkerrors.Wrap(err, "fee sponsor")
+	}
+	if !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not a fee sponsor", sponsor)
+	}
+	protoMsgs := make([]twasmcontract.ProtoAny, len(msgs))
+	for i, m := range msgs {
+		a, err := codectypes.NewAnyWithValue(m)
+		if err != nil {
+			return sdkerrors.Wrap(err, "encode msg")
+		}
+		protoMsgs[i] = twasmcontract.ProtoAny{TypeURL: a.TypeUrl, Value: a.Value}
+	}
+	bz, err := json.Marshal(twasmcontract.TgradeSudoMsg{ApproveFee: &twasmcontract.ApproveFee{
+		Payer: payer.String(),
+		Msgs:  protoMsgs,
+		Fee:   wasmkeeper.ConvertSdkCoinsToWasmCoins(fee),
+	}})
+	if err != nil {
+		return sdkerrors.Wrap(err, "marshal approve fee msg")
+	}
+
+	gasMeter := sdk.NewGasMeter(FeeSponsorApprovalGasLimit)
+	defer func() {
+		ctx.GasMeter().ConsumeGas(gasMeter.GasConsumedToLimit(), "fee sponsor approval")
+		if r := recover(); r != nil {
+			oog, ok := r.(sdk.ErrorOutOfGas)
+			if !ok {
+				panic(r)
+			}
+			err = sdkerrors.Wrapf(sdkerrors.ErrOutOfGas, "fee sponsor approval: %s", oog.Descriptor)
+		}
+	}()
+	if _, err := sfd.sponsorKeeper.Sudo(ctx.WithGasMeter(gasMeter), sponsor, bz); err != nil {
+		return sdkerrors.Wrap(err, "fee sponsor approval")
+	}
+	return nil
+}
+
+// DeductFees deducts fees from the given account and distributes them by the fee split.
 func (dfd DeductFeeDecorator) DeductFees(ctx sdk.Context, acc authtypes.AccountI, fees sdk.Coins) error {
 	if !fees.IsValid() {
 		return sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "invalid fee amount: %s", fees)
 	}
-	// in POE we have a contract that receives the fees
-	feeCollector, err := dfd.contractSource.GetPoEContractAddress(ctx, types.PoEContractTypeValset)
-	if err != nil {
-		panic(fmt.Sprintf("%s contract address has not been set", types.PoEContractTypeValset))
+	valsetFees, communityPoolFees, burnFees := dfd.feeSplitSource.GetFeeSplit(ctx).Split(fees)
+	// in POE we have contracts that receive the fees
+	if err := dfd.sendToContract(ctx, acc.GetAddress(), types.PoEContractTypeValset, valsetFees); err != nil {
+		return err
 	}
+	if err := dfd.sendToContract(ctx, acc.GetAddress(), types.PoEContractTypeCommunityPool, communityPoolFees); err != nil {
+		return err
+	}
+	if !burnFees.IsZero() {
+		if err := dfd.bankKeeper.SendCoinsFromAccountToModule(ctx, acc.GetAddress(), types.ModuleName, burnFees); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, err.Error())
+		}
+		if err := dfd.bankKeeper.BurnCoins(ctx, types.ModuleName, burnFees); err != nil {
+			return sdkerrors.Wrap(err, "burn fees")
+		}
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeDistributeFee,
+		sdk.NewAttribute(types.AttributeKeyValsetFee, valsetFees.String()),
+		sdk.NewAttribute(types.AttributeKeyCommunityPool, communityPoolFees.String()),
+		sdk.NewAttribute(types.AttributeKeyBurnedFee, burnFees.String()),
+	))
+	return nil
+}
 
-	if err := dfd.bankKeeper.SendCoins(ctx, acc.GetAddress(), feeCollector, fees); err != nil {
+// sendToContract sends the non zero fees to the PoE contract of the given type
+func (dfd DeductFeeDecorator) sendToContract(ctx sdk.Context, from sdk.AccAddress, ctype types.PoEContractType, fees sdk.Coins) error {
+	if fees.IsZero() {
+		return nil
+	}
+	feeCollector, err := dfd.contractSource.GetPoEContractAddress(ctx, ctype)
+	if err != nil {
+		panic(fmt.Sprintf("%s contract address has not been set", ctype))
+	}
+	if err := dfd.bankKeeper.SendCoins(ctx, from, feeCollector, fees); err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, err.Error())
 	}
-
 	return nil
 }
@@ -1,10 +1,13 @@
 package poe
 
 import (
+	"encoding/json"
 	"errors"
 	"testing"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/gogo/protobuf/proto"
 
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
@@ -17,19 +20,30 @@ import (
 
 	"github.com/confio/tgrade/x/poe/keeper"
 	"github.com/confio/tgrade/x/poe/types"
+	twasmcontract "github.com/confio/tgrade/x/twasm/contract"
+	twasmtypes "github.com/confio/tgrade/x/twasm/types"
 )
 
 func TestDeductFeeDecorator(t *testing.T) {
 	var (
-		myContractAddr   sdk.AccAddress = rand.Bytes(address.Len)
-		mySenderAddr     sdk.AccAddress = rand.Bytes(address.Len)
-		myFeeGranterAddr sdk.AccAddress = rand.Bytes(address.Len)
+		myContractAddr      sdk.AccAddress = rand.Bytes(address.Len)
+		myCommunityPoolAddr sdk.AccAddress = rand.Bytes(address.Len)
+		mySenderAddr        sdk.AccAddress = rand.Bytes(address.Len)
+		myFeeGranterAddr    sdk.AccAddress = rand.Bytes(address.Len)
+		mySponsorAddr       sdk.AccAddress = rand.Bytes(address.Len)
 	)
 
 	cs := keeper.PoEKeeperMock{GetPoEContractAddressFn: func(ctx sdk.Context, ctype types.PoEContractType) (sdk.AccAddress, error) {
-		require.Equal(t, types.PoEContractTypeValset, ctype)
-		return myContractAddr, nil
+		switch ctype {
+		case types.PoEContractTypeValset:
+			return myContractAddr, nil
+		case types.PoEContractTypeCommunityPool:
+			return myCommunityPoolAddr, nil
+		}
+		t.Fatalf("unexpected contract type: %s", ctype)
+		return nil, nil
 	}}
+	mySplit := types.FeeSplit{Valset: sdk.NewDecWithPrec(5, 1), CommunityPool: sdk.NewDecWithPrec(3, 1), Burn: sdk.NewDecWithPrec(2, 1)}
 
 	accountsMock := func(expAddr sdk.AccAddress) types.AccountKeeper {
 		return accountKeeperMock{func(ctx sdk.Context, addr sdk.AccAddress) authtypes.AccountI {
@@ -45,12 +59,16 @@ func TestDeductFeeDecorator(t *testing.T) {
 		bank           types.BankKeeper
 		grants         ante.FeegrantKeeper
 		accounts       types.AccountKeeper
+		feeSplit       *types.FeeSplit
+		sponsor        sdk.AccAddress
 		expErr         bool
 		expFeesGranted []capturedGrantedFee
+		expFeeEvent    bool
 	}{
 		"with fee": {
-			feeAmount: sdk.Coins{sdk.NewCoin("ALX", sdk.OneInt())},
-			accounts:  accountsMock(mySenderAddr),
+			expFeeEvent: true,
+			feeAmount:   sdk.Coins{sdk.NewCoin("ALX", sdk.OneInt())},
+			accounts:    accountsMock(mySenderAddr),
 			bank: bankKeeperMock{SendCoinsFn: func(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error {
 				assert.Equal(t, mySenderAddr, fromAddr)
 				assert.Equal(t, myContractAddr, toAddr)
@@ -70,8 +88,9 @@ func TestDeductFeeDecorator(t *testing.T) {
 			expErr:    true,
 		},
 		"with multiple fees": {
-			feeAmount: sdk.Coins{sdk.NewCoin("ALX", sdk.OneInt()), sdk.NewCoin("BLX", sdk.NewInt(2))},
-			accounts:  accountsMock(mySenderAddr),
+			expFeeEvent: true,
+			feeAmount:   sdk.Coins{sdk.NewCoin("ALX", sdk.OneInt()), sdk.NewCoin("BLX", sdk.NewInt(2))},
+			accounts:    accountsMock(mySenderAddr),
 			bank: bankKeeperMock{SendCoinsFn: func(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error {
 				assert.Equal(t, mySenderAddr, fromAddr)
 				assert.Equal(t, myContractAddr, toAddr)
@@ -92,10 +111,11 @@ func TestDeductFeeDecorator(t *testing.T) {
 			expErr:    true,
 		},
 		"with feegranter": {
-			feeAmount: sdk.Coins{sdk.NewCoin("ALX", sdk.OneInt())},
-			granter:   myFeeGranterAddr,
-			accounts:  accountsMock(myFeeGranterAddr),
-			grants:    capturingGrantKeeper,
+			expFeeEvent: true,
+			feeAmount:   sdk.Coins{sdk.NewCoin("ALX", sdk.OneInt())},
+			granter:     myFeeGranterAddr,
+			accounts:    accountsMock(myFeeGranterAddr),
+			grants:      capturingGrantKeeper,
 			bank: bankKeeperMock{SendCoinsFn: func(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error {
 				assert.Equal(t, myFeeGranterAddr, fromAddr)
 				assert.Equal(t, myContractAddr, toAddr)
@@ -123,6 +143,65 @@ func TestDeductFeeDecorator(t *testing.T) {
 			}},
 			expErr: true,
 		},
+		"with fee split": {
+			feeAmount: sdk.Coins{sdk.NewCoin("ALX", sdk.NewInt(10)), sdk.NewCoin("BLX", sdk.NewInt(3))},
+			accounts:  accountsMock(mySenderAddr),
+			feeSplit:  &mySplit,
+			bank: bankKeeperMock{
+				SendCoinsFn: func(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error {
+					assert.Equal(t, mySenderAddr, fromAddr)
+					switch {
+					case toAddr.Equals(myContractAddr):
+						// remainder of BLX rounding goes to the valset
+						assert.Equal(t, sdk.NewCoins(sdk.NewCoin("ALX", sdk.NewInt(5)), sdk.NewCoin("BLX", sdk.NewInt(3))), amt)
+					case toAddr.Equals(myCommunityPoolAddr):
+						assert.Equal(t, sdk.NewCoins(sdk.NewCoin("ALX", sdk.NewInt(3))), amt)
+					default:
+						t.Fatalf("unexpected recipient: %s", toAddr)
+					}
+					return nil
+				},
+				SendCoinsFromAccountToModuleFn: func(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+					assert.Equal(t, mySenderAddr, senderAddr)
+					assert.Equal(t, types.ModuleName, recipientModule)
+					assert.Equal(t, sdk.NewCoins(sdk.NewCoin("ALX", sdk.NewInt(2))), amt)
+					return nil
+				},
+				BurnCoinsFn: func(ctx sdk.Context, moduleName string, amt sdk.Coins) error {
+					assert.Equal(t, types.ModuleName, moduleName)
+					assert.Equal(t, sdk.NewCoins(sdk.NewCoin("ALX", sdk.NewInt(2))), amt)
+					return nil
+				},
+			},
+			expFeeEvent: true,
+		},
+		"burn fails": {
+			feeAmount: sdk.Coins{sdk.NewCoin("ALX", sdk.NewInt(10))},
+			accounts:  accountsMock(mySenderAddr),
+			feeSplit:  &types.FeeSplit{Valset: sdk.ZeroDec(), CommunityPool: sdk.ZeroDec(), Burn: sdk.OneDec()},
+			bank: bankKeeperMock{
+				SendCoinsFromAccountToModuleFn: func(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+					return nil
+				},
+				BurnCoinsFn: func(ctx sdk.Context, moduleName string, amt sdk.Coins) error {
+					return errors.New("testing")
+				},
+			},
+			expErr: true,
+		},
+		"with fee sponsor": {
+			feeAmount: sdk.Coins{sdk.NewCoin("ALX", sdk.OneInt())},
+			sponsor:   mySponsorAddr,
+			accounts:  accountKeeperMock{},
+			bank:      bankKeeperMock{},
+		},
+		"fee sponsor with feegranter": {
+			feeAmount: sdk.Coins{sdk.NewCoin("ALX", sdk.OneInt())},
+			granter:   myFeeGranterAddr,
+			sponsor:   mySponsorAddr,
+			grants:    capturingGrantKeeper,
+			expErr:    true,
+		},
 		"unknown account": {
 			feeAmount: sdk.Coins{sdk.NewCoin("ALX", sdk.OneInt()), sdk.NewCoin("BLX", sdk.NewInt(2))},
 			accounts: accountKeeperMock{func(ctx sdk.Context, addr sdk.AccAddress) authtypes.AccountI {
@@ -136,24 +215,264 @@ func TestDeductFeeDecorator(t *testing.T) {
 			*capturedGrantedFees = nil
 			nextAnte, gotCalled := captureNextHandlerCall()
 			em := sdk.NewEventManager()
-			ctx := sdk.Context{}.WithEventManager(em)
-			decorator := NewDeductFeeDecorator(spec.accounts, spec.bank, spec.grants, cs)
-			_, gotErr := decorator.AnteHandle(ctx, newFeeTXMock(spec.feeAmount, mySenderAddr).WithGranter(spec.granter), false, nextAnte)
+			gasMeter := sdk.NewInfiniteGasMeter()
+			ctx := sdk.Context{}.WithEventManager(em).WithGasMeter(gasMeter)
+			feeSplit := types.DefaultFeeSplit()
+			if spec.feeSplit != nil {
+				feeSplit = *spec.feeSplit
+			}
+			fs := keeper.PoEKeeperMock{GetFeeSplitFn: func(ctx sdk.Context) types.FeeSplit { return feeSplit }}
+			decorator := NewDeductFeeDecorator(spec.accounts, spec.bank, spec.grants, cs, fs)
+			tx := newFeeTXMock(spec.feeAmount, mySenderAddr).WithGranter(spec.granter)
+			if spec.sponsor != nil {
+				tx = tx.WithExtensionOptions(feeSponsorOption(t, spec.sponsor))
+			}
+			_, gotErr := decorator.AnteHandle(ctx, tx, false, nextAnte)
 			if spec.expErr {
 				require.Error(t, gotErr)
 				return
 			}
 			require.NoError(t, gotErr)
 			assert.True(t, *gotCalled, "next ante handler called")
-			// and an event emitted
-			require.Len(t, em.Events(), 1)
-			require.Len(t, em.Events()[0].Attributes, 1)
-			require.Equal(t, []byte(sdk.AttributeKeyFee), em.Events()[0].Attributes[0].Key)
+			// and events emitted
+			events := em.Events()
+			if spec.sponsor != nil {
+				// deducted by the SponsoredFeeDecorator
+				assert.Empty(t, events)
+				return
+			}
+			if spec.expFeeEvent {
+				require.Len(t, events, 2)
+				assert.Equal(t, types.EventTypeDistributeFee, events[0].Type)
+				require.Len(t, events[0].Attributes, 3)
+				events = events[1:]
+			}
+			require.Len(t, events, 1)
+			require.Len(t, events[0].Attributes, 1)
+			require.Equal(t, []byte(sdk.AttributeKeyFee), events[0].Attributes[0].Key)
 			assert.Equal(t, spec.expFeesGranted, *capturedGrantedFees)
 		})
 	}
 }
 
+func TestSponsoredFeeDecorator(t *testing.T) {
+	var (
+		myContractAddr      sdk.AccAddress = rand.Bytes(address.Len)
+		myCommunityPoolAddr sdk.AccAddress = rand.Bytes(address.Len)
+		mySenderAddr        sdk.AccAddress = rand.Bytes(address.Len)
+		myFeeGranterAddr    sdk.AccAddress = rand.Bytes(address.Len)
+		mySponsorAddr       sdk.AccAddress = rand.Bytes(address.Len)
+	)
+
+	cs := keeper.PoEKeeperMock{GetPoEContractAddressFn: func(ctx sdk.Context, ctype types.PoEContractType) (sdk.AccAddress, error) {
+		switch ctype {
+		case types.PoEContractTypeValset:
+			return myContractAddr, nil
+		case types.PoEContractTypeCommunityPool:
+			return myCommunityPoolAddr, nil
+		}
+		t.Fatalf("unexpected contract type: %s", ctype)
+		return nil, nil
+	}}
+	fs := keeper.PoEKeeperMock{GetFeeSplitFn: func(ctx sdk.Context) types.FeeSplit { return types.DefaultFeeSplit() }}
+
+	accountsMock := func(expAddr sdk.AccAddress) types.AccountKeeper {
+		return accountKeeperMock{func(ctx sdk.Context, addr sdk.AccAddress) authtypes.AccountI {
+			require.Equal(t, expAddr, addr)
+			return authtypes.NewBaseAccount(expAddr, nil, 1, 1)
+		}}
+	}
+
+	specs := map[string]struct {
+		feeAmount      sdk.Coins
+		granter        sdk.AccAddress
+		bank           types.BankKeeper
+		accounts       types.AccountKeeper
+		sponsor        sdk.AccAddress
+		sponsors       FeeSponsorKeeper
+		expGasConsumed sdk.Gas
+		expErr         bool
+		expFeeEvent    bool
+	}{
+		"with fee sponsor": {
+			expFeeEvent:    true,
+			expGasConsumed: 1,
+			feeAmount:      sdk.Coins{sdk.NewCoin("ALX", sdk.OneInt())},
+			sponsor:        mySponsorAddr,
+			accounts:       accountsMock(mySponsorAddr),
+			sponsors: feeSponsorKeeperMock{
+				HasPrivilegedContractFn: func(ctx sdk.Context, contractAddr sdk.AccAddress, privilegeType twasmtypes.PrivilegeType) (bool, error) {
+					assert.Equal(t, mySponsorAddr, contractAddr)
+					assert.Equal(t, twasmtypes.PrivilegeFeeSponsor, privilegeType)
+					return true, nil
+				},
+				SudoFn: func(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error) {
+					assert.Equal(t, mySponsorAddr, contractAddress)
+					var got twasmcontract.TgradeSudoMsg
+					require.NoError(t, json.Unmarshal(msg, &got))
+					require.NotNil(t, got.ApproveFee)
+					assert.Equal(t, mySenderAddr.String(), got.ApproveFee.Payer)
+					assert.Len(t, got.ApproveFee.Msgs, 0)
+					assert.Equal(t, "1", got.ApproveFee.Fee[0].Amount)
+					assert.Equal(t, FeeSponsorApprovalGasLimit, ctx.GasMeter().Limit())
+					ctx.GasMeter().ConsumeGas(1, "testing")
+					return nil, nil
+				},
+			},
+			bank: bankKeeperMock{SendCoinsFn: func(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error {
+				assert.Equal(t, mySponsorAddr, fromAddr)
+				assert.Equal(t, myContractAddr, toAddr)
+				return nil
+			}},
+		},
+		"fee sponsor not privileged": {
+			feeAmount: sdk.Coins{sdk.NewCoin("ALX", sdk.OneInt())},
+			sponsor:   mySponsorAddr,
+			sponsors: feeSponsorKeeperMock{
+				HasPrivilegedContractFn: func(ctx sdk.Context, contractAddr sdk.AccAddress, privilegeType twasmtypes.PrivilegeType) (bool, error) {
+					return false, nil
+				},
+			},
+			expErr: true,
+		},
+		"fee sponsor rejects": {
+			feeAmount: sdk.Coins{sdk.NewCoin("ALX", sdk.OneInt())},
+			sponsor:   mySponsorAddr,
+			sponsors: feeSponsorKeeperMock{
+				HasPrivilegedContractFn: func(ctx sdk.Context, contractAddr sdk.AccAddress, privilegeType twasmtypes.PrivilegeType) (bool, error) {
+					return true, nil
+				},
+				SudoFn: func(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error) {
+					return nil, errors.New("testing")
+				},
+			},
+			expErr: true,
+		},
+		"fee sponsor out of gas": {
+			feeAmount: sdk.Coins{sdk.NewCoin("ALX", sdk.OneInt())},
+			sponsor:   mySponsorAddr,
+			sponsors: feeSponsorKeeperMock{
+				HasPrivilegedContractFn: func(ctx sdk.Context, contractAddress sdk.AccAddress, privilegeType twasmtypes.PrivilegeType) (bool, error) {
+					return true, nil
+				},
+				SudoFn: func(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error) {
+					ctx.GasMeter().ConsumeGas(FeeSponsorApprovalGasLimit+1, "testing")
+					return nil, nil
+				},
+			},
+			expGasConsumed: FeeSponsorApprovalGasLimit,
+			expErr:         true,
+		},
+		"fee sponsor with feegranter": {
+			feeAmount: sdk.Coins{sdk.NewCoin("ALX", sdk.OneInt())},
+			granter:   myFeeGranterAddr,
+			sponsor:   mySponsorAddr,
+			expErr:    true,
+		},
+		"without fee sponsor": {
+			feeAmount: sdk.Coins{sdk.NewCoin("ALX", sdk.OneInt())},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			nextAnte, gotCalled := captureNextHandlerCall()
+			em := sdk.NewEventManager()
+			gasMeter := sdk.NewInfiniteGasMeter()
+			ctx := sdk.Context{}.WithEventManager(em).WithGasMeter(gasMeter)
+			decorator := NewSponsoredFeeDecorator(spec.accounts, spec.bank, cs, fs, spec.sponsors)
+			tx := newFeeTXMock(spec.feeAmount, mySenderAddr).WithGranter(spec.granter)
+			if spec.sponsor != nil {
+				tx = tx.WithExtensionOptions(feeSponsorOption(t, spec.sponsor))
+			}
+			_, gotErr := decorator.AnteHandle(ctx, tx, false, nextAnte)
+			assert.Equal(t, spec.expGasConsumed, gasMeter.GasConsumed())
+			if spec.expErr {
+				require.Error(t, gotErr)
+				assert.False(t, *gotCalled)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.True(t, *gotCalled, "next ante handler called")
+			// and events emitted
+			events := em.Events()
+			if !spec.expFeeEvent {
+				assert.Empty(t, events)
+				return
+			}
+			require.Len(t, events, 2)
+			assert.Equal(t, types.EventTypeDistributeFee, events[0].Type)
+			assert.Equal(t, sdk.EventTypeTx, events[1].Type)
+		})
+	}
+}
+
+func TestRejectExtensionOptionsDecorator(t *testing.T) {
+	mySponsorAddr := sdk.AccAddress(rand.Bytes(address.Len))
+	unknownOption, err := codectypes.NewAnyWithValue(&types.MsgCreateValidator{})
+	require.NoError(t, err)
+
+	specs := map[string]struct {
+		opts   []*codectypes.Any
+		expErr bool
+	}{
+		"no options": {},
+		"fee sponsor": {
+			opts: []*codectypes.Any{feeSponsorOption(t, mySponsorAddr)},
+		},
+		"duplicate fee sponsor": {
+			opts:   []*codectypes.Any{feeSponsorOption(t, mySponsorAddr), feeSponsorOption(t, mySponsorAddr)},
+			expErr: true,
+		},
+		"invalid fee sponsor address": {
+			opts:   []*codectypes.Any{feeSponsorOption(t, nil)},
+			expErr: true,
+		},
+		"unknown option": {
+			opts:   []*codectypes.Any{unknownOption},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			nextAnte, gotCalled := captureNextHandlerCall()
+			tx := newFeeTXMock(nil, nil).WithExtensionOptions(spec.opts...)
+			_, gotErr := NewRejectExtensionOptionsDecorator().AnteHandle(sdk.Context{}, tx, false, nextAnte)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				assert.False(t, *gotCalled)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.True(t, *gotCalled)
+		})
+	}
+}
+
+func feeSponsorOption(t *testing.T, sponsor sdk.AccAddress) *codectypes.Any {
+	bz, err := proto.Marshal(&types.FeeSponsorExtension{Contract: sponsor.String()})
+	require.NoError(t, err)
+	return &codectypes.Any{TypeUrl: "/" + proto.MessageName(&types.FeeSponsorExtension{}), Value: bz}
+}
+
+type feeSponsorKeeperMock struct {
+	HasPrivilegedContractFn func(ctx sdk.Context, contractAddr sdk.AccAddress, privilegeType twasmtypes.PrivilegeType) (bool, error)
+	SudoFn                  func(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error)
+}
+
+func (m feeSponsorKeeperMock) HasPrivilegedContract(ctx sdk.Context, contractAddr sdk.AccAddress, privilegeType twasmtypes.PrivilegeType) (bool, error) {
+	if m.HasPrivilegedContractFn == nil {
+		panic("not expected to be called")
+	}
+	return m.HasPrivilegedContractFn(ctx, contractAddr, privilegeType)
+}
+
+func (m feeSponsorKeeperMock) Sudo(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error) {
+	if m.SudoFn == nil {
+		panic("not expected to be called")
+	}
+	return m.SudoFn(ctx, contractAddress, msg)
+}
+
 type capturedGrantedFee struct {
 	feeGranter, feePayer sdk.AccAddress
 	fee                  sdk.Coins
@@ -173,20 +492,21 @@ type bankKeeperMock struct {
 	SendCoinsFromModuleToAccountFn       func(ctx sdk.Context, s string, addr sdk.AccAddress, amt sdk.Coins) error
 	DelegateCoinsFromAccountToModuleFn   func(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
 	UndelegateCoinsFromModuleToAccountFn func(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	BurnCoinsFn                          func(ctx sdk.Context, moduleName string, amt sdk.Coins) error
 }
 
 func (m bankKeeperMock) SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
 	if m.SendCoinsFromAccountToModuleFn == nil {
 		panic("not expected to be called")
 	}
-	return m.SendCoinsFromAccountToModule(ctx, senderAddr, recipientModule, amt)
+	return m.SendCoinsFromAccountToModuleFn(ctx, senderAddr, recipientModule, amt)
 }
 
 func (m bankKeeperMock) SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
 	if m.SendCoinsFromModuleToAccountFn == nil {
 		panic("not expected to be called")
 	}
-	return m.SendCoinsFromModuleToAccount(ctx, senderModule, recipientAddr, amt)
+	return m.SendCoinsFromModuleToAccountFn(ctx, senderModule, recipientAddr, amt)
 }
 
 func (m bankKeeperMock) DelegateCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
@@ -210,6 +530,13 @@ func (m bankKeeperMock) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAd
 	return m.SendCoinsFn(ctx, fromAddr, toAddr, amt)
 }
 
+func (m bankKeeperMock) BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error {
+	if m.BurnCoinsFn == nil {
+		panic("not expected to be called")
+	}
+	return m.BurnCoinsFn(ctx, moduleName, amt)
+}
+
 func captureNextHandlerCall() (sdk.AnteHandler, *bool) {
 	var called bool
 	return func(ctx sdk.Context, tx sdk.Tx, simulate bool) (newCtx sdk.Context, err error) {
@@ -225,6 +552,7 @@ type feeTXMock struct {
 	payer   sdk.AccAddress
 	granter sdk.AccAddress
 	msgs    []sdk.Msg
+	opts    []*codectypes.Any
 }
 
 func newFeeTXMock(fee sdk.Coins, payer sdk.AccAddress) *feeTXMock {
@@ -255,11 +583,24 @@ func (f feeTXMock) GetGas() uint64 {
 	panic("not expected to be called")
 }
 
+func (f feeTXMock) GetExtensionOptions() []*codectypes.Any {
+	return f.opts
+}
+
+func (f feeTXMock) GetNonCriticalExtensionOptions() []*codectypes.Any {
+	return nil
+}
+
 func (f *feeTXMock) WithGranter(granter sdk.AccAddress) feeTXMock {
 	f.granter = granter
 	return *f
 }
 
+func (f feeTXMock) WithExtensionOptions(opts ...*codectypes.Any) feeTXMock {
+	f.opts = opts
+	return f
+}
+
 type feegrantMock struct {
 	UseGrantedFeesFn func(ctx sdk.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error
 }
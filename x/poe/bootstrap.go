@@ -1,7 +1,7 @@
 package poe
 
 import (
-	_ "embed"
+	"embed"
 	"encoding/json"
 	"fmt"
 
@@ -17,39 +17,43 @@ import (
 )
 
 var (
-	//go:embed contract/tg4_engagement.wasm
-	tg4Engagement []byte
-	//go:embed contract/tg4_stake.wasm
-	tg4Stake []byte
-	//go:embed contract/tg4_mixer.wasm
-	tg4Mixer []byte
-	//go:embed contract/tgrade_valset.wasm
-	tgValset []byte
-	//go:embed contract/tgrade_trusted_circle.wasm
-	tgTrustedCircles []byte
-	//go:embed contract/tgrade_oc_proposals.wasm
-	tgOCGovProposalsCircles []byte
-	//go:embed contract/tgrade_community_pool.wasm
-	tgCommunityPool []byte
-	//go:embed contract/tgrade_validator_voting.wasm
-	tgValidatorVoting []byte
-	//go:embed contract/tgrade_ap_voting.wasm
-	tgArbiterPool []byte
+	//go:embed contract/*.wasm
+	embeddedContracts embed.FS
 	//go:embed contract/version.txt
 	contractVersion string
 )
 
-// ClearEmbeddedContracts release memory
-func ClearEmbeddedContracts() {
-	tg4Engagement = nil
-	tg4Stake = nil
-	tg4Mixer = nil
-	tgValset = nil
-	tgTrustedCircles = nil
-	tgOCGovProposalsCircles = nil
-	tgCommunityPool = nil
-	tgValidatorVoting = nil
-	tgArbiterPool = nil
+// embedded wasm files by PoE contract type. Contract types that share a file run the same code.
+var embeddedContractFiles = map[types.PoEContractType]string{
+	types.PoEContractTypeEngagement:                     "contract/tg4_engagement.wasm",
+	types.PoEContractTypeDistribution:                   "contract/tg4_engagement.wasm",
+	types.PoEContractTypeStaking:                        "contract/tg4_stake.wasm",
+	types.PoEContractTypeMixer:                          "contract/tg4_mixer.wasm",
+	types.PoEContractTypeValset:                         "contract/tgrade_valset.wasm",
+	types.PoEContractTypeOversightCommunity:             "contract/tgrade_trusted_circle.wasm",
+	types.PoEContractTypeArbiterPool:                    "contract/tgrade_trusted_circle.wasm",
+	types.PoEContractTypeOversightCommunityGovProposals: "contract/tgrade_oc_proposals.wasm",
+	types.PoEContractTypeCommunityPool:                  "contract/tgrade_community_pool.wasm",
+	types.PoEContractTypeValidatorVoting:                "contract/tgrade_validator_voting.wasm",
+	types.PoEContractTypeArbiterPoolVoting:              "contract/tgrade_ap_voting.wasm",
+}
+
+// embeddedContractCode returns the embedded wasm byte code for the given PoE contract type
+func embeddedContractCode(tp types.PoEContractType) ([]byte, error) {
+	file, ok := embeddedContractFiles[tp]
+	if !ok {
+		return nil, sdkerrors.Wrapf(types.ErrInvalid, "no embedded code for %s", tp.String())
+	}
+	return embeddedContracts.ReadFile(file)
+}
+
+// mustEmbeddedContractCode same as embeddedContractCode but panics on error
+func mustEmbeddedContractCode(tp types.PoEContractType) []byte {
+	code, err := embeddedContractCode(tp)
+	if err != nil {
+		panic(fmt.Sprintf("embedded contract: %s", err))
+	}
+	return code
 }
 
 type poeKeeper interface {
@@ -70,7 +74,7 @@ func BootstrapPoEContracts(ctx sdk.Context, k wasmtypes.ContractOpsKeeper, tk tw
 	// setup engagement contract
 	//
 	tg4EngagementInitMsg := newEngagementInitMsg(gs, bootstrapAccountAddr)
-	engagementCodeID, _, err := k.Create(ctx, bootstrapAccountAddr, tg4Engagement, &wasmtypes.AllowEverybody)
+	engagementCodeID, _, err := k.Create(ctx, bootstrapAccountAddr, mustEmbeddedContractCode(types.PoEContractTypeEngagement), &wasmtypes.AllowEverybody)
 	if err != nil {
 		return sdkerrors.Wrap(err, "store tg4 engagement contract")
 	}
@@ -95,7 +99,7 @@ func BootstrapPoEContracts(ctx sdk.Context, k wasmtypes.ContractOpsKeeper, tk tw
 
 	// setup trusted circle for oversight community
 	//
-	trustedCircleCodeID, _, err := k.Create(ctx, bootstrapAccountAddr, tgTrustedCircles, &wasmtypes.AllowEverybody)
+	trustedCircleCodeID, _, err := k.Create(ctx, bootstrapAccountAddr, mustEmbeddedContractCode(types.PoEContractTypeOversightCommunity), &wasmtypes.AllowEverybody)
 	if err != nil {
 		return sdkerrors.Wrap(err, "store tg trusted circle contract")
 	}
@@ -133,7 +137,7 @@ func BootstrapPoEContracts(ctx sdk.Context, k wasmtypes.ContractOpsKeeper, tk tw
 
 	// setup stake contract
 	//
-	stakeCodeID, _, err := k.Create(ctx, bootstrapAccountAddr, tg4Stake, &wasmtypes.AllowEverybody)
+	stakeCodeID, _, err := k.Create(ctx, bootstrapAccountAddr, mustEmbeddedContractCode(types.PoEContractTypeStaking), &wasmtypes.AllowEverybody)
 	if err != nil {
 		return sdkerrors.Wrap(err, "store tg4 stake contract")
 	}
@@ -169,7 +173,7 @@ func BootstrapPoEContracts(ctx sdk.Context, k wasmtypes.ContractOpsKeeper, tk tw
 			Sigmoid: &poeFunction,
 		},
 	}
-	mixerCodeID, _, err := k.Create(ctx, bootstrapAccountAddr, tg4Mixer, &wasmtypes.AllowEverybody)
+	mixerCodeID, _, err := k.Create(ctx, bootstrapAccountAddr, mustEmbeddedContractCode(types.PoEContractTypeMixer), &wasmtypes.AllowEverybody)
 	if err != nil {
 		return sdkerrors.Wrap(err, "store tg4 mixer contract")
 	}
@@ -193,7 +197,7 @@ func BootstrapPoEContracts(ctx sdk.Context, k wasmtypes.ContractOpsKeeper, tk tw
 
 	// setup community pool
 	//
-	communityPoolCodeID, _, err := k.Create(ctx, bootstrapAccountAddr, tgCommunityPool, &wasmtypes.AllowEverybody)
+	communityPoolCodeID, _, err := k.Create(ctx, bootstrapAccountAddr, mustEmbeddedContractCode(types.PoEContractTypeCommunityPool), &wasmtypes.AllowEverybody)
 	if err != nil {
 		return sdkerrors.Wrap(err, "store community pool contract")
 	}
@@ -221,7 +225,7 @@ func BootstrapPoEContracts(ctx sdk.Context, k wasmtypes.ContractOpsKeeper, tk tw
 
 	// setup valset contract
 	//
-	valSetCodeID, _, err := k.Create(ctx, bootstrapAccountAddr, tgValset, &wasmtypes.AllowEverybody)
+	valSetCodeID, _, err := k.Create(ctx, bootstrapAccountAddr, mustEmbeddedContractCode(types.PoEContractTypeValset), &wasmtypes.AllowEverybody)
 	if err != nil {
 		return sdkerrors.Wrap(err, "store valset contract")
 	}
@@ -262,7 +266,7 @@ func BootstrapPoEContracts(ctx sdk.Context, k wasmtypes.ContractOpsKeeper, tk tw
 
 	// setup oversight community gov proposals contract
 	//
-	ocGovCodeID, _, err := k.Create(ctx, bootstrapAccountAddr, tgOCGovProposalsCircles, &wasmtypes.AllowEverybody)
+	ocGovCodeID, _, err := k.Create(ctx, bootstrapAccountAddr, mustEmbeddedContractCode(types.PoEContractTypeOversightCommunityGovProposals), &wasmtypes.AllowEverybody)
 	if err != nil {
 		return sdkerrors.Wrap(err, "store tg oc gov proposals contract: ")
 	}
@@ -297,7 +301,7 @@ func BootstrapPoEContracts(ctx sdk.Context, k wasmtypes.ContractOpsKeeper, tk tw
 
 	// setup validator voting contract
 	//
-	validatorVotingCodeID, _, err := k.Create(ctx, bootstrapAccountAddr, tgValidatorVoting, &wasmtypes.AllowEverybody)
+	validatorVotingCodeID, _, err := k.Create(ctx, bootstrapAccountAddr, mustEmbeddedContractCode(types.PoEContractTypeValidatorVoting), &wasmtypes.AllowEverybody)
 	if err != nil {
 		return sdkerrors.Wrap(err, "store validator voting contract")
 	}
@@ -352,7 +356,7 @@ func BootstrapPoEContracts(ctx sdk.Context, k wasmtypes.ContractOpsKeeper, tk tw
 	}
 
 	// setup arbiter pool
-	apCodeID, _, err := k.Create(ctx, bootstrapAccountAddr, tgArbiterPool, &wasmtypes.AllowEverybody)
+	apCodeID, _, err := k.Create(ctx, bootstrapAccountAddr, mustEmbeddedContractCode(types.PoEContractTypeArbiterPoolVoting), &wasmtypes.AllowEverybody)
 	if err != nil {
 		return sdkerrors.Wrap(err, "store arbiter voting contract: ")
 	}
@@ -146,6 +146,10 @@ func (m twasmKeeperMock) GetContractKeeper() wasmtypes.ContractOpsKeeper {
 	panic("implement me")
 }
 
+func (m twasmKeeperMock) IterateContractState(ctx sdk.Context, contractAddress sdk.AccAddress, cb func(key, value []byte) bool) {
+	panic("implement me")
+}
+
 func (m twasmKeeperMock) IteratePrivilegedContractsByType(ctx sdk.Context, privilegeType twasmtypes.PrivilegeType, cb func(prio uint8, contractAddr sdk.AccAddress) bool) {
 	panic("implement me")
 }
@@ -0,0 +1,321 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+
+	"github.com/confio/tgrade/x/poe/contract"
+	"github.com/confio/tgrade/x/poe/types"
+)
+
+const apGroup = "ap"
+
+// NewAPTxCmd returns the arbiter pool dispute transaction commands.
+func NewAPTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        apGroup,
+		Short:                      "Arbiter pool dispute subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	cmd.AddCommand(
+		NewAPRegisterComplaintCmd(),
+		NewAPAcceptComplaintCmd(),
+		NewAPWithdrawComplaintCmd(),
+		NewAPRenderDecisionCmd(),
+		NewAPProposeArbitersCmd(),
+		NewVoteProposalCmd(types.PoEContractTypeArbiterPoolVoting, apGroup),
+		NewExecuteProposalCmd(types.PoEContractTypeArbiterPoolVoting, apGroup),
+		NewCloseProposalCmd(types.PoEContractTypeArbiterPoolVoting, apGroup),
+	)
+	return cmd
+}
+
+// GetAPQueryCmd returns the arbiter pool dispute query commands.
+func GetAPQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        apGroup,
+		Short:                      "Querying commands for the arbiter pool disputes",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	cmd.AddCommand(
+		GetCmdQueryAPComplaints(),
+		GetCmdQueryAPComplaint(),
+		GetCmdQueryProposals(types.PoEContractTypeArbiterPoolVoting, apGroup),
+		GetCmdQueryProposal(types.PoEContractTypeArbiterPoolVoting, apGroup),
+		GetCmdQueryVotes(types.PoEContractTypeArbiterPoolVoting, apGroup),
+		GetCmdQueryTally(types.PoEContractTypeArbiterPoolVoting, apGroup),
+	)
+	return cmd
+}
+
+// NewAPRegisterComplaintCmd returns a command to register a complaint. The dispute cost is sent with it.
+func NewAPRegisterComplaintCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-complaint [defendant]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Register a complaint against an address",
+		Long: fmt.Sprintf(`Register a complaint against an address. The dispute cost configured in the arbiter pool
+contract is sent with the complaint.
+
+Example:
+$ %s tx poe ap register-complaint tgrade1n4kjhlrpapnpv0n0e3048ydftrjs9m6mm473jf --title "Complaint" --description "Details" --from mykey`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			defendant, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			title, err := cmd.Flags().GetString(flagTitle)
+			if err != nil {
+				return err
+			}
+			description, err := cmd.Flags().GetString(flagDescription)
+			if err != nil {
+				return err
+			}
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			config, err := queryAPConfig(cmd, clientCtx)
+			if err != nil {
+				return err
+			}
+			return executeContractCLI(cmd, types.PoEContractTypeArbiterPoolVoting, contract.APVotingExecute{
+				RegisterComplaint: &contract.RegisterComplaint{
+					Title:       title,
+					Description: description,
+					Defendant:   defendant.String(),
+				},
+			}, sdk.NewCoins(config.DisputeCost))
+		},
+	}
+
+	cmd.Flags().AddFlagSet(flagSetProposal())
+	_ = cmd.MarkFlagRequired(flagTitle)
+	_ = cmd.MarkFlagRequired(flagDescription)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewAPAcceptComplaintCmd returns a command for the defendant to accept a complaint.
+func NewAPAcceptComplaintCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accept-complaint [complaint-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Accept a complaint as defendant",
+		Long: fmt.Sprintf(`Accept a complaint as defendant to start the arbitration.
+
+Example:
+$ %s tx poe ap accept-complaint 0 --from mykey`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			complaintID, err := parseComplaintID(args[0])
+			if err != nil {
+				return err
+			}
+			return executeContractCLI(cmd, types.PoEContractTypeArbiterPoolVoting, contract.APVotingExecute{
+				AcceptComplaint: &contract.AcceptComplaint{ComplaintID: complaintID},
+			}, nil)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewAPWithdrawComplaintCmd returns a command for the plaintiff to withdraw a complaint.
+func NewAPWithdrawComplaintCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw-complaint [complaint-id] [reason]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Withdraw a complaint as plaintiff",
+		Long: fmt.Sprintf(`Withdraw a complaint as plaintiff.
+
+Example:
+$ %s tx poe ap withdraw-complaint 0 "settled" --from mykey`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			complaintID, err := parseComplaintID(args[0])
+			if err != nil {
+				return err
+			}
+			return executeContractCLI(cmd, types.PoEContractTypeArbiterPoolVoting, contract.APVotingExecute{
+				WithdrawComplaint: &contract.WithdrawComplaint{ComplaintID: complaintID, Reason: args[1]},
+			}, nil)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewAPRenderDecisionCmd returns a command for the arbiters to render a decision on a complaint.
+func NewAPRenderDecisionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "render-decision [complaint-id] [summary] [ipfs-link]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Render the arbiters decision on a complaint",
+		Long: fmt.Sprintf(`Render the decision on a complaint. Must be sent by the arbiters of the complaint.
+
+Example:
+$ %s tx poe ap render-decision 0 "summary" "ipfs://..." --from mykey`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			complaintID, err := parseComplaintID(args[0])
+			if err != nil {
+				return err
+			}
+			return executeContractCLI(cmd, types.PoEContractTypeArbiterPoolVoting, contract.APVotingExecute{
+				RenderDecision: &contract.RenderDecision{ComplaintID: complaintID, Summary: args[1], IpfsLink: args[2]},
+			}, nil)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewAPProposeArbitersCmd returns a command to propose the arbiters for a complaint.
+func NewAPProposeArbitersCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "propose-arbiters [complaint-id] [arbiter]...",
+		Args:  cobra.MinimumNArgs(2),
+		Short: "Propose arbiters for a complaint",
+		Long: fmt.Sprintf(`Propose the arbiters for an accepted complaint.
+
+Example:
+$ %s tx poe ap propose-arbiters 0 tgrade1n4kjhlrpapnpv0n0e3048ydftrjs9m6mm473jf --title "Arbiters" --description "Case 0" --from mykey`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			complaintID, err := parseComplaintID(args[0])
+			if err != nil {
+				return err
+			}
+			arbiters := make([]sdk.AccAddress, len(args)-1)
+			for i, a := range args[1:] {
+				if arbiters[i], err = sdk.AccAddressFromBech32(a); err != nil {
+					return sdkerrors.Wrapf(err, "arbiter %s", a)
+				}
+			}
+			title, err := cmd.Flags().GetString(flagTitle)
+			if err != nil {
+				return err
+			}
+			description, err := cmd.Flags().GetString(flagDescription)
+			if err != nil {
+				return err
+			}
+			return executeContractCLI(cmd, types.PoEContractTypeArbiterPoolVoting, contract.APVotingExecute{
+				Propose: &contract.Propose{
+					Title:       title,
+					Description: description,
+					APProposal: contract.APProposal{
+						ProposeArbiters: &contract.ProposeArbiters{CaseID: complaintID, Arbiters: arbiters},
+					},
+				},
+			}, nil)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(flagSetProposal())
+	_ = cmd.MarkFlagRequired(flagTitle)
+	_ = cmd.MarkFlagRequired(flagDescription)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryAPComplaints returns a command to list the complaints.
+func GetCmdQueryAPComplaints() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "complaints",
+		Args:  cobra.NoArgs,
+		Short: "Query all complaints",
+		Long: fmt.Sprintf(`Query all complaints with their state ordered by complaint id, starting with id 0.
+
+Example:
+$ %s query poe ap complaints --start-after 10 --limit 10`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			listQuery := &contract.ListComplaintsQuery{}
+			if cmd.Flags().Changed(flagStartAfter) {
+				startAfter, err := cmd.Flags().GetUint64(flagStartAfter)
+				if err != nil {
+					return err
+				}
+				listQuery.StartAfter = &startAfter
+			}
+			limit, err := cmd.Flags().GetUint32(flags.FlagLimit)
+			if err != nil {
+				return err
+			}
+			listQuery.Limit = limit
+			return querySmartContractCLI(cmd, types.PoEContractTypeArbiterPoolVoting, contract.APVotingQuery{ListComplaints: listQuery})
+		},
+	}
+
+	cmd.Flags().Uint64(flagStartAfter, 0, "Complaint id to start after")
+	cmd.Flags().Uint32(flags.FlagLimit, 0, "Maximum number of complaints to return, contract default when 0")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryAPComplaint returns a command to query a single complaint.
+func GetCmdQueryAPComplaint() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "complaint [complaint-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query a complaint",
+		Long: fmt.Sprintf(`Query a complaint and its state by id.
+
+Example:
+$ %s query poe ap complaint 0`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			complaintID, err := parseComplaintID(args[0])
+			if err != nil {
+				return err
+			}
+			return querySmartContractCLI(cmd, types.PoEContractTypeArbiterPoolVoting, contract.APVotingQuery{
+				Complaint: &contract.ComplaintQuery{ComplaintID: complaintID},
+			})
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// queryAPConfig reads the arbiter pool configuration from the contract store. The contract has no smart query for it.
+func queryAPConfig(cmd *cobra.Command, clientCtx client.Context) (*contract.APConfig, error) {
+	contractAddr, err := queryContractAddress(cmd.Context(), clientCtx, types.PoEContractTypeArbiterPoolVoting)
+	if err != nil {
+		return nil, err
+	}
+	res, err := wasmtypes.NewQueryClient(clientCtx).RawContractState(cmd.Context(), &wasmtypes.QueryRawContractStateRequest{
+		Address:   contractAddr,
+		QueryData: []byte(contract.APConfigStorageKey),
+	})
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "query arbiter pool config")
+	}
+	var config contract.APConfig
+	if err := json.Unmarshal(res.Data, &config); err != nil {
+		return nil, sdkerrors.Wrap(err, "decode arbiter pool config")
+	}
+	return &config, nil
+}
+
+func parseComplaintID(s string) (uint64, error) {
+	id, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("complaint id argument provided must be a non-negative-integer: %v", err)
+	}
+	return id, nil
+}
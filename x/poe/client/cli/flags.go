@@ -5,19 +5,35 @@ import (
 )
 
 const (
-	FlagPubKey          = "pubkey"
-	FlagAmount          = "amount"
-	FlagVestingAmount   = "vesting-amount"
-	FlagMoniker         = "moniker"
-	FlagIdentity        = "identity"
-	FlagWebsite         = "website"
-	FlagSecurityContact = "security-contact"
-	FlagDetails         = "details"
-	FlagNodeID          = "node-id"
-	FlagIP              = "ip"
-	flagAddress         = "address"
-	flagEngagement      = "engagement"
-	flagDistribution    = "distribution"
+	FlagPubKey                  = "pubkey"
+	FlagAmount                  = "amount"
+	FlagVestingAmount           = "vesting-amount"
+	FlagMoniker                 = "moniker"
+	FlagIdentity                = "identity"
+	FlagWebsite                 = "website"
+	FlagSecurityContact         = "security-contact"
+	FlagDetails                 = "details"
+	FlagNodeID                  = "node-id"
+	FlagIP                      = "ip"
+	flagAddress                 = "address"
+	flagEngagement              = "engagement"
+	flagDistribution            = "distribution"
+	flagMinHeight               = "min-height"
+	flagMaxHeight               = "max-height"
+	flagTitle                   = "title"
+	flagDescription             = "description"
+	flagStartAfter              = "start-after"
+	flagReverse                 = "reverse"
+	flagStatus                  = "status"
+	flagUpgradeInfo             = "info"
+	flagBlockMaxBytes           = "block-max-bytes"
+	flagBlockMaxGas             = "block-max-gas"
+	flagEvidenceMaxAgeNumBlocks = "evidence-max-age-num-blocks"
+	flagEvidenceMaxAgeDuration  = "evidence-max-age-duration"
+	flagEvidenceMaxBytes        = "evidence-max-bytes"
+	flagContract                = "contract"
+	flagAdd                     = "add"
+	flagRemove                  = "remove"
 )
 
 // FlagSetAmounts Returns the FlagSet for amount related operations.
@@ -46,3 +62,10 @@ func flagSetDescriptionCreate() *flag.FlagSet {
 
 	return fs
 }
+
+func flagSetProposal() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.String(flagTitle, "", "The proposal title")
+	fs.String(flagDescription, "", "The proposal description")
+	return fs
+}
@@ -0,0 +1,145 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+
+	"github.com/confio/tgrade/x/poe/contract"
+	"github.com/confio/tgrade/x/poe/types"
+)
+
+const ocGroup = "oc"
+
+// NewOCTxCmd returns the oversight committee proposal transaction commands.
+func NewOCTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        ocGroup,
+		Short:                      "Oversight committee proposal subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	cmd.AddCommand(
+		NewOCProposeSlashCmd(),
+		NewOCProposeGrantEngagementCmd(),
+		NewVoteProposalCmd(types.PoEContractTypeOversightCommunityGovProposals, ocGroup),
+		NewExecuteProposalCmd(types.PoEContractTypeOversightCommunityGovProposals, ocGroup),
+		NewCloseProposalCmd(types.PoEContractTypeOversightCommunityGovProposals, ocGroup),
+	)
+	return cmd
+}
+
+// GetOCQueryCmd returns the oversight committee proposal query commands.
+func GetOCQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        ocGroup,
+		Short:                      "Querying commands for the oversight committee proposals",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	cmd.AddCommand(
+		GetCmdQueryProposals(types.PoEContractTypeOversightCommunityGovProposals, ocGroup),
+		GetCmdQueryProposal(types.PoEContractTypeOversightCommunityGovProposals, ocGroup),
+		GetCmdQueryVotes(types.PoEContractTypeOversightCommunityGovProposals, ocGroup),
+		GetCmdQueryTally(types.PoEContractTypeOversightCommunityGovProposals, ocGroup),
+	)
+	return cmd
+}
+
+// NewOCProposeSlashCmd returns a command to propose slashing a validator.
+func NewOCProposeSlashCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "propose-slash [member] [portion]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Propose to slash a validator",
+		Long: fmt.Sprintf(`Propose to slash the given portion (0.0-1.0) of a validator's stake and engagement.
+
+Example:
+$ %s tx poe oc propose-slash tgrade1n4kjhlrpapnpv0n0e3048ydftrjs9m6mm473jf 0.5 --title "Slash" --description "Misbehaviour" --from mykey`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			member, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			portion, err := sdk.NewDecFromStr(args[1])
+			if err != nil {
+				return fmt.Errorf("portion argument provided must be a decimal: %v", err)
+			}
+			if !portion.IsPositive() || portion.GT(sdk.OneDec()) {
+				return fmt.Errorf("portion must be greater than 0 and not greater than 1: %s", portion)
+			}
+			proposal, err := newProposalMsg(cmd, contract.OversightProposal{
+				Slash: &contract.SlashProposal{Member: member.String(), Portion: portion},
+			})
+			if err != nil {
+				return err
+			}
+			return executeContractCLI(cmd, types.PoEContractTypeOversightCommunityGovProposals, contract.OCProposalsExecuteMsg{Propose: proposal}, nil)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(flagSetProposal())
+	_ = cmd.MarkFlagRequired(flagTitle)
+	_ = cmd.MarkFlagRequired(flagDescription)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewOCProposeGrantEngagementCmd returns a command to propose granting engagement points.
+func NewOCProposeGrantEngagementCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "propose-grant-engagement [member] [points]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Propose to grant engagement points to an address",
+		Long: fmt.Sprintf(`Propose to grant engagement points to an address.
+
+Example:
+$ %s tx poe oc propose-grant-engagement tgrade1n4kjhlrpapnpv0n0e3048ydftrjs9m6mm473jf 100 --title "Grant" --description "Contribution" --from mykey`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			member, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			points, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("points argument provided must be a non-negative-integer: %v", err)
+			}
+			proposal, err := newProposalMsg(cmd, contract.OversightProposal{
+				GrantEngagement: &contract.GrantEngagementProposal{Member: member.String(), Points: points},
+			})
+			if err != nil {
+				return err
+			}
+			return executeContractCLI(cmd, types.PoEContractTypeOversightCommunityGovProposals, contract.OCProposalsExecuteMsg{Propose: proposal}, nil)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(flagSetProposal())
+	_ = cmd.MarkFlagRequired(flagTitle)
+	_ = cmd.MarkFlagRequired(flagDescription)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func newProposalMsg(cmd *cobra.Command, proposal contract.OversightProposal) (*contract.ProposalMsg, error) {
+	title, err := cmd.Flags().GetString(flagTitle)
+	if err != nil {
+		return nil, err
+	}
+	description, err := cmd.Flags().GetString(flagDescription)
+	if err != nil {
+		return nil, err
+	}
+	return &contract.ProposalMsg{
+		Title:       title,
+		Description: description,
+		Proposal:    proposal,
+	}, nil
+}
@@ -0,0 +1,201 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	"github.com/gogo/protobuf/proto"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	coretypes "github.com/tendermint/tendermint/rpc/core/types"
+
+	"github.com/confio/tgrade/x/poe/types"
+)
+
+func TestOCTxCmd(t *testing.T) {
+	myContractAddr := types.RandomAccAddress().String()
+	mySender := types.RandomAccAddress().String()
+	myMember := types.RandomAccAddress().String()
+	specs := map[string]struct {
+		args   []string
+		expMsg string
+		expErr bool
+	}{
+		"propose slash": {
+			args:   []string{"propose-slash", myMember, "0.5", "--title=my title", "--description=my description"},
+			expMsg: `{"propose":{"title":"my title","description":"my description","proposal":{"punish":{"member":"` + myMember + `","portion":"0.500000000000000000"}}}}`,
+		},
+		"propose slash with invalid portion": {
+			args:   []string{"propose-slash", myMember, "1.1", "--title=my title", "--description=my description"},
+			expErr: true,
+		},
+		"propose grant engagement": {
+			args:   []string{"propose-grant-engagement", myMember, "100", "--title=my title", "--description=my description"},
+			expMsg: `{"propose":{"title":"my title","description":"my description","proposal":{"grant_engagement":{"member":"` + myMember + `","points":100}}}}`,
+		},
+		"vote": {
+			args:   []string{"vote", "1", "yes"},
+			expMsg: `{"vote":{"proposal_id":1,"vote":"yes"}}`,
+		},
+		"invalid vote": {
+			args:   []string{"vote", "1", "maybe"},
+			expErr: true,
+		},
+		"execute": {
+			args:   []string{"execute", "1"},
+			expMsg: `{"execute":{"proposal_id":1}}`,
+		},
+		"close": {
+			args:   []string{"close", "1"},
+			expMsg: `{"close":{"proposal_id":1}}`,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			rpc := &mockRPCClient{contractAddr: myContractAddr}
+			var out bytes.Buffer
+			cmd := NewOCTxCmd()
+			cmd.SetArgs(append(spec.args, "--from="+mySender, "--generate-only"))
+
+			// when
+			gotErr := executeCmdWithClient(t, cmd, rpc, &out)
+
+			// then
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			var gotTx struct {
+				Body struct {
+					Messages []wasmtypes.MsgExecuteContract `json:"messages"`
+				} `json:"body"`
+			}
+			require.NoError(t, json.Unmarshal(out.Bytes(), &gotTx), out.String())
+			require.Len(t, gotTx.Body.Messages, 1)
+			gotMsg := gotTx.Body.Messages[0]
+			assert.Equal(t, myContractAddr, gotMsg.Contract)
+			assert.Equal(t, mySender, gotMsg.Sender)
+			assert.JSONEq(t, spec.expMsg, string(gotMsg.Msg), string(gotMsg.Msg))
+		})
+	}
+}
+
+func TestOCQueryCmd(t *testing.T) {
+	myContractAddr := types.RandomAccAddress().String()
+	specs := map[string]struct {
+		args     []string
+		expQuery string
+		expErr   bool
+	}{
+		"proposals": {
+			args:     []string{"proposals", "--start-after=1", "--limit=10"},
+			expQuery: `{"list_proposals":{"start_after":1,"limit":10}}`,
+		},
+		"proposals reverse": {
+			args:     []string{"proposals", "--reverse"},
+			expQuery: `{"reverse_proposals":{}}`,
+		},
+		"proposals with invalid status": {
+			args:   []string{"proposals", "--status=unknown"},
+			expErr: true,
+		},
+		"proposal": {
+			args:     []string{"proposal", "1"},
+			expQuery: `{"proposal":{"proposal_id":1}}`,
+		},
+		"votes": {
+			args:     []string{"votes", "1", "--limit=10"},
+			expQuery: `{"list_votes":{"proposal_id":1,"limit":10}}`,
+		},
+		"tally": {
+			args:     []string{"tally", "1"},
+			expQuery: `{"proposal":{"proposal_id":1}}`,
+		},
+		"invalid proposal id": {
+			args:   []string{"proposal", "one"},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			rpc := &mockRPCClient{contractAddr: myContractAddr}
+			var out bytes.Buffer
+			cmd := GetOCQueryCmd()
+			cmd.SetArgs(spec.args)
+
+			// when
+			gotErr := executeCmdWithClient(t, cmd, rpc, &out)
+
+			// then
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			require.NotNil(t, rpc.capturedSmartQuery)
+			assert.Equal(t, myContractAddr, rpc.capturedSmartQuery.Address)
+			assert.JSONEq(t, spec.expQuery, string(rpc.capturedSmartQuery.QueryData), string(rpc.capturedSmartQuery.QueryData))
+		})
+	}
+}
+
+// executeCmdWithClient runs the command with a client context that sends all queries to the given rpc client
+func executeCmdWithClient(t *testing.T, cmd *cobra.Command, rpc rpcclient.Client, out *bytes.Buffer) error {
+	t.Helper()
+	registry := codectypes.NewInterfaceRegistry()
+	types.RegisterInterfaces(registry)
+	wasmtypes.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+	clientCtx := client.Context{}.
+		WithCodec(cdc).
+		WithInterfaceRegistry(registry).
+		WithTxConfig(authtx.NewTxConfig(cdc, authtx.DefaultSignModes)).
+		WithKeyring(keyring.NewInMemory()).
+		WithChainID("testing").
+		WithClient(rpc).
+		WithOutput(out)
+	cmd.SetOut(out)
+	cmd.SetErr(out)
+	return cmd.ExecuteContext(context.WithValue(context.Background(), client.ClientContextKey, &clientCtx))
+}
+
+// mockRPCClient answers the PoE contract address query and captures the smart contract query
+type mockRPCClient struct {
+	rpcclient.Client
+	contractAddr       string
+	capturedSmartQuery *wasmtypes.QuerySmartContractStateRequest
+}
+
+func (m *mockRPCClient) ABCIQueryWithOptions(_ context.Context, path string, data tmbytes.HexBytes, _ rpcclient.ABCIQueryOptions) (*coretypes.ResultABCIQuery, error) {
+	var rsp proto.Marshaler
+	switch path {
+	case "/confio.poe.v1beta1.Query/ContractAddress":
+		rsp = &types.QueryContractAddressResponse{Address: m.contractAddr}
+	case "/cosmwasm.wasm.v1.Query/SmartContractState":
+		m.capturedSmartQuery = &wasmtypes.QuerySmartContractStateRequest{}
+		if err := m.capturedSmartQuery.Unmarshal(data); err != nil {
+			return nil, err
+		}
+		rsp = &wasmtypes.QuerySmartContractStateResponse{Data: []byte(`{}`)}
+	default:
+		return &coretypes.ResultABCIQuery{Response: abci.ResponseQuery{Code: 1, Log: "unexpected path: " + path}}, nil
+	}
+	bz, err := rsp.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	return &coretypes.ResultABCIQuery{Response: abci.ResponseQuery{Value: bz}}, nil
+}
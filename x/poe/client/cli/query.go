@@ -35,6 +35,16 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryUnbondingPeriod(),
 		GetCmdQueryHistoricalInfo(),
 		GetCmdQueryValidatorReward(),
+		GetCmdQueryEngagementPoints(),
+		GetCmdQueryEngagementMembers(),
+		GetCmdQueryValidatorJailStatus(),
+		GetCmdQueryEpochInfo(),
+		GetCmdQuerySlashingEvents(),
+		GetCmdQueryPreviewMixedPoints(),
+		GetOCQueryCmd(),
+		GetAPQueryCmd(),
+		GetValidatorVotingQueryCmd(),
+		GetTrustedCircleQueryCmd(),
 	)
 	return queryCmd
 }
@@ -445,6 +455,159 @@ func queryEngagementRewards(queryClient types.QueryClient, valAddr sdk.ValAddres
 	return res.Reward, nil
 }
 
+// GetCmdQueryEngagementPoints implements the command to query the engagement points of an address.
+func GetCmdQueryEngagementPoints() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "engagement-points [address]",
+		Short: "Query the engagement points of an address",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the engagement points of an address. Returns zero for non members.
+
+Example:
+$ %s query poe engagement-points tgrade1n4kjhlrpapnpv0n0e3048ydftrjs9m6mm473jf
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.EngagementPoints(cmd.Context(), &types.QueryEngagementPointsRequest{Address: addr.String()})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryEngagementMembers implements the command to query all engagement group members.
+func GetCmdQueryEngagementMembers() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "engagement-members",
+		Short: "Query all engagement group members ordered by points",
+		Args:  cobra.NoArgs,
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all engagement group members with their points, highest first.
+
+Example:
+$ %s query poe engagement-members
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+			res, err := queryClient.EngagementMembers(cmd.Context(), &types.QueryEngagementMembersRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	AddPaginationFlagsToCmd(cmd, "engagement members")
+	return cmd
+}
+
+// GetCmdQueryValidatorJailStatus implements the command to query the jail status of a validator.
+func GetCmdQueryValidatorJailStatus() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "jail-status [validator-addr]",
+		Short: "Query the jail status of a validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the jail status of a validator and when it can be unjailed.
+
+Example:
+$ %s query poe jail-status tgrade1n4kjhlrpapnpv0n0e3048ydftrjs9m6mm473jf
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ValidatorJailStatus(cmd.Context(), &types.QueryValidatorJailStatusRequest{ValidatorAddr: addr.String()})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryEpochInfo implements the command to query the current epoch.
+func GetCmdQueryEpochInfo() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "epoch",
+		Args:  cobra.NoArgs,
+		Short: "Query the current epoch and reward distribution",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the current epoch, the start of the next epoch and the last reward distribution.
+
+Example:
+$ %s query poe epoch
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.EpochInfo(cmd.Context(), &types.QueryEpochInfoRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // AddPaginationFlagsToCmd adds common pagination flags to cmd
 func AddPaginationFlagsToCmd(cmd *cobra.Command, query string) {
 	// we support only a subset in the contracts yet
@@ -468,3 +631,103 @@ func withPageKeyDecoded(flagSet *flag.FlagSet) *flag.FlagSet {
 	}
 	return flagSet
 }
+
+// GetCmdQuerySlashingEvents implements the command to query the slashing history of all validators.
+func GetCmdQuerySlashingEvents() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "slashing-events",
+		Short: "Query the slashing history of all validators",
+		Args:  cobra.NoArgs,
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the slashing events of all validators. Pagination is over validators.
+
+Example:
+$ %s query poe slashing-events --min-height 100 --max-height 200
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			minHeight, err := cmd.Flags().GetUint64(flagMinHeight)
+			if err != nil {
+				return err
+			}
+			maxHeight, err := cmd.Flags().GetUint64(flagMaxHeight)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+			res, err := queryClient.SlashingEvents(cmd.Context(), &types.QuerySlashingEventsRequest{
+				MinHeight:  minHeight,
+				MaxHeight:  maxHeight,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Uint64(flagMinHeight, 0, "Lowest slashing height to include")
+	cmd.Flags().Uint64(flagMaxHeight, 0, "Highest slashing height to include, 0 for no upper bound")
+	flags.AddQueryFlagsToCmd(cmd)
+	AddPaginationFlagsToCmd(cmd, "slashing events")
+	return cmd
+}
+
+// GetCmdQueryPreviewMixedPoints implements the command to preview the mixer function result.
+func GetCmdQueryPreviewMixedPoints() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "preview-mixed-points [stake-points] [engagement-points]",
+		Short: "Query the validator points for the given stake and engagement points",
+		Args:  cobra.ExactArgs(2),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the validator points that the on-chain mixer function calculates for the given
+stake and engagement points. Stake points are the bonded tokens divided by the tokens per point of the
+staking contract.
+
+Example:
+$ %s query poe preview-mixed-points 100 2000
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			stake, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("stake points argument provided must be a non-negative-integer: %v", err)
+			}
+			engagement, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("engagement points argument provided must be a non-negative-integer: %v", err)
+			}
+			res, err := queryClient.PreviewMixedPoints(cmd.Context(), &types.QueryPreviewMixedPointsRequest{
+				Stake:      stake,
+				Engagement: engagement,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
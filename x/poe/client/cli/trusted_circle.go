@@ -0,0 +1,343 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+
+	"github.com/confio/tgrade/x/poe/contract"
+	"github.com/confio/tgrade/x/poe/types"
+)
+
+const trustedCircleGroup = "trusted-circle"
+
+// NewTrustedCircleTxCmd returns the trusted circle membership transaction commands.
+func NewTrustedCircleTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        trustedCircleGroup,
+		Short:                      "Trusted circle membership subcommands",
+		Long:                       "Trusted circle membership subcommands. The oversight community is used unless --contract is set.",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	cmd.PersistentFlags().String(flagContract, "", "Address of the trusted circle contract, defaults to the oversight community")
+	cmd.AddCommand(
+		NewTrustedCircleProposeAddVotingMembersCmd(),
+		NewTrustedCircleProposeAddRemoveNonVotingMembersCmd(),
+		NewTrustedCircleDepositEscrowCmd(),
+		NewTrustedCircleReturnEscrowCmd(),
+		NewTrustedCircleLeaveCmd(),
+		NewVoteProposalCmd(types.PoEContractTypeOversightCommunity, trustedCircleGroup),
+		NewExecuteProposalCmd(types.PoEContractTypeOversightCommunity, trustedCircleGroup),
+		NewCloseProposalCmd(types.PoEContractTypeOversightCommunity, trustedCircleGroup),
+	)
+	return cmd
+}
+
+// GetTrustedCircleQueryCmd returns the trusted circle membership query commands.
+func GetTrustedCircleQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        trustedCircleGroup,
+		Short:                      "Querying commands for trusted circles",
+		Long:                       "Querying commands for trusted circles. The oversight community is used unless --contract is set.",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	cmd.PersistentFlags().String(flagContract, "", "Address of the trusted circle contract, defaults to the oversight community")
+	cmd.AddCommand(
+		GetCmdQueryTrustedCircleInfo(),
+		GetCmdQueryTrustedCircleMembers(),
+		GetCmdQueryTrustedCircleNonVotingMembers(),
+		GetCmdQueryTrustedCircleEscrow(),
+		GetCmdQueryProposals(types.PoEContractTypeOversightCommunity, trustedCircleGroup),
+		GetCmdQueryProposal(types.PoEContractTypeOversightCommunity, trustedCircleGroup),
+		GetCmdQueryVotes(types.PoEContractTypeOversightCommunity, trustedCircleGroup),
+		GetCmdQueryTally(types.PoEContractTypeOversightCommunity, trustedCircleGroup),
+	)
+	return cmd
+}
+
+// NewTrustedCircleProposeAddVotingMembersCmd returns a command to propose new voting members.
+func NewTrustedCircleProposeAddVotingMembersCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "propose-add-voting-members [address]...",
+		Args:  cobra.MinimumNArgs(1),
+		Short: "Propose to add voting members",
+		Long: fmt.Sprintf(`Propose to add voting members to the trusted circle. New voting members have to deposit the
+escrow before they can vote.
+
+Example:
+$ %s tx poe trusted-circle propose-add-voting-members tgrade1n4kjhlrpapnpv0n0e3048ydftrjs9m6mm473jf --title "Add" --description "New member" --from mykey`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			voters, err := parseAddresses(args)
+			if err != nil {
+				return err
+			}
+			return proposeTrustedCircleCLI(cmd, contract.ProposalContent{
+				AddVotingMembers: &contract.AddVotingMembers{Voters: voters},
+			})
+		},
+	}
+
+	cmd.Flags().AddFlagSet(flagSetProposal())
+	_ = cmd.MarkFlagRequired(flagTitle)
+	_ = cmd.MarkFlagRequired(flagDescription)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewTrustedCircleProposeAddRemoveNonVotingMembersCmd returns a command to propose adding or removing non-voting members.
+func NewTrustedCircleProposeAddRemoveNonVotingMembersCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "propose-add-remove-non-voting-members",
+		Args:  cobra.NoArgs,
+		Short: "Propose to add or remove non-voting members",
+		Long: fmt.Sprintf(`Propose to add or remove non-voting members of the trusted circle.
+
+Example:
+$ %s tx poe trusted-circle propose-add-remove-non-voting-members --add tgrade1n4kjhlrpapnpv0n0e3048ydftrjs9m6mm473jf --title "Add" --description "New member" --from mykey`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			addArgs, err := cmd.Flags().GetStringSlice(flagAdd)
+			if err != nil {
+				return err
+			}
+			removeArgs, err := cmd.Flags().GetStringSlice(flagRemove)
+			if err != nil {
+				return err
+			}
+			if len(addArgs) == 0 && len(removeArgs) == 0 {
+				return fmt.Errorf("at least one of --%s or --%s must be set", flagAdd, flagRemove)
+			}
+			add, err := parseAddresses(addArgs)
+			if err != nil {
+				return err
+			}
+			remove, err := parseAddresses(removeArgs)
+			if err != nil {
+				return err
+			}
+			return proposeTrustedCircleCLI(cmd, contract.ProposalContent{
+				AddRemoveNonVotingMembers: &contract.AddRemoveNonVotingMembers{Add: add, Remove: remove},
+			})
+		},
+	}
+
+	cmd.Flags().StringSlice(flagAdd, nil, "Addresses to add as non-voting members")
+	cmd.Flags().StringSlice(flagRemove, nil, "Non-voting member addresses to remove")
+	cmd.Flags().AddFlagSet(flagSetProposal())
+	_ = cmd.MarkFlagRequired(flagTitle)
+	_ = cmd.MarkFlagRequired(flagDescription)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewTrustedCircleDepositEscrowCmd returns a command to deposit escrow.
+func NewTrustedCircleDepositEscrowCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deposit-escrow [amount]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Deposit escrow to become or stay a voting member",
+		Long: fmt.Sprintf(`Deposit escrow to become or stay a voting member of the trusted circle.
+
+Example:
+$ %s tx poe trusted-circle deposit-escrow 1000000utgd --from mykey`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+			return executeContractCLI(cmd, types.PoEContractTypeOversightCommunity, contract.TrustedCircleExecute{
+				DepositEscrow: &struct{}{},
+			}, sdk.NewCoins(amount))
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewTrustedCircleReturnEscrowCmd returns a command to return the escrow above the required amount.
+func NewTrustedCircleReturnEscrowCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "return-escrow",
+		Args:  cobra.NoArgs,
+		Short: "Return the escrow above the required amount",
+		Long: fmt.Sprintf(`Return the escrow above the required amount, or all escrow of a member that left the trusted circle.
+
+Example:
+$ %s tx poe trusted-circle return-escrow --from mykey`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return executeContractCLI(cmd, types.PoEContractTypeOversightCommunity, contract.TrustedCircleExecute{
+				ReturnEscrow: &struct{}{},
+			}, nil)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewTrustedCircleLeaveCmd returns a command to leave the trusted circle.
+func NewTrustedCircleLeaveCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "leave",
+		Args:  cobra.NoArgs,
+		Short: "Leave the trusted circle",
+		Long: fmt.Sprintf(`Leave the trusted circle. Voting members can not be removed by proposal, leaving is the only way
+to give up a voting membership. The escrow can be returned after the leaving period has passed.
+
+Example:
+$ %s tx poe trusted-circle leave --from mykey`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return executeContractCLI(cmd, types.PoEContractTypeOversightCommunity, contract.TrustedCircleExecute{
+				LeaveTrustedCircle: &struct{}{},
+			}, nil)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryTrustedCircleInfo returns a command to query the trusted circle configuration.
+func GetCmdQueryTrustedCircleInfo() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "info",
+		Args:  cobra.NoArgs,
+		Short: "Query the trusted circle configuration",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return querySmartContractCLI(cmd, types.PoEContractTypeOversightCommunity, contract.TrustedCircleQuery{TrustedCircle: &struct{}{}})
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryTrustedCircleMembers returns a command to list the voting members with their escrow status.
+func GetCmdQueryTrustedCircleMembers() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "members",
+		Args:  cobra.NoArgs,
+		Short: "Query the voting members and their escrow status",
+		Long: fmt.Sprintf(`Query the voting members and their escrow status. Members that are not yet voting or that left
+the trusted circle are included with their status.
+
+Example:
+$ %s query poe trusted-circle members --limit 10`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			listQuery, err := listMembersQuery(cmd)
+			if err != nil {
+				return err
+			}
+			return querySmartContractCLI(cmd, types.PoEContractTypeOversightCommunity, contract.TrustedCircleQuery{ListEscrows: listQuery})
+		},
+	}
+
+	addListMembersFlags(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryTrustedCircleNonVotingMembers returns a command to list the non-voting members.
+func GetCmdQueryTrustedCircleNonVotingMembers() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "non-voting-members",
+		Args:  cobra.NoArgs,
+		Short: "Query the non-voting members",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			listQuery, err := listMembersQuery(cmd)
+			if err != nil {
+				return err
+			}
+			return querySmartContractCLI(cmd, types.PoEContractTypeOversightCommunity, contract.TrustedCircleQuery{ListNonVotingMembers: listQuery})
+		},
+	}
+
+	addListMembersFlags(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryTrustedCircleEscrow returns a command to query the escrow status of an address.
+func GetCmdQueryTrustedCircleEscrow() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "escrow [address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the escrow status of an address",
+		Long: fmt.Sprintf(`Query the escrow status of an address. Returns null for addresses that are not a voting member.
+
+Example:
+$ %s query poe trusted-circle escrow tgrade1n4kjhlrpapnpv0n0e3048ydftrjs9m6mm473jf`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			return querySmartContractCLI(cmd, types.PoEContractTypeOversightCommunity, contract.TrustedCircleQuery{
+				Escrow: &contract.EscrowQuery{Addr: addr.String()},
+			})
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func proposeTrustedCircleCLI(cmd *cobra.Command, proposal contract.ProposalContent) error {
+	title, err := cmd.Flags().GetString(flagTitle)
+	if err != nil {
+		return err
+	}
+	description, err := cmd.Flags().GetString(flagDescription)
+	if err != nil {
+		return err
+	}
+	return executeContractCLI(cmd, types.PoEContractTypeOversightCommunity, contract.TrustedCircleExecute{
+		Propose: &contract.ProposeMsg{
+			Title:       title,
+			Description: description,
+			Proposal:    proposal,
+		},
+	}, nil)
+}
+
+func addListMembersFlags(cmd *cobra.Command) {
+	cmd.Flags().String(flagStartAfter, "", "Member address to start after")
+	cmd.Flags().Uint32(flags.FlagLimit, 0, "Maximum number of members to return, contract default when 0")
+}
+
+func listMembersQuery(cmd *cobra.Command) (*contract.ListMembersQuery, error) {
+	startAfter, err := cmd.Flags().GetString(flagStartAfter)
+	if err != nil {
+		return nil, err
+	}
+	if startAfter != "" {
+		if _, err := sdk.AccAddressFromBech32(startAfter); err != nil {
+			return nil, err
+		}
+	}
+	limit, err := cmd.Flags().GetUint32(flags.FlagLimit)
+	if err != nil {
+		return nil, err
+	}
+	return &contract.ListMembersQuery{StartAfter: startAfter, Limit: int(limit)}, nil
+}
+
+func parseAddresses(args []string) ([]string, error) {
+	r := make([]string, len(args))
+	for i, a := range args {
+		addr, err := sdk.AccAddressFromBech32(a)
+		if err != nil {
+			return nil, fmt.Errorf("address %q: %v", a, err)
+		}
+		r[i] = addr.String()
+	}
+	return r, nil
+}
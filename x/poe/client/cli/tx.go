@@ -1,28 +1,26 @@
 package cli
 
 import (
-	"context"
-	"encoding/json"
 	"fmt"
 	"os"
 	"strings"
 
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
-	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 	stakingcli "github.com/cosmos/cosmos-sdk/x/staking/client/cli"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
-	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	flag "github.com/spf13/pflag"
 
-	poecontracts "github.com/confio/tgrade/x/poe/contract"
 	"github.com/confio/tgrade/x/poe/types"
 )
 
@@ -50,6 +48,11 @@ func NewTxCmd() *cobra.Command {
 		NewUnjailTxCmd(),
 		NewClaimRewardsCmd(),
 		NewSetWithdrawAddressCmd(),
+		NewSetFeeSponsorCmd(),
+		NewOCTxCmd(),
+		NewAPTxCmd(),
+		NewValidatorVotingTxCmd(),
+		NewTrustedCircleTxCmd(),
 	)
 
 	return poeTxCmd
@@ -446,25 +449,7 @@ $ %s tx poe unjail --from mykey
 			if err != nil {
 				return err
 			}
-			queryClient := types.NewQueryClient(clientCtx)
-			res, err := queryClient.ContractAddress(cmd.Context(), &types.QueryContractAddressRequest{ContractType: types.PoEContractTypeValset})
-			if err != nil {
-				return errors.Wrap(err, "query valset contract address")
-			}
-			nodeOperator := clientCtx.GetFromAddress()
-			unjailMsg := &poecontracts.TG4ValsetExecute{
-				Unjail: &poecontracts.UnjailMsg{},
-			}
-			unjailBz, err := json.Marshal(unjailMsg)
-			if err != nil {
-				return errors.Wrap(err, "encode msg payload")
-			}
-
-			msg := &wasmtypes.MsgExecuteContract{
-				Sender:   nodeOperator.String(),
-				Contract: res.Address,
-				Msg:      unjailBz,
-			}
+			msg := types.NewMsgUnjail(clientCtx.GetFromAddress())
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -494,9 +479,6 @@ $ %s tx poe claim-rewards --distribution --engagement
 			if err != nil {
 				return err
 			}
-			queryClient := types.NewQueryClient(clientCtx)
-			nodeOperator := clientCtx.GetFromAddress()
-
 			distrRewards, err := cmd.Flags().GetBool(flagDistribution)
 			if err != nil {
 				return err
@@ -505,24 +487,12 @@ $ %s tx poe claim-rewards --distribution --engagement
 			if err != nil {
 				return err
 			}
-
-			msgs := []sdk.Msg{}
-			if distrRewards || !(distrRewards || engRewards) {
-				distrMsg, err := buildDistributionWithdrawRewardsMsgExecute(cmd.Context(), queryClient, nodeOperator.String())
-				if err != nil {
-					return err
-				}
-				msgs = append(msgs, distrMsg)
-			}
-
-			if engRewards || !(distrRewards || engRewards) {
-				engMsg, err := buildEngagementWithdrawRewardsMsgExecute(cmd.Context(), queryClient, nodeOperator.String())
-				if err != nil {
-					return err
-				}
-				msgs = append(msgs, engMsg)
+			none := !(distrRewards || engRewards)
+			msg := types.NewMsgWithdrawRewards(clientCtx.GetFromAddress(), distrRewards || none, engRewards || none)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
 			}
-			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msgs...)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
@@ -546,89 +516,81 @@ $ %s tx poe set-withdraw-address tgrade1n4kjhlrpapnpv0n0e3048ydftrjs9m6mm473jf`,
 			if err != nil {
 				return err
 			}
-			queryClient := types.NewQueryClient(clientCtx)
-			res, err := queryClient.ContractAddress(cmd.Context(), &types.QueryContractAddressRequest{ContractType: types.PoEContractTypeEngagement})
+			withdrawAddress, err := sdk.AccAddressFromBech32(args[0])
 			if err != nil {
-				return errors.Wrap(err, "query engagement contract address")
+				return err
+			}
+			msg := types.NewMsgSetWithdrawAddress(clientCtx.GetFromAddress(), withdrawAddress)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
 			}
-			nodeOperator := clientCtx.GetFromAddress()
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewSetFeeSponsorCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-fee-sponsor [tx-json-file] [sponsor-contract-addr]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Set a fee sponsor contract to pay the fees of an unsigned TX",
+		Long: fmt.Sprintf(`Set a contract with the fee sponsor privilege to pay the fees of an unsigned TX.
+The TX is printed as JSON and must be signed and broadcast afterwards.
 
-			delegateAddress, err := sdk.AccAddressFromBech32(args[0])
+Example:
+$ %s tx poe unjail --from mykey --generate-only > unsigned.json
+$ %s tx poe set-fee-sponsor unsigned.json tgrade1... > sponsored.json
+$ %s tx sign sponsored.json --from mykey
+`, version.AppName, version.AppName, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			stdTx, err := authclient.ReadTxFromFile(clientCtx, args[0])
 			if err != nil {
 				return err
 			}
-			delegateMsg := &poecontracts.TG4EngagementExecute{
-				DelegateWithdrawal: &poecontracts.DelegateWithdrawalMsg{
-					Delegated: delegateAddress.String(),
-				},
-			}
-			delegateBz, err := json.Marshal(delegateMsg)
+			sponsor, err := sdk.AccAddressFromBech32(args[1])
 			if err != nil {
-				return errors.Wrap(err, "encode msg payload")
+				return sdkerrors.Wrap(err, "sponsor contract")
 			}
-
-			msg := &wasmtypes.MsgExecuteContract{
-				Sender:   nodeOperator.String(),
-				Contract: res.Address,
-				Msg:      delegateBz,
+			sponsoredTx, err := withFeeSponsor(clientCtx.TxConfig, stdTx, sponsor)
+			if err != nil {
+				return err
 			}
-			if err := msg.ValidateBasic(); err != nil {
+			bz, err := clientCtx.TxConfig.TxJSONEncoder()(sponsoredTx)
+			if err != nil {
 				return err
 			}
-			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+			return clientCtx.PrintBytes(bz)
 		},
 	}
-
-	flags.AddTxFlagsToCmd(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
 
-func buildDistributionWithdrawRewardsMsgExecute(ctx context.Context, queryClient types.QueryClient, sender string) (*wasmtypes.MsgExecuteContract, error) {
-	res, err := queryClient.ContractAddress(ctx, &types.QueryContractAddressRequest{ContractType: types.PoEContractTypeDistribution})
+// withFeeSponsor returns the unsigned TX with the fee sponsor extension option set
+func withFeeSponsor(txConfig client.TxConfig, stdTx sdk.Tx, sponsor sdk.AccAddress) (sdk.Tx, error) {
+	txBuilder, err := txConfig.WrapTxBuilder(stdTx)
 	if err != nil {
-		return nil, errors.Wrap(err, "query distribution contract address")
-	}
-	withdrawRewardsMsg := &poecontracts.TrustedCircleExecute{
-		WithdrawRewards: &struct{}{},
+		return nil, err
 	}
-	withdrawRewardsBz, err := json.Marshal(withdrawRewardsMsg)
+	sigs, err := txBuilder.GetTx().GetSignaturesV2()
 	if err != nil {
-		return nil, errors.Wrap(err, "encode msg payload")
-	}
-
-	msg := &wasmtypes.MsgExecuteContract{
-		Sender:   sender,
-		Contract: res.Address,
-		Msg:      withdrawRewardsBz,
-	}
-	if err := msg.ValidateBasic(); err != nil {
 		return nil, err
 	}
-
-	return msg, nil
-}
-
-func buildEngagementWithdrawRewardsMsgExecute(ctx context.Context, queryClient types.QueryClient, sender string) (*wasmtypes.MsgExecuteContract, error) {
-	res, err := queryClient.ContractAddress(ctx, &types.QueryContractAddressRequest{ContractType: types.PoEContractTypeEngagement})
-	if err != nil {
-		return nil, errors.Wrap(err, "query engagement contract address")
+	if len(sigs) != 0 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "tx must not be signed")
 	}
-	withdrawRewardsMsg := &poecontracts.TG4EngagementExecute{
-		WithdrawRewards: &poecontracts.WithdrawRewardsMsg{},
+	extBuilder, ok := txBuilder.(authtx.ExtensionOptionsTxBuilder)
+	if !ok {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidType, "tx builder does not support extension options")
 	}
-	withdrawRewardsBz, err := json.Marshal(withdrawRewardsMsg)
+	ext, err := codectypes.NewAnyWithValue(&types.FeeSponsorExtension{Contract: sponsor.String()})
 	if err != nil {
-		return nil, errors.Wrap(err, "encode msg payload")
-	}
-
-	msg := &wasmtypes.MsgExecuteContract{
-		Sender:   sender,
-		Contract: res.Address,
-		Msg:      withdrawRewardsBz,
-	}
-	if err := msg.ValidateBasic(); err != nil {
 		return nil, err
 	}
-
-	return msg, nil
+	extBuilder.SetExtensionOptions(ext)
+	return extBuilder.GetTx(), nil
 }
@@ -0,0 +1,67 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/confio/tgrade/x/poe/types"
+)
+
+func TestWithFeeSponsor(t *testing.T) {
+	registry := codectypes.NewInterfaceRegistry()
+	types.RegisterInterfaces(registry)
+	txConfig := authtx.NewTxConfig(codec.NewProtoCodec(registry), authtx.DefaultSignModes)
+	mySponsor := types.RandomAccAddress()
+
+	specs := map[string]struct {
+		signed bool
+		expErr bool
+	}{
+		"unsigned tx": {},
+		"signed tx": {
+			signed: true,
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			txBuilder := txConfig.NewTxBuilder()
+			require.NoError(t, txBuilder.SetMsgs(types.NewMsgUnjail(types.RandomAccAddress())))
+			if spec.signed {
+				require.NoError(t, txBuilder.SetSignatures(signing.SignatureV2{
+					PubKey:   secp256k1.GenPrivKey().PubKey(),
+					Data:     &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT, Signature: []byte("signature")},
+					Sequence: 1,
+				}))
+			}
+
+			// when
+			gotTx, gotErr := withFeeSponsor(txConfig, txBuilder.GetTx(), mySponsor)
+
+			// then
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			gotOpts := gotTx.(ante.HasExtensionOptionsTx).GetExtensionOptions()
+			require.Len(t, gotOpts, 1)
+			var gotExt types.FeeSponsorExtension
+			require.NoError(t, gotExt.Unmarshal(gotOpts[0].Value))
+			assert.Equal(t, mySponsor.String(), gotExt.Contract)
+			// and it can be encoded and decoded
+			bz, err := txConfig.TxEncoder()(gotTx)
+			require.NoError(t, err)
+			_, err = txConfig.TxDecoder()(bz)
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,245 @@
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/version"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	"github.com/spf13/cobra"
+
+	"github.com/confio/tgrade/x/poe/contract"
+	"github.com/confio/tgrade/x/poe/types"
+)
+
+const validatorVotingGroup = "validator-voting"
+
+// NewValidatorVotingTxCmd returns the validator voting proposal transaction commands.
+func NewValidatorVotingTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        validatorVotingGroup,
+		Short:                      "Validator voting proposal subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	cmd.AddCommand(
+		NewValidatorVotingProposeUpgradeCmd(),
+		NewValidatorVotingProposeConsensusParamsCmd(),
+		NewValidatorVotingProposeMigrateCmd(),
+		NewVoteProposalCmd(types.PoEContractTypeValidatorVoting, validatorVotingGroup),
+		NewExecuteProposalCmd(types.PoEContractTypeValidatorVoting, validatorVotingGroup),
+	)
+	return cmd
+}
+
+// GetValidatorVotingQueryCmd returns the validator voting proposal query commands.
+func GetValidatorVotingQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        validatorVotingGroup,
+		Short:                      "Querying commands for the validator voting proposals",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	cmd.AddCommand(
+		GetCmdQueryProposals(types.PoEContractTypeValidatorVoting, validatorVotingGroup),
+		GetCmdQueryProposal(types.PoEContractTypeValidatorVoting, validatorVotingGroup),
+		GetCmdQueryVotes(types.PoEContractTypeValidatorVoting, validatorVotingGroup),
+		GetCmdQueryTally(types.PoEContractTypeValidatorVoting, validatorVotingGroup),
+	)
+	return cmd
+}
+
+// NewValidatorVotingProposeUpgradeCmd returns a command to propose a chain upgrade.
+func NewValidatorVotingProposeUpgradeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "propose-upgrade [name] [height]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Propose a chain upgrade at the given height",
+		Long: fmt.Sprintf(`Propose a chain upgrade with the given upgrade handler name at the given height.
+
+Example:
+$ %s tx poe validator-voting propose-upgrade v2 1000000 --info "binaries" --title "Upgrade v2" --description "Details" --from mykey`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			height, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("height argument provided must be a positive integer: %v", err)
+			}
+			info, err := cmd.Flags().GetString(flagUpgradeInfo)
+			if err != nil {
+				return err
+			}
+			plan := upgradetypes.Plan{Name: args[0], Height: height, Info: info}
+			if err := plan.ValidateBasic(); err != nil {
+				return err
+			}
+			return proposeValidatorVotingCLI(cmd, contract.ValidatorProposal{
+				RegisterUpgrade: &contract.ChainUpgrade{Name: plan.Name, Height: uint64(plan.Height), Info: plan.Info},
+			})
+		},
+	}
+
+	cmd.Flags().String(flagUpgradeInfo, "", "Optional upgrade info, for example the binaries to use")
+	cmd.Flags().AddFlagSet(flagSetProposal())
+	_ = cmd.MarkFlagRequired(flagTitle)
+	_ = cmd.MarkFlagRequired(flagDescription)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewValidatorVotingProposeConsensusParamsCmd returns a command to propose consensus block and evidence param updates.
+func NewValidatorVotingProposeConsensusParamsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "propose-consensus-params",
+		Args:  cobra.NoArgs,
+		Short: "Propose an update of consensus block and evidence params",
+		Long: fmt.Sprintf(`Propose an update of consensus block and evidence params. Only the params set by flag are changed.
+
+Example:
+$ %s tx poe validator-voting propose-consensus-params --block-max-gas 40000000 --evidence-max-age-duration 336h --title "Params" --description "Details" --from mykey`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			proposal, err := consensusParamsProposal(cmd)
+			if err != nil {
+				return err
+			}
+			return proposeValidatorVotingCLI(cmd, proposal)
+		},
+	}
+
+	cmd.Flags().Int64(flagBlockMaxBytes, 0, "Maximum number of bytes (over all tx) to be included in a block")
+	cmd.Flags().Int64(flagBlockMaxGas, 0, "Maximum gas (over all tx) to be executed in one block, -1 for unlimited")
+	cmd.Flags().Int64(flagEvidenceMaxAgeNumBlocks, 0, "Max age of evidence, in blocks")
+	cmd.Flags().Duration(flagEvidenceMaxAgeDuration, 0, "Max age of evidence, in full seconds. Should correspond with the unbonding period")
+	cmd.Flags().Int64(flagEvidenceMaxBytes, 0, "Maximum number of bytes of evidence to be included in a block")
+	cmd.Flags().AddFlagSet(flagSetProposal())
+	_ = cmd.MarkFlagRequired(flagTitle)
+	_ = cmd.MarkFlagRequired(flagDescription)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewValidatorVotingProposeMigrateCmd returns a command to propose a contract migration.
+func NewValidatorVotingProposeMigrateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "propose-migrate [contract] [code-id] [json-encoded-migration-args]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Propose to migrate a contract to a new code version",
+		Long: fmt.Sprintf(`Propose to migrate a contract to the given code id.
+
+Example:
+$ %s tx poe validator-voting propose-migrate tgrade14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9s0xyzuw 7 '{}' --title "Migrate" --description "Details" --from mykey`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			contractAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return sdkerrors.Wrap(err, "contract")
+			}
+			codeID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("code id argument provided must be a positive integer: %v", err)
+			}
+			if codeID == 0 {
+				return errors.New("code id must not be 0")
+			}
+			migrateMsg := []byte(args[2])
+			if !json.Valid(migrateMsg) {
+				return errors.New("migration args must be valid json")
+			}
+			return proposeValidatorVotingCLI(cmd, contract.ValidatorProposal{
+				MigrateContract: &contract.Migration{Contract: contractAddr.String(), CodeID: codeID, MigrateMsg: migrateMsg},
+			})
+		},
+	}
+
+	cmd.Flags().AddFlagSet(flagSetProposal())
+	_ = cmd.MarkFlagRequired(flagTitle)
+	_ = cmd.MarkFlagRequired(flagDescription)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// consensusParamsProposal builds the proposal from the flags that were set
+func consensusParamsProposal(cmd *cobra.Command) (contract.ValidatorProposal, error) {
+	var proposal contract.ValidatorProposal
+	fs := cmd.Flags()
+	int64Flag := func(name string, valid func(int64) bool) (*int64, error) {
+		if !fs.Changed(name) {
+			return nil, nil
+		}
+		v, err := fs.GetInt64(name)
+		if err != nil {
+			return nil, err
+		}
+		if !valid(v) {
+			return nil, fmt.Errorf("invalid %s: %d", name, v)
+		}
+		return &v, nil
+	}
+	positive := func(v int64) bool { return v > 0 }
+
+	var block contract.ConsensusBlockParamsUpdate
+	var err error
+	if block.MaxBytes, err = int64Flag(flagBlockMaxBytes, positive); err != nil {
+		return proposal, err
+	}
+	if block.MaxGas, err = int64Flag(flagBlockMaxGas, func(v int64) bool { return v >= -1 }); err != nil {
+		return proposal, err
+	}
+	var evidence contract.ConsensusEvidenceParamsUpdate
+	if evidence.MaxAgeNumBlocks, err = int64Flag(flagEvidenceMaxAgeNumBlocks, positive); err != nil {
+		return proposal, err
+	}
+	if evidence.MaxBytes, err = int64Flag(flagEvidenceMaxBytes, positive); err != nil {
+		return proposal, err
+	}
+	if fs.Changed(flagEvidenceMaxAgeDuration) {
+		d, err := fs.GetDuration(flagEvidenceMaxAgeDuration)
+		if err != nil {
+			return proposal, err
+		}
+		if d < time.Second || d%time.Second != 0 {
+			return proposal, fmt.Errorf("invalid %s: must be full seconds: %s", flagEvidenceMaxAgeDuration, d)
+		}
+		seconds := int64(d / time.Second)
+		evidence.MaxAgeDuration = &seconds
+	}
+
+	if block.ValidateBasic() == nil {
+		proposal.UpdateConsensusBlockParams = &block
+	}
+	if evidence.ValidateBasic() == nil {
+		proposal.UpdateConsensusEvidenceParams = &evidence
+	}
+	if proposal.UpdateConsensusBlockParams == nil && proposal.UpdateConsensusEvidenceParams == nil {
+		return proposal, errors.New("at least one block or evidence param must be set")
+	}
+	if proposal.UpdateConsensusBlockParams != nil && proposal.UpdateConsensusEvidenceParams != nil {
+		return proposal, errors.New("block and evidence params must be updated in separate proposals")
+	}
+	return proposal, nil
+}
+
+func proposeValidatorVotingCLI(cmd *cobra.Command, proposal contract.ValidatorProposal) error {
+	title, err := cmd.Flags().GetString(flagTitle)
+	if err != nil {
+		return err
+	}
+	description, err := cmd.Flags().GetString(flagDescription)
+	if err != nil {
+		return err
+	}
+	return executeContractCLI(cmd, types.PoEContractTypeValidatorVoting, contract.ValidatorVotingExecuteMsg{
+		Propose: &contract.ValidatorVotingPropose{
+			Title:       title,
+			Description: description,
+			Proposal:    proposal,
+		},
+	}, nil)
+}
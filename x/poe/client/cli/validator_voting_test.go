@@ -0,0 +1,70 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/confio/tgrade/x/poe/contract"
+)
+
+func TestConsensusParamsProposal(t *testing.T) {
+	var (
+		one      int64 = 1
+		minusOne int64 = -1
+		hour     int64 = 3600
+	)
+	specs := map[string]struct {
+		args   []string
+		exp    contract.ValidatorProposal
+		expErr bool
+	}{
+		"block params": {
+			args: []string{"--block-max-bytes=1", "--block-max-gas=-1"},
+			exp: contract.ValidatorProposal{
+				UpdateConsensusBlockParams: &contract.ConsensusBlockParamsUpdate{MaxBytes: &one, MaxGas: &minusOne},
+			},
+		},
+		"evidence params": {
+			args: []string{"--evidence-max-age-num-blocks=1", "--evidence-max-age-duration=1h", "--evidence-max-bytes=1"},
+			exp: contract.ValidatorProposal{
+				UpdateConsensusEvidenceParams: &contract.ConsensusEvidenceParamsUpdate{MaxAgeNumBlocks: &one, MaxAgeDuration: &hour, MaxBytes: &one},
+			},
+		},
+		"no params": {
+			expErr: true,
+		},
+		"block and evidence params": {
+			args:   []string{"--block-max-bytes=1", "--evidence-max-bytes=1"},
+			expErr: true,
+		},
+		"zero block max bytes": {
+			args:   []string{"--block-max-bytes=0"},
+			expErr: true,
+		},
+		"invalid block max gas": {
+			args:   []string{"--block-max-gas=-2"},
+			expErr: true,
+		},
+		"evidence duration not full seconds": {
+			args:   []string{"--evidence-max-age-duration=1500ms"},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			cmd := NewValidatorVotingProposeConsensusParamsCmd()
+			require.NoError(t, cmd.ParseFlags(spec.args))
+			// when
+			got, gotErr := consensusParamsProposal(cmd)
+			// then
+			if spec.expErr {
+				assert.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.exp, got)
+		})
+	}
+}
@@ -0,0 +1,390 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+
+	"github.com/confio/tgrade/x/poe/contract"
+	"github.com/confio/tgrade/x/poe/types"
+)
+
+// NewVoteProposalCmd returns a command to vote on a proposal of the given voting contract.
+func NewVoteProposalCmd(ctype types.PoEContractType, group string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vote [proposal-id] [yes|no|abstain|veto]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Vote on a proposal",
+		Long: fmt.Sprintf(`Vote on an open proposal.
+
+Example:
+$ %s tx poe %s vote 1 yes --from mykey`, version.AppName, group),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			proposalID, err := parseProposalID(args[0])
+			if err != nil {
+				return err
+			}
+			vote, err := parseVote(args[1])
+			if err != nil {
+				return err
+			}
+			return executeContractCLI(cmd, ctype, contract.OCProposalsExecuteMsg{
+				Vote: &contract.VoteMsg{ProposalID: proposalID, Vote: vote},
+			}, nil)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewExecuteProposalCmd returns a command to execute a passed proposal of the given voting contract.
+func NewExecuteProposalCmd(ctype types.PoEContractType, group string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "execute [proposal-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Execute a passed proposal",
+		Long: fmt.Sprintf(`Execute a proposal that has passed.
+
+Example:
+$ %s tx poe %s execute 1 --from mykey`, version.AppName, group),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			proposalID, err := parseProposalID(args[0])
+			if err != nil {
+				return err
+			}
+			return executeContractCLI(cmd, ctype, contract.OCProposalsExecuteMsg{
+				Execute: &contract.ProposalID{ProposalID: proposalID},
+			}, nil)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCloseProposalCmd returns a command to close a rejected or expired proposal of the given voting contract.
+func NewCloseProposalCmd(ctype types.PoEContractType, group string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "close [proposal-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Close a rejected or expired proposal",
+		Long: fmt.Sprintf(`Close a proposal that did not pass before the end of the voting period.
+
+Example:
+$ %s tx poe %s close 1 --from mykey`, version.AppName, group),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			proposalID, err := parseProposalID(args[0])
+			if err != nil {
+				return err
+			}
+			return executeContractCLI(cmd, ctype, contract.OCProposalsExecuteMsg{
+				Close: &contract.ProposalID{ProposalID: proposalID},
+			}, nil)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryProposals returns a command to list the proposals of the given voting contract.
+func GetCmdQueryProposals(ctype types.PoEContractType, group string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "proposals",
+		Args:  cobra.NoArgs,
+		Short: "Query all proposals",
+		Long: fmt.Sprintf(`Query all proposals ordered by id. The status filter is applied to the returned page.
+
+Example:
+$ %s query poe %s proposals --reverse --limit 10 --status open`, version.AppName, group),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			startAfter, err := cmd.Flags().GetUint64(flagStartAfter)
+			if err != nil {
+				return err
+			}
+			limit, err := cmd.Flags().GetUint32(flags.FlagLimit)
+			if err != nil {
+				return err
+			}
+			reverse, err := cmd.Flags().GetBool(flagReverse)
+			if err != nil {
+				return err
+			}
+			status, err := cmd.Flags().GetString(flagStatus)
+			if err != nil {
+				return err
+			}
+			listQuery := &contract.ListProposalQuery{StartAfter: startAfter, Limit: limit}
+			query := contract.ProposalsQuery{ListProposals: listQuery}
+			if reverse {
+				query = contract.ProposalsQuery{ReverseProposals: listQuery}
+			}
+			if status == "" {
+				return querySmartContractCLI(cmd, ctype, query)
+			}
+			if !isProposalStatus(contract.ProposalStatus(status)) {
+				return fmt.Errorf("status must be one of pending, open, rejected, passed or executed: %s", status)
+			}
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			res, err := querySmartContract(cmd, clientCtx, ctype, query)
+			if err != nil {
+				return err
+			}
+			var rsp struct {
+				Proposals []json.RawMessage `json:"proposals"`
+			}
+			if err := json.Unmarshal(res.Data, &rsp); err != nil {
+				return sdkerrors.Wrap(err, "decode proposals")
+			}
+			filtered := make([]json.RawMessage, 0, len(rsp.Proposals))
+			for _, p := range rsp.Proposals {
+				var proposal struct {
+					Status contract.ProposalStatus `json:"status"`
+				}
+				if err := json.Unmarshal(p, &proposal); err != nil {
+					return sdkerrors.Wrap(err, "decode proposal")
+				}
+				if proposal.Status == contract.ProposalStatus(status) {
+					filtered = append(filtered, p)
+				}
+			}
+			rsp.Proposals = filtered
+			bz, err := json.Marshal(rsp)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintBytes(bz)
+		},
+	}
+
+	cmd.Flags().Uint64(flagStartAfter, 0, "Proposal id to start after")
+	cmd.Flags().Uint32(flags.FlagLimit, 0, "Maximum number of proposals to return, contract default when 0")
+	cmd.Flags().Bool(flagReverse, false, "List newest proposals first")
+	cmd.Flags().String(flagStatus, "", "Only show proposals with the given status: pending, open, rejected, passed or executed")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryProposal returns a command to query a single proposal of the given voting contract.
+func GetCmdQueryProposal(ctype types.PoEContractType, group string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "proposal [proposal-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query a proposal",
+		Long: fmt.Sprintf(`Query a proposal by id.
+
+Example:
+$ %s query poe %s proposal 1`, version.AppName, group),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			proposalID, err := parseProposalID(args[0])
+			if err != nil {
+				return err
+			}
+			return querySmartContractCLI(cmd, ctype, contract.ProposalsQuery{
+				Proposal: &contract.ProposalID{ProposalID: proposalID},
+			})
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryVotes returns a command to list the votes on a proposal of the given voting contract.
+func GetCmdQueryVotes(ctype types.PoEContractType, group string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "votes [proposal-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the votes on a proposal",
+		Long: fmt.Sprintf(`Query the votes on a proposal ordered by voter address.
+
+Example:
+$ %s query poe %s votes 1`, version.AppName, group),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			proposalID, err := parseProposalID(args[0])
+			if err != nil {
+				return err
+			}
+			startAfter, err := cmd.Flags().GetString(flagStartAfter)
+			if err != nil {
+				return err
+			}
+			limit, err := cmd.Flags().GetUint32(flags.FlagLimit)
+			if err != nil {
+				return err
+			}
+			return querySmartContractCLI(cmd, ctype, contract.ProposalsQuery{
+				ListVotes: &contract.ListVotesQuery{ProposalID: proposalID, StartAfter: startAfter, Limit: limit},
+			})
+		},
+	}
+
+	cmd.Flags().String(flagStartAfter, "", "Voter address to start after")
+	cmd.Flags().Uint32(flags.FlagLimit, 0, "Maximum number of votes to return, contract default when 0")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryTally returns a command to query the vote tally of a proposal of the given voting contract.
+func GetCmdQueryTally(ctype types.PoEContractType, group string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tally [proposal-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the vote tally of a proposal",
+		Long: fmt.Sprintf(`Query the status, the votes by option and the total voting points of a proposal.
+
+Example:
+$ %s query poe %s tally 1`, version.AppName, group),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			proposalID, err := parseProposalID(args[0])
+			if err != nil {
+				return err
+			}
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			res, err := querySmartContract(cmd, clientCtx, ctype, contract.ProposalsQuery{
+				Proposal: &contract.ProposalID{ProposalID: proposalID},
+			})
+			if err != nil {
+				return err
+			}
+			var tally struct {
+				ID          uint64                  `json:"id"`
+				Status      contract.ProposalStatus `json:"status"`
+				TotalPoints uint64                  `json:"total_points"`
+				Votes       contract.Votes          `json:"votes"`
+				Rules       contract.VotingRules    `json:"rules"`
+			}
+			if err := json.Unmarshal(res.Data, &tally); err != nil {
+				return sdkerrors.Wrap(err, "decode proposal")
+			}
+			bz, err := json.Marshal(tally)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintBytes(bz)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// executeContractCLI sends the json encoded payload to the PoE contract of the given type
+func executeContractCLI(cmd *cobra.Command, ctype types.PoEContractType, payload interface{}, funds sdk.Coins) error {
+	clientCtx, err := client.GetClientTxContext(cmd)
+	if err != nil {
+		return err
+	}
+	contractAddr, err := resolveContractAddress(cmd, clientCtx, ctype)
+	if err != nil {
+		return err
+	}
+	payloadBz, err := json.Marshal(payload)
+	if err != nil {
+		return sdkerrors.Wrap(err, "encode msg payload")
+	}
+	msg := &wasmtypes.MsgExecuteContract{
+		Sender:   clientCtx.GetFromAddress().String(),
+		Contract: contractAddr,
+		Msg:      payloadBz,
+		Funds:    funds,
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+	return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+}
+
+// querySmartContractCLI sends the json encoded query to the PoE contract of the given type and prints the result
+func querySmartContractCLI(cmd *cobra.Command, ctype types.PoEContractType, query interface{}) error {
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return err
+	}
+	res, err := querySmartContract(cmd, clientCtx, ctype, query)
+	if err != nil {
+		return err
+	}
+	return clientCtx.PrintProto(res)
+}
+
+// querySmartContract sends the json encoded query to the PoE contract of the given type
+func querySmartContract(cmd *cobra.Command, clientCtx client.Context, ctype types.PoEContractType, query interface{}) (*wasmtypes.QuerySmartContractStateResponse, error) {
+	contractAddr, err := resolveContractAddress(cmd, clientCtx, ctype)
+	if err != nil {
+		return nil, err
+	}
+	queryBz, err := json.Marshal(query)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "encode query payload")
+	}
+	return wasmtypes.NewQueryClient(clientCtx).SmartContractState(cmd.Context(), &wasmtypes.QuerySmartContractStateRequest{
+		Address:   contractAddr,
+		QueryData: queryBz,
+	})
+}
+
+// resolveContractAddress returns the address from the contract flag when set. Otherwise the address of the PoE contract of
+// the given type.
+func resolveContractAddress(cmd *cobra.Command, clientCtx client.Context, ctype types.PoEContractType) (string, error) {
+	if f := cmd.Flags().Lookup(flagContract); f != nil && f.Value.String() != "" {
+		if _, err := sdk.AccAddressFromBech32(f.Value.String()); err != nil {
+			return "", sdkerrors.Wrap(err, "contract")
+		}
+		return f.Value.String(), nil
+	}
+	return queryContractAddress(cmd.Context(), clientCtx, ctype)
+}
+
+func queryContractAddress(ctx context.Context, clientCtx client.Context, ctype types.PoEContractType) (string, error) {
+	res, err := types.NewQueryClient(clientCtx).ContractAddress(ctx, &types.QueryContractAddressRequest{ContractType: ctype})
+	if err != nil {
+		return "", sdkerrors.Wrapf(err, "query %s contract address", strings.ToLower(ctype.String()))
+	}
+	return res.Address, nil
+}
+
+func parseProposalID(s string) (uint64, error) {
+	id, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("proposal id argument provided must be a non-negative-integer: %v", err)
+	}
+	return id, nil
+}
+
+func isProposalStatus(s contract.ProposalStatus) bool {
+	switch s {
+	case contract.ProposalStatusPending, contract.ProposalStatusOpen, contract.ProposalStatusRejected,
+		contract.ProposalStatusPassed, contract.ProposalStatusExecuted:
+		return true
+	default:
+		return false
+	}
+}
+
+func parseVote(s string) (contract.Vote, error) {
+	switch v := contract.Vote(strings.ToLower(s)); v {
+	case contract.YesVote, contract.NoVote, contract.AbstainVote, contract.VetoVote:
+		return v, nil
+	default:
+		return "", fmt.Errorf("vote must be one of yes, no, abstain or veto: %s", s)
+	}
+}
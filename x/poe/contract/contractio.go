@@ -181,6 +181,20 @@ func SetEngagementPoints(ctx sdk.Context, contractAddr sdk.AccAddress, k types.S
 	return sdkerrors.Wrap(err, "sudo")
 }
 
+// WithdrawRewards calls the distribution or engagement contract to withdraw the owner's rewards
+func WithdrawRewards(ctx sdk.Context, contractAddr sdk.AccAddress, ownerAddress sdk.AccAddress, k types.Executor) error {
+	msg := TG4EngagementExecute{
+		WithdrawRewards: &WithdrawRewardsMsg{},
+	}
+	msgBz, err := json.Marshal(msg)
+	if err != nil {
+		return sdkerrors.Wrap(err, "TG4EngagementExecute message")
+	}
+
+	_, err = k.Execute(ctx, contractAddr, ownerAddress, msgBz, nil)
+	return sdkerrors.Wrap(err, "execute contract")
+}
+
 func ConvertToTendermintPubKey(key ValidatorPubkey) (crypto.PublicKey, error) {
 	switch {
 	case key.Ed25519 != nil:
@@ -98,6 +98,34 @@ func (a EngagementContractAdapter) UpdateAdmin(ctx sdk.Context, newAdmin, sender
 	return a.doExecute(ctx, msg, sender)
 }
 
+// DelegateWithdrawal sets the address that is allowed to withdraw the sender's rewards
+func (a EngagementContractAdapter) DelegateWithdrawal(ctx sdk.Context, delegated, sender sdk.AccAddress) error {
+	msg := TG4EngagementExecute{
+		DelegateWithdrawal: &DelegateWithdrawalMsg{Delegated: delegated.String()},
+	}
+	return a.doExecute(ctx, msg, sender)
+}
+
+// ListMembersByPoints returns the engagement group members ordered by points descending.
+// The returned cursor is the last element and can be used to fetch the next page.
+func (a EngagementContractAdapter) ListMembersByPoints(ctx sdk.Context, pagination *Paginator) ([]TG4Member, PaginationCursor, error) {
+	if err := a.addressLookupErr; err != nil {
+		return nil, nil, err
+	}
+	members, err := QueryTG4MembersByWeight(ctx, a.twasmKeeper, a.contractAddr, pagination)
+	if err != nil {
+		return nil, nil, sdkerrors.Wrap(err, "contract query")
+	}
+	if len(members) == 0 {
+		return members, nil, nil
+	}
+	cursor, err := json.Marshal(members[len(members)-1])
+	if err != nil {
+		return nil, nil, sdkerrors.Wrap(err, "cursor")
+	}
+	return members, cursor, nil
+}
+
 // EngagementQuery will create many queries for the engagement contract
 // See https://github.com/confio/poe-contracts/blob/v0.5.3-2/contracts/tg4-engagement/src/msg.rs#L77-L123
 type EngagementQuery struct {
@@ -105,6 +105,57 @@ func TestQueryDelegated(t *testing.T) {
 	}
 }
 
+func TestDelegateWithdrawal(t *testing.T) {
+	// setup contracts and seed some data
+	ctx, example, vals, _ := setupPoEContracts(t)
+
+	contractAddr, err := example.PoEKeeper.GetPoEContractAddress(ctx, types.PoEContractTypeEngagement)
+	require.NoError(t, err)
+	adaptor := contract.NewEngagementContractAdapter(contractAddr, example.TWasmKeeper, nil)
+
+	ownerAddr, err := sdk.AccAddressFromBech32(vals[0].OperatorAddress)
+	require.NoError(t, err)
+	var myWithdrawAddr sdk.AccAddress = rand.Bytes(address.Len)
+
+	// when
+	gotErr := adaptor.DelegateWithdrawal(ctx, myWithdrawAddr, ownerAddr)
+
+	// then
+	require.NoError(t, gotErr)
+	gotVal, err := adaptor.QueryDelegated(ctx, ownerAddr)
+	require.NoError(t, err)
+	assert.Equal(t, myWithdrawAddr.String(), gotVal.Delegated)
+}
+
+func TestListMembersByPoints(t *testing.T) {
+	// setup contracts and seed some data
+	ctx, example, vals, _ := setupPoEContracts(t)
+
+	contractAddr, err := example.PoEKeeper.GetPoEContractAddress(ctx, types.PoEContractTypeEngagement)
+	require.NoError(t, err)
+	adaptor := contract.NewEngagementContractAdapter(contractAddr, example.TWasmKeeper, nil)
+
+	// when
+	gotMembers, gotCursor, err := adaptor.ListMembersByPoints(ctx, nil)
+
+	// then
+	require.NoError(t, err)
+	require.Len(t, gotMembers, len(vals))
+	for i := 1; i < len(gotMembers); i++ {
+		assert.GreaterOrEqual(t, gotMembers[i-1].Points, gotMembers[i].Points)
+	}
+	assert.NotEmpty(t, gotCursor)
+
+	// and when paginated
+	gotFirstPage, gotCursor, err := adaptor.ListMembersByPoints(ctx, &contract.Paginator{Limit: 1})
+	require.NoError(t, err)
+	require.Len(t, gotFirstPage, 1)
+	gotSecondPage, _, err := adaptor.ListMembersByPoints(ctx, &contract.Paginator{StartAfter: gotCursor, Limit: 1})
+	require.NoError(t, err)
+	require.Len(t, gotSecondPage, 1)
+	assert.Equal(t, gotMembers[0:2], append(gotFirstPage, gotSecondPage...))
+}
+
 func TestQueryWithdrawableRewards(t *testing.T) {
 	// setup contracts and seed some data
 	ctx, example, vals, _ := setupPoEContracts(t, func(gs *types.GenesisState) {
@@ -2,6 +2,9 @@ package contract
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/confio/tgrade/x/poe/types"
 )
 
 // TG4MixerInitMsg contract init message
@@ -38,3 +41,47 @@ type AlgebraicSigmoid struct {
 	P         sdk.Dec `json:"p"`
 	S         sdk.Dec `json:"s"`
 }
+
+// TG4MixerQuery contains the custom queries for the tg4-mixer contract.
+// You can also make any generic TG4Query on it.
+// See https://github.com/confio/tgrade-contracts/blob/main/contracts/tg4-mixer/src/msg.rs
+type TG4MixerQuery struct {
+	MixerFunction *MixerFunctionQuery `json:"mixer_function,omitempty"`
+}
+
+// MixerFunctionQuery evaluates the configured mixer function for the given points
+type MixerFunctionQuery struct {
+	Stake      uint64 `json:"stake,string"`
+	Engagement uint64 `json:"engagement,string"`
+}
+
+// MixerFunctionResponse response to a mixer function query
+type MixerFunctionResponse struct {
+	Points uint64 `json:"points"`
+}
+
+type MixerContractAdapter struct {
+	BaseContractAdapter
+}
+
+// NewMixerContractAdapter constructor
+func NewMixerContractAdapter(contractAddr sdk.AccAddress, twasmKeeper types.TWasmKeeper, addressLookupErr error) *MixerContractAdapter {
+	return &MixerContractAdapter{
+		BaseContractAdapter: NewBaseContractAdapter(
+			contractAddr,
+			twasmKeeper,
+			addressLookupErr,
+		),
+	}
+}
+
+// QueryMixerFunction returns the points the mixer function calculates for the given stake and engagement points
+func (m MixerContractAdapter) QueryMixerFunction(ctx sdk.Context, stake, engagement uint64) (uint64, error) {
+	query := TG4MixerQuery{MixerFunction: &MixerFunctionQuery{Stake: stake, Engagement: engagement}}
+	var rsp MixerFunctionResponse
+	err := m.doQuery(ctx, query, &rsp)
+	if err != nil {
+		return 0, sdkerrors.Wrap(err, "contract query")
+	}
+	return rsp.Points, nil
+}
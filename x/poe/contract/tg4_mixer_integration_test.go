@@ -0,0 +1,62 @@
+package contract_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/confio/tgrade/x/poe/contract"
+	"github.com/confio/tgrade/x/poe/types"
+)
+
+func TestQueryMixerFunction(t *testing.T) {
+	// setup contracts and seed some data
+	ctx, example, vals, _ := setupPoEContracts(t)
+	mixerAddr, err := example.PoEKeeper.GetPoEContractAddress(ctx, types.PoEContractTypeMixer)
+	require.NoError(t, err)
+	stakeAddr, err := example.PoEKeeper.GetPoEContractAddress(ctx, types.PoEContractTypeStaking)
+	require.NoError(t, err)
+	engagementAddr, err := example.PoEKeeper.GetPoEContractAddress(ctx, types.PoEContractTypeEngagement)
+	require.NoError(t, err)
+
+	opAddr, err := sdk.AccAddressFromBech32(vals[0].OperatorAddress)
+	require.NoError(t, err)
+	stake, err := contract.QueryTG4Member(ctx, example.TWasmKeeper, stakeAddr, opAddr)
+	require.NoError(t, err)
+	require.NotNil(t, stake)
+	engagement, err := contract.QueryTG4Member(ctx, example.TWasmKeeper, engagementAddr, opAddr)
+	require.NoError(t, err)
+	require.NotNil(t, engagement)
+	mixed, err := contract.QueryTG4Member(ctx, example.TWasmKeeper, mixerAddr, opAddr)
+	require.NoError(t, err)
+	require.NotNil(t, mixed)
+
+	adapter := contract.NewMixerContractAdapter(mixerAddr, example.TWasmKeeper, nil)
+	specs := map[string]struct {
+		stake, engagement uint64
+		exp               uint64
+	}{
+		"same as validator": {
+			stake:      uint64(*stake),
+			engagement: uint64(*engagement),
+			exp:        uint64(*mixed),
+		},
+		"no stake": {
+			engagement: uint64(*engagement),
+		},
+		"no engagement": {
+			stake: uint64(*stake),
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			// when
+			gotPoints, gotErr := adapter.QueryMixerFunction(ctx, spec.stake, spec.engagement)
+			// then
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.exp, gotPoints)
+		})
+	}
+}
@@ -1,6 +1,8 @@
 package contract
 
 import (
+	"bytes"
+	"encoding/binary"
 	"encoding/json"
 	"time"
 
@@ -97,6 +99,82 @@ type UnbondingPeriodResponse struct {
 	UnbondingPeriod uint64 `json:"unbonding_period"`
 }
 
+// storage keys of the tg4-stake contract
+// See https://github.com/confio/tgrade-contracts/blob/main/contracts/tg4-stake/src/state.rs
+const (
+	StakeConfigStorageKey        = "config"
+	StakeStorageNamespace        = "stake"
+	VestingStakeStorageNamespace = "vesting_stake"
+	ClaimsStorageNamespace       = "claims"
+)
+
+// ContractStateIterator iterates through the raw state of a contract
+type ContractStateIterator interface {
+	IterateContractState(ctx sdk.Context, contractAddress sdk.AccAddress, cb func(key, value []byte) bool)
+}
+
+// stakeConfig subset of the stored tg4-stake contract config
+type stakeConfig struct {
+	Denom string `json:"denom"`
+}
+
+// storedClaim subset of the stored tg4-stake contract claim
+type storedClaim struct {
+	Amount        sdk.Int `json:"amount"`
+	VestingAmount sdk.Int `json:"vesting_amount"`
+}
+
+// SumStakeContractBonded returns the sum of the liquid and vesting stakes plus all pending claims from the raw
+// tg4-stake contract state. These are the tokens that the contract must hold.
+func SumStakeContractBonded(ctx sdk.Context, k ContractStateIterator, stakeAddr sdk.AccAddress) (sdk.Coin, error) {
+	var (
+		denom              string
+		total              = sdk.ZeroInt()
+		err                error
+		stakePrefix        = storageMapPrefix(StakeStorageNamespace)
+		vestingStakePrefix = storageMapPrefix(VestingStakeStorageNamespace)
+		claimsPrefix       = storageMapPrefix(ClaimsStorageNamespace)
+	)
+	k.IterateContractState(ctx, stakeAddr, func(key, value []byte) bool {
+		switch {
+		case bytes.Equal(key, []byte(StakeConfigStorageKey)):
+			var c stakeConfig
+			err = sdkerrors.Wrap(json.Unmarshal(value, &c), "config")
+			denom = c.Denom
+		case bytes.HasPrefix(key, stakePrefix), bytes.HasPrefix(key, vestingStakePrefix):
+			var amount sdk.Int
+			err = sdkerrors.Wrapf(json.Unmarshal(value, &amount), "stake %q", key)
+			if err == nil {
+				total = total.Add(amount)
+			}
+		case bytes.HasPrefix(key, claimsPrefix):
+			var c storedClaim
+			err = sdkerrors.Wrapf(json.Unmarshal(value, &c), "claim %q", key)
+			if err == nil {
+				total = total.Add(c.Amount)
+				if !c.VestingAmount.IsNil() {
+					total = total.Add(c.VestingAmount)
+				}
+			}
+		}
+		return err != nil
+	})
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	if denom == "" {
+		return sdk.Coin{}, sdkerrors.Wrap(types.ErrInvalid, "no stake contract config")
+	}
+	return sdk.NewCoin(denom, total), nil
+}
+
+// storageMapPrefix returns the key prefix of a cw-storage-plus map: length of the namespace as 2 bytes big endian and the namespace
+func storageMapPrefix(namespace string) []byte {
+	r := make([]byte, 2, 2+len(namespace))
+	binary.BigEndian.PutUint16(r, uint16(len(namespace)))
+	return append(r, namespace...)
+}
+
 // QueryStakedAmount query PoE staking contract for bonded self delegation amount
 func QueryStakedAmount(ctx sdk.Context, k types.SmartQuerier, stakeAddr sdk.AccAddress, opAddr sdk.AccAddress) (TG4StakedAmountsResponse, error) {
 	query := TG4StakeQuery{Staked: &StakedQuery{Address: opAddr.String()}}
@@ -83,3 +83,33 @@ type RenderDecision struct {
 	Summary     string `json:"summary"`
 	IpfsLink    string `json:"ipfs_link"`
 }
+
+// APConfigStorageKey is the raw storage key of the ap-voting contract configuration
+const APConfigStorageKey = "ap_config"
+
+// APConfig ap-voting contract configuration as persisted in the contract store
+type APConfig struct {
+	// DisputeCost that must be sent with a complaint
+	DisputeCost sdk.Coin `json:"dispute_cost"`
+	// WaitingPeriod in seconds for the defendant to accept a complaint
+	WaitingPeriod   uint64 `json:"waiting_period"`
+	NextComplaintID uint64 `json:"next_complaint_id"`
+	MultisigCodeID  uint64 `json:"multisig_code_id"`
+}
+
+// APVotingQuery contains the custom queries for the ap-voting contract.
+// You can also make any generic ProposalsQuery on it.
+type APVotingQuery struct {
+	Complaint      *ComplaintQuery      `json:"complaint,omitempty"`
+	ListComplaints *ListComplaintsQuery `json:"list_complaints,omitempty"`
+}
+
+type ComplaintQuery struct {
+	ComplaintID uint64 `json:"complaint_id"`
+}
+
+type ListComplaintsQuery struct {
+	// StartAfter complaint id. Complaint ids start with 0 so nil is used to start from the beginning.
+	StartAfter *uint64 `json:"start_after,omitempty"`
+	Limit      uint32  `json:"limit,omitempty"`
+}
@@ -0,0 +1,95 @@
+package contract_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/rand"
+
+	"github.com/confio/tgrade/x/poe/contract"
+	"github.com/confio/tgrade/x/poe/types"
+)
+
+func TestAPConfigStorage(t *testing.T) {
+	// setup contracts and seed some data
+	ctx, example, _, _ := setupPoEContracts(t)
+	apAddr, err := example.PoEKeeper.GetPoEContractAddress(ctx, types.PoEContractTypeArbiterPoolVoting)
+	require.NoError(t, err)
+
+	// when
+	bz := example.TWasmKeeper.QueryRaw(ctx, apAddr, []byte(contract.APConfigStorageKey))
+
+	// then
+	var config contract.APConfig
+	require.NoError(t, json.Unmarshal(bz, &config))
+	exp := types.DefaultGenesisState().GetSeedContracts().ArbiterPoolContractConfig.DisputeCost
+	assert.Equal(t, exp, config.DisputeCost)
+}
+
+func TestAPComplaints(t *testing.T) {
+	// setup contracts and seed some data
+	ctx, example, vals, _ := setupPoEContracts(t)
+	apAddr, err := example.PoEKeeper.GetPoEContractAddress(ctx, types.PoEContractTypeArbiterPoolVoting)
+	require.NoError(t, err)
+	var plaintiff sdk.AccAddress = rand.Bytes(address.Len)
+	disputeCost := types.DefaultGenesisState().GetSeedContracts().ArbiterPoolContractConfig.DisputeCost
+	example.Faucet.Fund(ctx, plaintiff, disputeCost.Add(disputeCost))
+
+	for i := 0; i < 2; i++ {
+		msg := contract.APVotingExecute{RegisterComplaint: &contract.RegisterComplaint{
+			Title:       "my title",
+			Description: "my description",
+			Defendant:   vals[0].OperatorAddress,
+		}}
+		msgBz, err := json.Marshal(msg)
+		require.NoError(t, err)
+		_, err = example.TWasmKeeper.GetContractKeeper().Execute(ctx, apAddr, plaintiff, msgBz, sdk.NewCoins(disputeCost))
+		require.NoError(t, err)
+	}
+
+	var startAfterFirst uint64
+	specs := map[string]struct {
+		query  contract.APVotingQuery
+		expLen int
+	}{
+		"list all": {
+			query:  contract.APVotingQuery{ListComplaints: &contract.ListComplaintsQuery{}},
+			expLen: 2,
+		},
+		"list after first": {
+			query:  contract.APVotingQuery{ListComplaints: &contract.ListComplaintsQuery{StartAfter: &startAfterFirst}},
+			expLen: 1,
+		},
+		"list with limit": {
+			query:  contract.APVotingQuery{ListComplaints: &contract.ListComplaintsQuery{Limit: 1}},
+			expLen: 1,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			queryBz, err := json.Marshal(spec.query)
+			require.NoError(t, err)
+
+			// when
+			bz, err := example.TWasmKeeper.QuerySmart(ctx, apAddr, queryBz)
+
+			// then
+			require.NoError(t, err)
+			var rsp struct {
+				Complaints []json.RawMessage `json:"complaints"`
+			}
+			require.NoError(t, json.Unmarshal(bz, &rsp))
+			assert.Len(t, rsp.Complaints, spec.expLen)
+		})
+	}
+
+	// and single complaint
+	queryBz, err := json.Marshal(contract.APVotingQuery{Complaint: &contract.ComplaintQuery{ComplaintID: 1}})
+	require.NoError(t, err)
+	_, err = example.TWasmKeeper.QuerySmart(ctx, apAddr, queryBz)
+	require.NoError(t, err)
+}
@@ -1,6 +1,8 @@
 package contract
 
 import (
+	"encoding/json"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
@@ -34,10 +36,12 @@ type TrustedCircleInitMsg struct { //nolint:maligned
 }
 
 type TrustedCircleExecute struct {
-	DepositEscrow   *struct{}   `json:"deposit_escrow,omitempty"`
-	Propose         *ProposeMsg `json:"propose,omitempty"`
-	Execute         *ExecuteMsg `json:"execute,omitempty"`
-	WithdrawRewards *struct{}   `json:"withdraw_rewards,omitempty"`
+	DepositEscrow      *struct{}   `json:"deposit_escrow,omitempty"`
+	ReturnEscrow       *struct{}   `json:"return_escrow,omitempty"`
+	Propose            *ProposeMsg `json:"propose,omitempty"`
+	Execute            *ExecuteMsg `json:"execute,omitempty"`
+	LeaveTrustedCircle *struct{}   `json:"leave_trusted_circle,omitempty"`
+	WithdrawRewards    *struct{}   `json:"withdraw_rewards,omitempty"`
 }
 
 type ExecuteMsg struct {
@@ -64,6 +68,47 @@ type AddRemoveNonVotingMembers struct {
 	Remove []string `json:"remove"`
 }
 
+// MarshalJSON encodes nil lists as empty lists as the contract does not accept null
+func (m AddRemoveNonVotingMembers) MarshalJSON() ([]byte, error) {
+	type plain AddRemoveNonVotingMembers
+	if m.Add == nil {
+		m.Add = []string{}
+	}
+	if m.Remove == nil {
+		m.Remove = []string{}
+	}
+	return json.Marshal(plain(m))
+}
+
+// TrustedCircleQuery contains the custom queries for the trusted circle contract.
+// You can also make any generic ProposalsQuery on it.
+type TrustedCircleQuery struct {
+	TrustedCircle        *struct{}         `json:"trusted_circle,omitempty"`
+	Escrow               *EscrowQuery      `json:"escrow,omitempty"`
+	ListEscrows          *ListMembersQuery `json:"list_escrows,omitempty"`
+	ListNonVotingMembers *ListMembersQuery `json:"list_non_voting_members,omitempty"`
+}
+
+type EscrowQuery struct {
+	Addr string `json:"addr"`
+}
+
+type EscrowListResponse struct {
+	Escrows []Escrow `json:"escrows"`
+}
+
+type Escrow struct {
+	Addr         string       `json:"addr"`
+	EscrowStatus EscrowStatus `json:"escrow_status"`
+}
+
+type EscrowStatus struct {
+	// Paid escrow amount
+	Paid sdk.Int `json:"paid"`
+	// Status of the member. See https://github.com/confio/tgrade-contracts/blob/main/contracts/tgrade-trusted-circle/src/state.rs
+	Status json.RawMessage `json:"status"`
+}
+
 type TrustedCircleContractAdapter struct {
 	VotingContractAdapter
 }
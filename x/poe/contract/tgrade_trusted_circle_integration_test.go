@@ -7,10 +7,12 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/tendermint/tendermint/libs/rand"
 
 	"github.com/confio/tgrade/x/poe/contract"
+	"github.com/confio/tgrade/x/poe/types"
 )
 
 //go:embed tgrade_trusted_circle.wasm
@@ -49,3 +51,56 @@ func TestInitTrustedCircle(t *testing.T) {
 	require.NoError(t, err)
 	require.NotEmpty(t, contractAddr)
 }
+
+func TestTrustedCircleMembership(t *testing.T) {
+	var ocMember sdk.AccAddress = rand.Bytes(address.Len)
+	// setup contracts and seed some data
+	ctx, example, _, _ := setupPoEContracts(t, types.SetGenesisOCMembersMutator(ocMember))
+	ocAddr, err := example.PoEKeeper.GetPoEContractAddress(ctx, types.PoEContractTypeOversightCommunity)
+	require.NoError(t, err)
+	adapter := contract.NewTrustedCircleContractAdapter(ocAddr, example.TWasmKeeper, nil)
+
+	// when
+	bz, err := json.Marshal(contract.TrustedCircleQuery{ListEscrows: &contract.ListMembersQuery{}})
+	require.NoError(t, err)
+	res, err := example.TWasmKeeper.QuerySmart(ctx, ocAddr, bz)
+
+	// then
+	require.NoError(t, err)
+	var escrows contract.EscrowListResponse
+	require.NoError(t, json.Unmarshal(res, &escrows))
+	require.Len(t, escrows.Escrows, 1)
+	assert.Equal(t, ocMember.String(), escrows.Escrows[0].Addr)
+	expEscrow := types.DefaultGenesisState().GetSeedContracts().OversightCommitteeContractConfig.EscrowAmount
+	assert.Equal(t, expEscrow.Amount, escrows.Escrows[0].EscrowStatus.Paid)
+	assert.JSONEq(t, `{"voting":{}}`, string(escrows.Escrows[0].EscrowStatus.Status))
+
+	// and when non voting member added
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	var nonVotingMember sdk.AccAddress = rand.Bytes(address.Len)
+	bz, err = json.Marshal(contract.TrustedCircleExecute{
+		Propose: &contract.ProposeMsg{
+			Title:       "Add non voting member",
+			Description: "Add non voting member",
+			Proposal: contract.ProposalContent{
+				AddRemoveNonVotingMembers: &contract.AddRemoveNonVotingMembers{Add: []string{nonVotingMember.String()}},
+			},
+		},
+	})
+	require.NoError(t, err)
+	_, err = example.TWasmKeeper.GetContractKeeper().Execute(ctx, ocAddr, ocMember, bz, nil)
+	require.NoError(t, err)
+	latest, err := adapter.LatestProposal(ctx)
+	require.NoError(t, err)
+	require.NoError(t, adapter.ExecuteProposal(ctx, latest.ID, ocMember))
+
+	// then
+	bz, err = json.Marshal(contract.TrustedCircleQuery{ListNonVotingMembers: &contract.ListMembersQuery{}})
+	require.NoError(t, err)
+	res, err = example.TWasmKeeper.QuerySmart(ctx, ocAddr, bz)
+	require.NoError(t, err)
+	var members contract.TG4MemberListResponse
+	require.NoError(t, json.Unmarshal(res, &members))
+	require.Len(t, members.Members, 1)
+	assert.Equal(t, nonVotingMember.String(), members.Members[0].Addr)
+}
@@ -191,6 +191,8 @@ type ValsetEpochResponse struct {
 	LastUpdateTime uint64 `json:"last_update_time"`
 	// The last time we updated the validator set - block height
 	LastUpdateHeight uint64 `json:"last_update_height"`
+	// The next time the validator set will be updated - block time (in seconds)
+	NextUpdateTime uint64 `json:"next_update_time"`
 }
 
 type OperatorResponse struct {
@@ -288,6 +290,25 @@ type ValidatorInfo struct {
 	Power           uint64          `json:"power"`
 }
 
+// ToValidator converts an active validator into the sdk type. Tokens are derived from the validator power.
+func (v ValidatorInfo) ToValidator() (stakingtypes.Validator, error) {
+	pubKey, err := toCosmosPubKey(v.ValidatorPubkey)
+	if err != nil {
+		return stakingtypes.Validator{}, sdkerrors.Wrap(err, "convert to cosmos key")
+	}
+	any, err := codectypes.NewAnyWithValue(pubKey)
+	if err != nil {
+		return stakingtypes.Validator{}, sdkerrors.Wrap(err, "convert to any type")
+	}
+	return stakingtypes.Validator{
+		OperatorAddress: v.Operator,
+		ConsensusPubkey: any,
+		Tokens:          sdk.TokensFromConsensusPower(int64(v.Power), sdk.DefaultPowerReduction),
+		DelegatorShares: sdk.OneDec(),
+		Status:          stakingtypes.Bonded,
+	}, nil
+}
+
 type ValidatorResponse struct {
 	Validator *OperatorResponse `json:"validator"`
 }
@@ -316,9 +337,11 @@ func (l ListActiveValidatorsResponse) PaginationCursor(_ []byte) (PaginationCurs
 }
 
 type ListValidatorSlashingResponse struct {
-	Operator    string              `json:"operator"`
+	Operator    string              `json:"addr"`
 	StartHeight uint64              `json:"start_height"`
 	Slashing    []ValidatorSlashing `json:"slashing"`
+	Tombstoned  bool                `json:"tombstoned"`
+	JailedUntil *JailingPeriod      `json:"jailed_until,omitempty"`
 }
 type ValidatorSlashing struct {
 	Height  uint64  `json:"slash_height"`
@@ -379,6 +402,17 @@ func (v ValsetContractAdapter) QueryRawValidator(ctx sdk.Context, opAddr sdk.Acc
 	return rsp, sdkerrors.Wrap(err, "contract query")
 }
 
+// QueryEpoch query the current epoch information
+func (v ValsetContractAdapter) QueryEpoch(ctx sdk.Context) (*ValsetEpochResponse, error) {
+	query := ValsetQuery{Epoch: &struct{}{}}
+	var rsp ValsetEpochResponse
+	err := v.doQuery(ctx, query, &rsp)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "contract query")
+	}
+	return &rsp, nil
+}
+
 // ListValidators query all validators
 func (v ValsetContractAdapter) ListValidators(ctx sdk.Context, pagination *Paginator) ([]stakingtypes.Validator, PaginationCursor, error) {
 	startAfter, limit := pagination.ToQuery()
@@ -425,13 +459,22 @@ func (v ValsetContractAdapter) IterateActiveValidators(ctx sdk.Context, callback
 }
 
 func (v ValsetContractAdapter) ListValidatorSlashing(ctx sdk.Context, opAddr sdk.AccAddress) ([]ValidatorSlashing, error) {
+	rsp, err := v.QueryValidatorSlashing(ctx, opAddr)
+	if err != nil {
+		return nil, err
+	}
+	return rsp.Slashing, nil
+}
+
+// QueryValidatorSlashing query the slashing history, tombstone and jail status of a validator
+func (v ValsetContractAdapter) QueryValidatorSlashing(ctx sdk.Context, opAddr sdk.AccAddress) (*ListValidatorSlashingResponse, error) {
 	query := ValsetQuery{ListValidatorSlashing: &ValidatorQuery{Operator: opAddr.String()}}
 	var rsp ListValidatorSlashingResponse
 	err := v.doQuery(ctx, query, &rsp)
 	if err != nil {
 		return nil, sdkerrors.Wrap(err, "contract query")
 	}
-	return rsp.Slashing, nil
+	return &rsp, nil
 }
 
 // QueryConfig query contract configuration
@@ -457,7 +500,8 @@ func (v ValsetContractAdapter) UpdateAdmin(ctx sdk.Context, newAdmin sdk.AccAddr
 	return v.doExecute(ctx, msg, sender)
 }
 
-// JailValidator is for testing propose only. On a chain the OC does this
+// JailValidator jails a validator. Only the admin is allowed to jail. On a chain the OC does this and
+// the app on a zero height genesis export
 func (v ValsetContractAdapter) JailValidator(ctx sdk.Context, nodeOperator sdk.AccAddress, duration time.Duration, forever bool, sender sdk.AccAddress) error {
 	if time.Duration(int64(duration.Seconds()))*time.Second != duration {
 		return sdkerrors.Wrap(types.ErrInvalid, "must fit into seconds")
@@ -215,6 +215,43 @@ func TestQueryValsetConfig(t *testing.T) {
 	assert.Equal(t, expConfig, res)
 }
 
+func TestQueryValsetEpoch(t *testing.T) {
+	// setup contracts and seed some data
+	ctx, example, _, _ := setupPoEContracts(t)
+	contractAddr, err := example.PoEKeeper.GetPoEContractAddress(ctx, types.PoEContractTypeValset)
+	require.NoError(t, err)
+
+	// when
+	adapter := contract.NewValsetContractAdapter(contractAddr, example.TWasmKeeper, nil)
+	res, gotErr := adapter.QueryEpoch(ctx)
+
+	// then
+	require.NoError(t, gotErr)
+	assert.Equal(t, uint64(60), res.EpochLength)
+	assert.Equal(t, uint64(ctx.BlockTime().Unix())/res.EpochLength, res.CurrentEpoch)
+	assert.Greater(t, res.NextUpdateTime, res.LastUpdateTime)
+}
+
+func TestQueryValidatorSlashing(t *testing.T) {
+	// setup contracts and seed some data
+	ctx, example, vals, _ := setupPoEContracts(t)
+	contractAddr, err := example.PoEKeeper.GetPoEContractAddress(ctx, types.PoEContractTypeValset)
+	require.NoError(t, err)
+	opAddr, err := sdk.AccAddressFromBech32(vals[0].OperatorAddress)
+	require.NoError(t, err)
+
+	// when
+	adapter := contract.NewValsetContractAdapter(contractAddr, example.TWasmKeeper, nil)
+	res, gotErr := adapter.QueryValidatorSlashing(ctx, opAddr)
+
+	// then
+	require.NoError(t, gotErr)
+	assert.Equal(t, vals[0].OperatorAddress, res.Operator)
+	assert.Empty(t, res.Slashing)
+	assert.False(t, res.Tombstoned)
+	assert.Nil(t, res.JailedUntil)
+}
+
 func TestJailUnjail(t *testing.T) {
 	// setup contracts and seed some data
 	ctx, example, vals, _ := setupPoEContracts(t)
@@ -330,3 +367,21 @@ func TestIterateActiveValidators(t *testing.T) {
 		})
 	}
 }
+
+func TestTrackHistoricalInfoWithActiveValidators(t *testing.T) {
+	ctx, example, genesisValidators, _ := setupPoEContractsNVal(t, 3)
+	k := example.PoEKeeper
+
+	// when
+	k.TrackHistoricalInfo(ctx)
+
+	// then
+	got, exists := k.GetHistoricalInfo(ctx, ctx.BlockHeight())
+	require.True(t, exists)
+	require.Len(t, got.Valset, len(genesisValidators))
+	for _, v := range got.Valset {
+		assert.Equal(t, stakingtypes.Bonded, v.Status)
+		assert.True(t, v.Tokens.IsPositive())
+		assert.NotNil(t, v.ConsensusPubkey)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -43,3 +44,37 @@ func TestToValidator(t *testing.T) {
 		})
 	}
 }
+
+func TestValidatorInfoToValidator(t *testing.T) {
+	pubKey := ed25519.GenPrivKey().PubKey()
+	pk, err := contract.NewValidatorPubkey(pubKey)
+	require.NoError(t, err)
+	specs := map[string]struct {
+		info   contract.ValidatorInfo
+		expErr bool
+	}{
+		"all good": {
+			info: contract.ValidatorInfo{Operator: "myOperator", ValidatorPubkey: pk, Power: 10},
+		},
+		"unsupported pubkey": {
+			info:   contract.ValidatorInfo{Operator: "myOperator", Power: 10},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			gotVal, gotErr := spec.info.ToValidator()
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, "myOperator", gotVal.OperatorAddress)
+			assert.Equal(t, stakingtypes.Bonded, gotVal.Status)
+			assert.Equal(t, int64(10), gotVal.ConsensusPower(sdk.DefaultPowerReduction))
+			gotPubKey, err := gotVal.ConsPubKey()
+			require.NoError(t, err)
+			assert.Equal(t, pubKey, gotPubKey)
+		})
+	}
+}
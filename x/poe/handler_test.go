@@ -109,6 +109,60 @@ func TestHandler(t *testing.T) {
 			},
 			expErr: types.ErrInvalid,
 		},
+		"MsgWithdrawRewards": {
+			src: &types.MsgWithdrawRewards{},
+			mock: MsgServerMock{
+				WithdrawRewardsFn: func(ctx context.Context, msg *types.MsgWithdrawRewards) (*types.MsgWithdrawRewardsResponse, error) {
+					return &types.MsgWithdrawRewardsResponse{}, nil
+				},
+			},
+			expResult: &sdk.Result{Data: []byte{}, Events: []abcitypes.Event{}},
+		},
+		"MsgWithdrawRewards error returned": {
+			src: &types.MsgWithdrawRewards{},
+			mock: MsgServerMock{
+				WithdrawRewardsFn: func(ctx context.Context, msg *types.MsgWithdrawRewards) (*types.MsgWithdrawRewardsResponse, error) {
+					return nil, types.ErrInvalid
+				},
+			},
+			expErr: types.ErrInvalid,
+		},
+		"MsgUnjail": {
+			src: &types.MsgUnjail{},
+			mock: MsgServerMock{
+				UnjailFn: func(ctx context.Context, msg *types.MsgUnjail) (*types.MsgUnjailResponse, error) {
+					return &types.MsgUnjailResponse{}, nil
+				},
+			},
+			expResult: &sdk.Result{Data: []byte{}, Events: []abcitypes.Event{}},
+		},
+		"MsgUnjail error returned": {
+			src: &types.MsgUnjail{},
+			mock: MsgServerMock{
+				UnjailFn: func(ctx context.Context, msg *types.MsgUnjail) (*types.MsgUnjailResponse, error) {
+					return nil, types.ErrInvalid
+				},
+			},
+			expErr: types.ErrInvalid,
+		},
+		"MsgSetWithdrawAddress": {
+			src: &types.MsgSetWithdrawAddress{},
+			mock: MsgServerMock{
+				SetWithdrawAddressFn: func(ctx context.Context, msg *types.MsgSetWithdrawAddress) (*types.MsgSetWithdrawAddressResponse, error) {
+					return &types.MsgSetWithdrawAddressResponse{}, nil
+				},
+			},
+			expResult: &sdk.Result{Data: []byte{}, Events: []abcitypes.Event{}},
+		},
+		"MsgSetWithdrawAddress error returned": {
+			src: &types.MsgSetWithdrawAddress{},
+			mock: MsgServerMock{
+				SetWithdrawAddressFn: func(ctx context.Context, msg *types.MsgSetWithdrawAddress) (*types.MsgSetWithdrawAddressResponse, error) {
+					return nil, types.ErrInvalid
+				},
+			},
+			expErr: types.ErrInvalid,
+		},
 		"unknown message": {
 			src:    &banktypes.MsgSend{},
 			expErr: sdkerrors.ErrUnknownRequest,
@@ -141,10 +195,13 @@ func mustMarshalProto(m *types.MsgUndelegateResponse) []byte {
 var _ types.MsgServer = MsgServerMock{}
 
 type MsgServerMock struct {
-	CreateValidatorFn func(ctx context.Context, msg *types.MsgCreateValidator) (*types.MsgCreateValidatorResponse, error)
-	UpdateValidatorFn func(ctx context.Context, msg *types.MsgUpdateValidator) (*types.MsgUpdateValidatorResponse, error)
-	DelegateFn        func(ctx context.Context, msg *types.MsgDelegate) (*types.MsgDelegateResponse, error)
-	UndelegateFn      func(ctx context.Context, msg *types.MsgUndelegate) (*types.MsgUndelegateResponse, error)
+	CreateValidatorFn    func(ctx context.Context, msg *types.MsgCreateValidator) (*types.MsgCreateValidatorResponse, error)
+	UpdateValidatorFn    func(ctx context.Context, msg *types.MsgUpdateValidator) (*types.MsgUpdateValidatorResponse, error)
+	DelegateFn           func(ctx context.Context, msg *types.MsgDelegate) (*types.MsgDelegateResponse, error)
+	UndelegateFn         func(ctx context.Context, msg *types.MsgUndelegate) (*types.MsgUndelegateResponse, error)
+	WithdrawRewardsFn    func(ctx context.Context, msg *types.MsgWithdrawRewards) (*types.MsgWithdrawRewardsResponse, error)
+	UnjailFn             func(ctx context.Context, msg *types.MsgUnjail) (*types.MsgUnjailResponse, error)
+	SetWithdrawAddressFn func(ctx context.Context, msg *types.MsgSetWithdrawAddress) (*types.MsgSetWithdrawAddressResponse, error)
 }
 
 func (m MsgServerMock) CreateValidator(ctx context.Context, msg *types.MsgCreateValidator) (*types.MsgCreateValidatorResponse, error) {
@@ -174,3 +231,24 @@ func (m MsgServerMock) Undelegate(ctx context.Context, msg *types.MsgUndelegate)
 	}
 	return m.UndelegateFn(ctx, msg)
 }
+
+func (m MsgServerMock) WithdrawRewards(ctx context.Context, msg *types.MsgWithdrawRewards) (*types.MsgWithdrawRewardsResponse, error) {
+	if m.WithdrawRewardsFn == nil {
+		panic("not expected to be called")
+	}
+	return m.WithdrawRewardsFn(ctx, msg)
+}
+
+func (m MsgServerMock) Unjail(ctx context.Context, msg *types.MsgUnjail) (*types.MsgUnjailResponse, error) {
+	if m.UnjailFn == nil {
+		panic("not expected to be called")
+	}
+	return m.UnjailFn(ctx, msg)
+}
+
+func (m MsgServerMock) SetWithdrawAddress(ctx context.Context, msg *types.MsgSetWithdrawAddress) (*types.MsgSetWithdrawAddressResponse, error) {
+	if m.SetWithdrawAddressFn == nil {
+		panic("not expected to be called")
+	}
+	return m.SetWithdrawAddressFn(ctx, msg)
+}
@@ -0,0 +1,72 @@
+package poe
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/confio/tgrade/x/poe/contract"
+	"github.com/confio/tgrade/x/poe/keeper"
+	"github.com/confio/tgrade/x/poe/types"
+)
+
+type balanceKeeper interface {
+	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
+}
+
+// RegisterInvariants registers the poe module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, tk twasmKeeper, poeKeeper keeper.ContractSource, bk balanceKeeper) {
+	ir.RegisterRoute(types.ModuleName, "stake-balance", StakeBalanceInvariant(tk, poeKeeper, bk))
+	ir.RegisterRoute(types.ModuleName, "contract-addresses", ContractAddressesInvariant(tk, poeKeeper))
+}
+
+// StakeBalanceInvariant checks that the bonded tokens are held by the tg4-stake contract. Delegations pass through
+// the bonded pool module account to the contract so that the pool must be empty while the contract balance must cover
+// the total stake plus pending claims. Additional tokens on the contract are not considered, as anybody can send them.
+func StakeBalanceInvariant(tk twasmKeeper, poeKeeper keeper.ContractSource, bk balanceKeeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		stakeAddr, err := poeKeeper.GetPoEContractAddress(ctx, types.PoEContractTypeStaking)
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "stake-balance", fmt.Sprintf("stake contract address: %s", err)), true
+		}
+		bonded, err := contract.SumStakeContractBonded(ctx, tk, stakeAddr)
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "stake-balance", fmt.Sprintf("stake contract state: %s", err)), true
+		}
+		poolBalance := bk.GetBalance(ctx, authtypes.NewModuleAddress(types.BondedPoolName), bonded.Denom)
+		contractBalance := bk.GetBalance(ctx, stakeAddr, bonded.Denom)
+		broken := !poolBalance.IsZero() || !contractBalance.IsGTE(bonded)
+		return sdk.FormatInvariant(types.ModuleName, "stake-balance",
+			fmt.Sprintf("\tbonded pool balance: %s\n\tstake contract balance: %s\n\tstake and claims: %s\n", poolBalance, contractBalance, bonded)), broken
+	}
+}
+
+// ContractAddressesInvariant checks that the address of every PoE contract type is set and points to an existing
+// contract with pinned code
+func ContractAddressesInvariant(tk twasmKeeper, poeKeeper keeper.ContractSource) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		broken := 0
+		types.IteratePoEContractTypes(func(tp types.PoEContractType) bool {
+			addr, err := poeKeeper.GetPoEContractAddress(ctx, tp)
+			if err != nil {
+				broken++
+				msg += fmt.Sprintf("\t%s address: %s\n", tp, err)
+				return false
+			}
+			c := tk.GetContractInfo(ctx, addr)
+			switch {
+			case c == nil:
+				broken++
+				msg += fmt.Sprintf("\t%s unknown contract: %s\n", tp, addr)
+			case !tk.IsPinnedCode(ctx, c.CodeID):
+				broken++
+				msg += fmt.Sprintf("\t%s code %d not pinned\n", tp, c.CodeID)
+			}
+			return false
+		})
+		return sdk.FormatInvariant(types.ModuleName, "contract-addresses",
+			fmt.Sprintf("found %d invalid PoE contracts\n%s", broken, msg)), broken != 0
+	}
+}
@@ -0,0 +1,112 @@
+package poe_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/confio/tgrade/x/poe"
+	"github.com/confio/tgrade/x/poe/contract"
+	"github.com/confio/tgrade/x/poe/keeper"
+	"github.com/confio/tgrade/x/poe/types"
+)
+
+func TestStakeBalanceInvariant(t *testing.T) {
+	specs := map[string]struct {
+		setup     func(t *testing.T, ctx sdk.Context, example *keeper.TestKeepers, stakeAddr sdk.AccAddress)
+		expBroken bool
+	}{
+		"genesis stake": {
+			setup: func(t *testing.T, ctx sdk.Context, example *keeper.TestKeepers, stakeAddr sdk.AccAddress) {},
+		},
+		"with pending claims": {
+			setup: func(t *testing.T, ctx sdk.Context, example *keeper.TestKeepers, stakeAddr sdk.AccAddress) {
+				vals, _, err := example.PoEKeeper.ValsetContract(ctx).ListValidators(ctx, nil)
+				require.NoError(t, err)
+				opAddr, err := sdk.AccAddressFromBech32(vals[0].OperatorAddress)
+				require.NoError(t, err)
+				_, err = contract.UnbondDelegation(ctx, stakeAddr, opAddr, sdk.NewCoin(types.DefaultBondDenom, sdk.OneInt()), example.TWasmKeeper.GetContractKeeper())
+				require.NoError(t, err)
+			},
+		},
+		"stake contract with additional tokens": {
+			setup: func(t *testing.T, ctx sdk.Context, example *keeper.TestKeepers, stakeAddr sdk.AccAddress) {
+				example.Faucet.Fund(ctx, stakeAddr, sdk.NewCoin(types.DefaultBondDenom, sdk.OneInt()))
+			},
+		},
+		"stake contract balance below stake and claims": {
+			setup: func(t *testing.T, ctx sdk.Context, example *keeper.TestKeepers, stakeAddr sdk.AccAddress) {
+				vals, _, err := example.PoEKeeper.ValsetContract(ctx).ListValidators(ctx, nil)
+				require.NoError(t, err)
+				opAddr, err := sdk.AccAddressFromBech32(vals[0].OperatorAddress)
+				require.NoError(t, err)
+				_, err = contract.UnbondDelegation(ctx, stakeAddr, opAddr, sdk.NewCoin(types.DefaultBondDenom, sdk.OneInt()), example.TWasmKeeper.GetContractKeeper())
+				require.NoError(t, err)
+				lost := sdk.NewCoins(sdk.NewCoin(types.DefaultBondDenom, sdk.OneInt()))
+				require.NoError(t, example.BankKeeper.SendCoins(ctx, stakeAddr, keeper.RandomAddress(t), lost))
+			},
+			expBroken: true,
+		},
+		"bonded pool with tokens": {
+			setup: func(t *testing.T, ctx sdk.Context, example *keeper.TestKeepers, stakeAddr sdk.AccAddress) {
+				example.Faucet.Fund(ctx, authtypes.NewModuleAddress(types.BondedPoolName), sdk.NewCoin(types.DefaultBondDenom, sdk.OneInt()))
+			},
+			expBroken: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, example, _ := setupPoEContracts(t)
+			stakeAddr, err := example.PoEKeeper.GetPoEContractAddress(ctx, types.PoEContractTypeStaking)
+			require.NoError(t, err)
+			spec.setup(t, ctx, example, stakeAddr)
+
+			// when
+			msg, broken := poe.StakeBalanceInvariant(example.TWasmKeeper, example.PoEKeeper, example.BankKeeper)(ctx)
+
+			// then
+			assert.Equal(t, spec.expBroken, broken, msg)
+		})
+	}
+}
+
+func TestContractAddressesInvariant(t *testing.T) {
+	specs := map[string]struct {
+		setup     func(t *testing.T, ctx sdk.Context, example *keeper.TestKeepers)
+		expBroken bool
+	}{
+		"all contracts set": {
+			setup: func(t *testing.T, ctx sdk.Context, example *keeper.TestKeepers) {},
+		},
+		"unknown contract": {
+			setup: func(t *testing.T, ctx sdk.Context, example *keeper.TestKeepers) {
+				example.PoEKeeper.SetPoEContractAddress(ctx, types.PoEContractTypeMixer, keeper.RandomAddress(t))
+			},
+			expBroken: true,
+		},
+		"code not pinned": {
+			setup: func(t *testing.T, ctx sdk.Context, example *keeper.TestKeepers) {
+				addr, err := example.PoEKeeper.GetPoEContractAddress(ctx, types.PoEContractTypeMixer)
+				require.NoError(t, err)
+				codeID := example.TWasmKeeper.GetContractInfo(ctx, addr).CodeID
+				require.NoError(t, example.TWasmKeeper.GetContractKeeper().UnpinCode(ctx, codeID))
+			},
+			expBroken: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, example, _ := setupPoEContracts(t)
+			spec.setup(t, ctx, example)
+
+			// when
+			msg, broken := poe.ContractAddressesInvariant(example.TWasmKeeper, example.PoEKeeper)(ctx)
+
+			// then
+			assert.Equal(t, spec.expBroken, broken, msg)
+		})
+	}
+}
@@ -24,9 +24,15 @@ func (k *Keeper) DistributionContract(ctx sdk.Context) DistributionContract {
 type ValsetContract interface {
 	ListValidators(ctx sdk.Context, pagination *contract.Paginator) ([]stakingtypes.Validator, contract.PaginationCursor, error)
 	QueryValidator(ctx sdk.Context, opAddr sdk.AccAddress) (*stakingtypes.Validator, error)
+	// QueryRawValidator returns the validator as stored in the contract. The validator is nil when not found
+	QueryRawValidator(ctx sdk.Context, opAddr sdk.AccAddress) (contract.ValidatorResponse, error)
+	QueryEpoch(ctx sdk.Context) (*contract.ValsetEpochResponse, error)
 	ListValidatorSlashing(ctx sdk.Context, opAddr sdk.AccAddress) ([]contract.ValidatorSlashing, error)
+	QueryValidatorSlashing(ctx sdk.Context, opAddr sdk.AccAddress) (*contract.ListValidatorSlashingResponse, error)
 	QueryConfig(ctx sdk.Context) (*contract.ValsetConfigResponse, error)
 	UpdateAdmin(ctx sdk.Context, new sdk.AccAddress, sender sdk.AccAddress) error
+	UnjailValidator(ctx sdk.Context, sender sdk.AccAddress) error
+	JailValidator(ctx sdk.Context, nodeOperator sdk.AccAddress, duration time.Duration, forever bool, sender sdk.AccAddress) error
 	IterateActiveValidators(ctx sdk.Context, callback func(c contract.ValidatorInfo) bool, pagination *contract.Paginator) error
 	Address() (sdk.AccAddress, error)
 }
@@ -52,9 +58,13 @@ func (k *Keeper) StakeContract(ctx sdk.Context) StakeContract {
 
 type EngagementContract interface {
 	UpdateAdmin(ctx sdk.Context, newAdmin, sender sdk.AccAddress) error
+	// DelegateWithdrawal sets the address that is allowed to withdraw the sender's rewards
+	DelegateWithdrawal(ctx sdk.Context, delegated, sender sdk.AccAddress) error
 	// QueryDelegated returns withdrawal address when set
 	QueryDelegated(ctx sdk.Context, ownerAddr sdk.AccAddress) (*contract.DelegatedResponse, error)
 	QueryWithdrawableRewards(ctx sdk.Context, addr sdk.AccAddress) (sdk.Coin, error)
+	// ListMembersByPoints returns the members ordered by points descending
+	ListMembersByPoints(ctx sdk.Context, pagination *contract.Paginator) ([]contract.TG4Member, contract.PaginationCursor, error)
 	Address() (sdk.AccAddress, error)
 }
 
@@ -62,3 +72,14 @@ func (k *Keeper) EngagementContract(ctx sdk.Context) EngagementContract {
 	engContractAddr, err := k.GetPoEContractAddress(ctx, types.PoEContractTypeEngagement)
 	return contract.NewEngagementContractAdapter(engContractAddr, k.twasmKeeper, err)
 }
+
+type MixerContract interface {
+	// QueryMixerFunction returns the points the mixer function calculates for the given stake and engagement points
+	QueryMixerFunction(ctx sdk.Context, stake, engagement uint64) (uint64, error)
+	Address() (sdk.AccAddress, error)
+}
+
+func (k *Keeper) MixerContract(ctx sdk.Context) MixerContract {
+	mixerContractAddr, err := k.GetPoEContractAddress(ctx, types.PoEContractTypeMixer)
+	return contract.NewMixerContractAdapter(mixerContractAddr, k.twasmKeeper, err)
+}
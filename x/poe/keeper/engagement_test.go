@@ -18,7 +18,7 @@ func TestSetValidatorInitialEngagementPoints(t *testing.T) {
 	myOpAddr := RandomAddress(t)
 	ctx, _, k := createMinTestInput(t)
 	const initialPointsToGrant = 2
-	k.setParams(ctx, types.NewParams(0, initialPointsToGrant, sdk.NewCoins(sdk.NewCoin("ALX", sdk.NewInt(10)))))
+	k.setParams(ctx, types.NewParams(0, initialPointsToGrant, sdk.NewCoins(sdk.NewCoin("ALX", sdk.NewInt(10))), types.DefaultFeeSplit()))
 	engagementContractAddr := RandomAddress(t)
 	k.SetPoEContractAddress(ctx, types.PoEContractTypeEngagement, engagementContractAddr)
 
@@ -1,12 +1,16 @@
 package keeper
 
 import (
+	"crypto/sha256"
+	"fmt"
 	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	ibccoretypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
 
+	"github.com/confio/tgrade/x/poe/contract"
 	"github.com/confio/tgrade/x/poe/types"
 )
 
@@ -22,15 +26,34 @@ func (k *Keeper) GetHistoricalInfo(ctx sdk.Context, height int64) (stakingtypes.
 		return stakingtypes.HistoricalInfo{}, false
 	}
 
-	return stakingtypes.MustUnmarshalHistoricalInfo(k.codec, value), true
+	hi := stakingtypes.MustUnmarshalHistoricalInfo(k.codec, value)
+	hi.Valset = k.getHistoricalValset(ctx, height)
+	return hi, true
 }
 
-// SetHistoricalInfo sets the historical info at a given height
+// SetHistoricalInfo sets the historical info at a given height.
+// The validator set is stored separately and shared with other heights that have the same set.
 func (k *Keeper) SetHistoricalInfo(ctx sdk.Context, height int64, hi *stakingtypes.HistoricalInfo) {
 	store := ctx.KVStore(k.storeKey)
 	key := getHistoricalInfoKey(height)
-	value := k.codec.MustMarshal(hi)
+	k.releaseHistoricalValset(ctx, height)
+
+	value := k.codec.MustMarshal(&stakingtypes.HistoricalInfo{Header: hi.Header})
 	store.Set(key, value)
+	if len(hi.Valset) == 0 {
+		return
+	}
+	valsetBz := k.codec.MustMarshal(&stakingtypes.HistoricalInfo{Valset: hi.Valset})
+	hash := sha256.Sum256(valsetBz)
+	counterKey := getHistoricalValsetRefCounterKey(hash[:])
+	var counter uint64
+	if bz := store.Get(counterKey); bz != nil {
+		counter = sdk.BigEndianToUint64(bz)
+	} else {
+		store.Set(getHistoricalValsetKey(hash[:]), valsetBz)
+	}
+	store.Set(counterKey, sdk.Uint64ToBigEndian(counter+1))
+	store.Set(getHistoricalInfoValsetRefKey(height), hash[:])
 }
 
 // DeleteHistoricalInfo deletes the historical info at a given height
@@ -39,6 +62,45 @@ func (k *Keeper) DeleteHistoricalInfo(ctx sdk.Context, height int64) {
 	key := getHistoricalInfoKey(height)
 
 	store.Delete(key)
+	k.releaseHistoricalValset(ctx, height)
+}
+
+// getHistoricalValset returns the validator set referenced by the historical info at the given height or nil
+func (k *Keeper) getHistoricalValset(ctx sdk.Context, height int64) []stakingtypes.Validator {
+	store := ctx.KVStore(k.storeKey)
+	hash := store.Get(getHistoricalInfoValsetRefKey(height))
+	if hash == nil {
+		return nil
+	}
+	bz := store.Get(getHistoricalValsetKey(hash))
+	if bz == nil {
+		panic(fmt.Sprintf("historical validator set not found for height: %d", height))
+	}
+	return stakingtypes.MustUnmarshalHistoricalInfo(k.codec, bz).Valset
+}
+
+// releaseHistoricalValset removes the validator set reference for the given height. The validator set
+// is deleted when it is not referenced by any other height.
+func (k *Keeper) releaseHistoricalValset(ctx sdk.Context, height int64) {
+	store := ctx.KVStore(k.storeKey)
+	refKey := getHistoricalInfoValsetRefKey(height)
+	hash := store.Get(refKey)
+	if hash == nil {
+		return
+	}
+	store.Delete(refKey)
+
+	counterKey := getHistoricalValsetRefCounterKey(hash)
+	var counter uint64
+	if bz := store.Get(counterKey); bz != nil {
+		counter = sdk.BigEndianToUint64(bz)
+	}
+	if counter > 1 {
+		store.Set(counterKey, sdk.Uint64ToBigEndian(counter-1))
+		return
+	}
+	store.Delete(counterKey)
+	store.Delete(getHistoricalValsetKey(hash))
 }
 
 // iterateHistoricalInfo provides an interator over all stored HistoricalInfo
@@ -53,7 +115,12 @@ func (k *Keeper) iterateHistoricalInfo(ctx sdk.Context, cb func(stakingtypes.His
 	defer iter.Close()
 
 	for ; iter.Valid(); iter.Next() {
+		height, err := strconv.ParseInt(string(iter.Key()[len(types.HistoricalInfoKey):]), 10, 64)
+		if err != nil {
+			panic(fmt.Sprintf("invalid historical info key: %s", err))
+		}
 		histInfo := stakingtypes.MustUnmarshalHistoricalInfo(k.codec, iter.Value())
+		histInfo.Valset = k.getHistoricalValset(ctx, height)
 		if cb(histInfo) {
 			break
 		}
@@ -99,14 +166,55 @@ func (k *Keeper) TrackHistoricalInfo(ctx sdk.Context) {
 	}
 
 	// Create HistoricalInfo struct
-	var valSet stakingtypes.Validators // not used by IBC so we keep it empty
+	valSet, err := k.activeValidatorSet(ctx)
+	if err != nil {
+		// store an empty set rather than a truncated one
+		ModuleLogger(ctx).Error("failed to load active validator set for historical info", "cause", err)
+		valSet = nil
+	}
 	historicalEntry := stakingtypes.NewHistoricalInfo(ctx.BlockHeader(), valSet, sdk.DefaultPowerReduction)
 
 	// Set latest HistoricalInfo at current height
 	k.SetHistoricalInfo(ctx, ctx.BlockHeight(), &historicalEntry)
 }
 
+// activeValidatorSet returns the active validators from the valset contract
+func (k *Keeper) activeValidatorSet(ctx sdk.Context) (stakingtypes.Validators, error) {
+	var (
+		valSet  stakingtypes.Validators
+		convErr error
+	)
+	err := k.ValsetContract(ctx).IterateActiveValidators(ctx, func(c contract.ValidatorInfo) bool {
+		val, err := c.ToValidator()
+		if err != nil {
+			convErr = sdkerrors.Wrapf(err, "operator %s", c.Operator)
+			return true
+		}
+		valSet = append(valSet, val)
+		return false
+	}, nil)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "iterate active validators")
+	}
+	return valSet, convErr
+}
+
 // getHistoricalInfoKey returns a key prefix for indexing HistoricalInfo objects.
 func getHistoricalInfoKey(height int64) []byte {
 	return append(types.HistoricalInfoKey, []byte(strconv.FormatInt(height, 10))...)
 }
+
+// getHistoricalInfoValsetRefKey returns a key for the validator set hash referenced by the HistoricalInfo at the given height.
+func getHistoricalInfoValsetRefKey(height int64) []byte {
+	return append(types.HistoricalInfoValsetRefKey, []byte(strconv.FormatInt(height, 10))...)
+}
+
+// getHistoricalValsetKey returns a key for a validator set stored by its hash.
+func getHistoricalValsetKey(hash []byte) []byte {
+	return append(types.HistoricalValsetKey, hash...)
+}
+
+// getHistoricalValsetRefCounterKey returns a key for the number of heights referencing the validator set with the given hash.
+func getHistoricalValsetRefCounterKey(hash []byte) []byte {
+	return append(types.HistoricalValsetRefCounterKey, hash...)
+}
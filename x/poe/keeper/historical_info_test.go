@@ -1,9 +1,11 @@
 package keeper
 
 import (
+	"sort"
 	"testing"
 	"time"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	fuzz "github.com/google/gofuzz"
@@ -37,7 +39,7 @@ func TestTrackHistoricalInfo(t *testing.T) {
 	ctx, example := CreateDefaultTestInput(t)
 	keeper := example.PoEKeeper
 	const maxEntries = 2
-	keeper.setParams(ctx, types.Params{HistoricalEntries: maxEntries})
+	keeper.setParams(ctx, types.Params{HistoricalEntries: maxEntries, FeeSplit: types.DefaultFeeSplit()})
 
 	// fill all slots
 	expEntries := make([]stakingtypes.HistoricalInfo, 0, maxEntries+1)
@@ -65,3 +67,77 @@ func TestTrackHistoricalInfo(t *testing.T) {
 	expEntries = append(expEntries, stakingtypes.NewHistoricalInfo(header, nil, sdk.DefaultPowerReduction))
 	assert.Equal(t, expEntries[1:], keeper.getAllHistoricalInfo(ctx))
 }
+
+func TestHistoricalInfoValsetStorage(t *testing.T) {
+	ctx, example := CreateDefaultTestInput(t)
+	keeper := example.PoEKeeper
+
+	valSetA := stakingtypes.Validators{randomValidator(t), randomValidator(t)}
+	valSetB := stakingtypes.Validators{randomValidator(t)}
+	for height, valSet := range map[int64]stakingtypes.Validators{1: valSetA, 2: valSetA, 3: valSetB, 4: nil} {
+		header := tmproto.Header{Height: height}
+		hi := stakingtypes.NewHistoricalInfo(header, valSet, sdk.DefaultPowerReduction)
+		keeper.SetHistoricalInfo(ctx, height, &hi)
+	}
+	// then same sets are stored once
+	assert.Equal(t, 2, countStoreEntries(t, ctx, keeper, types.HistoricalValsetKey))
+	for height, exp := range map[int64]stakingtypes.Validators{1: valSetA, 2: valSetA, 3: valSetB, 4: nil} {
+		got, exists := keeper.GetHistoricalInfo(ctx, height)
+		require.True(t, exists)
+		assert.Equal(t, height, got.Header.Height)
+		assert.Equal(t, operatorAddresses(exp), operatorAddresses(got.Valset))
+	}
+
+	// when first reference deleted
+	keeper.DeleteHistoricalInfo(ctx, 1)
+	// then the shared set is kept
+	assert.Equal(t, 2, countStoreEntries(t, ctx, keeper, types.HistoricalValsetKey))
+	got, exists := keeper.GetHistoricalInfo(ctx, 2)
+	require.True(t, exists)
+	assert.Equal(t, operatorAddresses(valSetA), operatorAddresses(got.Valset))
+
+	// when last reference deleted
+	keeper.DeleteHistoricalInfo(ctx, 2)
+	// then the set is pruned
+	assert.Equal(t, 1, countStoreEntries(t, ctx, keeper, types.HistoricalValsetKey))
+	assert.Equal(t, 1, countStoreEntries(t, ctx, keeper, types.HistoricalValsetRefCounterKey))
+
+	// when overwritten
+	hi := stakingtypes.NewHistoricalInfo(tmproto.Header{Height: 3}, valSetA, sdk.DefaultPowerReduction)
+	keeper.SetHistoricalInfo(ctx, 3, &hi)
+	// then the old set is released
+	assert.Equal(t, 1, countStoreEntries(t, ctx, keeper, types.HistoricalValsetKey))
+	got, exists = keeper.GetHistoricalInfo(ctx, 3)
+	require.True(t, exists)
+	assert.Equal(t, operatorAddresses(valSetA), operatorAddresses(got.Valset))
+	assert.Len(t, keeper.getAllHistoricalInfo(ctx), 2)
+}
+
+func randomValidator(t *testing.T) stakingtypes.Validator {
+	t.Helper()
+	val, err := stakingtypes.NewValidator(sdk.ValAddress(RandomAddress(t)), ed25519.GenPrivKey().PubKey(), stakingtypes.Description{})
+	require.NoError(t, err)
+	val.Status = stakingtypes.Bonded
+	val.Tokens = sdk.TokensFromConsensusPower(1, sdk.DefaultPowerReduction)
+	return val
+}
+
+func operatorAddresses(vals []stakingtypes.Validator) []string {
+	var r []string
+	for _, v := range vals {
+		r = append(r, v.OperatorAddress)
+	}
+	sort.Strings(r)
+	return r
+}
+
+func countStoreEntries(t *testing.T, ctx sdk.Context, k *Keeper, prefix []byte) int {
+	t.Helper()
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), prefix)
+	defer iter.Close()
+	var count int
+	for ; iter.Valid(); iter.Next() {
+		count++
+	}
+	return count
+}
@@ -119,6 +119,17 @@ func (k *Keeper) UnbondingTime(ctx sdk.Context) time.Duration {
 	return rsp
 }
 
+// IsValidatorOperator returns true when the address is a validator operator in the valset contract.
+// Query errors are logged and return false.
+func (k *Keeper) IsValidatorOperator(ctx sdk.Context, opAddr sdk.AccAddress) bool {
+	val, err := k.ValsetContract(ctx).QueryValidator(ctx, opAddr)
+	if err != nil {
+		ModuleLogger(ctx).Error("query validator", "operator", opAddr.String(), "error", err)
+		return false
+	}
+	return val != nil
+}
+
 func (k *Keeper) GetBondDenom(ctx sdk.Context) string {
 	return types.DefaultBondDenom
 }
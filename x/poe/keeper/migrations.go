@@ -0,0 +1,23 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/confio/tgrade/x/poe/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper *Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper *Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 sets the default fee split that sends all fees to the valset contract
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	m.keeper.paramStore.Set(ctx, types.KeyFeeSplit, types.DefaultFeeSplit())
+	return nil
+}
@@ -0,0 +1,23 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/confio/tgrade/x/poe/types"
+)
+
+func TestMigrate1to2(t *testing.T) {
+	ctx, example := CreateDefaultTestInput(t)
+	k := example.PoEKeeper
+	k.paramStore.Set(ctx, types.KeyFeeSplit, types.FeeSplit{Valset: sdk.ZeroDec(), CommunityPool: sdk.ZeroDec(), Burn: sdk.OneDec()})
+
+	// when
+	require.NoError(t, NewMigrator(k).Migrate1to2(ctx))
+
+	// then
+	assert.Equal(t, types.DefaultFeeSplit(), k.GetFeeSplit(ctx))
+}
@@ -23,6 +23,8 @@ type PoEKeeper interface {
 	SetValidatorInitialEngagementPoints(ctx sdk.Context, address sdk.AccAddress, value sdk.Coin) error
 	GetBondDenom(ctx sdk.Context) string
 	ValsetContract(ctx sdk.Context) ValsetContract
+	DistributionContract(ctx sdk.Context) DistributionContract
+	EngagementContract(ctx sdk.Context) EngagementContract
 }
 
 type msgServer struct {
@@ -211,3 +213,118 @@ func (m msgServer) Undelegate(c context.Context, msg *types.MsgUndelegate) (*typ
 	})
 	return &types.MsgUndelegateResponse{CompletionTime: *completionTime}, nil
 }
+
+func (m msgServer) WithdrawRewards(c context.Context, msg *types.MsgWithdrawRewards) (*types.MsgWithdrawRewardsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	ownerAddress, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "owner address")
+	}
+
+	var total sdk.Coins
+	if msg.Distribution {
+		amount, err := m.keeper.DistributionContract(ctx).ValidatorOutstandingReward(ctx, ownerAddress)
+		if err != nil {
+			return nil, sdkerrors.Wrap(err, "query distribution rewards")
+		}
+		if err := m.withdrawRewards(ctx, types.PoEContractTypeDistribution, ownerAddress, amount); err != nil {
+			return nil, sdkerrors.Wrap(err, "withdraw distribution rewards")
+		}
+		total = total.Add(amount)
+	}
+	if msg.Engagement {
+		amount, err := m.keeper.EngagementContract(ctx).QueryWithdrawableRewards(ctx, ownerAddress)
+		if err != nil {
+			return nil, sdkerrors.Wrap(err, "query engagement rewards")
+		}
+		if err := m.withdrawRewards(ctx, types.PoEContractTypeEngagement, ownerAddress, amount); err != nil {
+			return nil, sdkerrors.Wrap(err, "withdraw engagement rewards")
+		}
+		total = total.Add(amount)
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Owner),
+	))
+	return &types.MsgWithdrawRewardsResponse{Amount: total}, nil
+}
+
+// withdrawRewards executes the withdrawal with the rewards contract of the given type and emits an event for the amount
+func (m msgServer) withdrawRewards(ctx sdk.Context, ctype types.PoEContractType, ownerAddress sdk.AccAddress, amount sdk.Coin) error {
+	contractAddr, err := m.keeper.GetPoEContractAddress(ctx, ctype)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "%s contract", ctype)
+	}
+	if err := contract.WithdrawRewards(ctx, contractAddr, ownerAddress, m.contractKeeper); err != nil {
+		return err
+	}
+
+	source := types.AttributeValueEngagement
+	if ctype == types.PoEContractTypeDistribution {
+		source = types.AttributeValueDistribution
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeWithdrawRewards,
+		sdk.NewAttribute(types.AttributeKeyOwner, ownerAddress.String()),
+		sdk.NewAttribute(types.AttributeKeyRewardsSource, source),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+	))
+	return nil
+}
+
+func (m msgServer) Unjail(c context.Context, msg *types.MsgUnjail) (*types.MsgUnjailResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	operatorAddress, err := sdk.AccAddressFromBech32(msg.OperatorAddress)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "operator address")
+	}
+
+	if err := m.keeper.ValsetContract(ctx).UnjailValidator(ctx, operatorAddress); err != nil {
+		return nil, sdkerrors.Wrap(err, "unjail validator")
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.OperatorAddress),
+		),
+		sdk.NewEvent(
+			types.EventTypeUnjail,
+			sdk.NewAttribute(types.AttributeKeyValOperator, msg.OperatorAddress),
+		),
+	})
+	return &types.MsgUnjailResponse{}, nil
+}
+
+func (m msgServer) SetWithdrawAddress(c context.Context, msg *types.MsgSetWithdrawAddress) (*types.MsgSetWithdrawAddressResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	ownerAddress, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "owner address")
+	}
+	withdrawAddress, err := sdk.AccAddressFromBech32(msg.WithdrawAddress)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "withdraw address")
+	}
+
+	if err := m.keeper.EngagementContract(ctx).DelegateWithdrawal(ctx, withdrawAddress, ownerAddress); err != nil {
+		return nil, sdkerrors.Wrap(err, "delegate withdrawal")
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Owner),
+		),
+		sdk.NewEvent(
+			types.EventTypeSetWithdrawAddress,
+			sdk.NewAttribute(types.AttributeKeyOwner, msg.Owner),
+			sdk.NewAttribute(types.AttributeKeyWithdrawAddr, msg.WithdrawAddress),
+		),
+	})
+	return &types.MsgSetWithdrawAddressResponse{}, nil
+}
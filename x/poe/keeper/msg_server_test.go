@@ -511,3 +511,230 @@ func TestUndelegate(t *testing.T) {
 		})
 	}
 }
+
+func TestWithdrawRewards(t *testing.T) {
+	var (
+		myDistributionContract sdk.AccAddress = rand.Bytes(address.Len)
+		myEngagementContract   sdk.AccAddress = rand.Bytes(address.Len)
+		myOwnerAddr            sdk.AccAddress = rand.Bytes(address.Len)
+	)
+	poeKeeperMock := PoEKeeperMock{
+		GetPoEContractAddressFn: func(ctx sdk.Context, ctype types.PoEContractType) (sdk.AccAddress, error) {
+			switch ctype {
+			case types.PoEContractTypeDistribution:
+				return myDistributionContract, nil
+			case types.PoEContractTypeEngagement:
+				return myEngagementContract, nil
+			default:
+				t.Fatalf("unexpected type: %s", ctype)
+				return nil, nil
+			}
+		},
+		DistributionContractFn: func(ctx sdk.Context) DistributionContract {
+			return poetesting.DistributionContractMock{
+				ValidatorOutstandingRewardFn: func(ctx sdk.Context, addr sdk.AccAddress) (sdk.Coin, error) {
+					return sdk.NewCoin(types.DefaultBondDenom, sdk.NewInt(2)), nil
+				},
+			}
+		},
+		EngagementContractFn: func(ctx sdk.Context) EngagementContract {
+			return poetesting.EngagementContractMock{
+				QueryWithdrawableRewardsFn: func(ctx sdk.Context, addr sdk.AccAddress) (sdk.Coin, error) {
+					return sdk.NewCoin(types.DefaultBondDenom, sdk.NewInt(3)), nil
+				},
+			}
+		},
+	}
+
+	fn, execs := wasmtesting.CaptureExecuteFn()
+	specs := map[string]struct {
+		src          *types.MsgWithdrawRewards
+		executeFn    func(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, msg []byte, coins sdk.Coins) ([]byte, error)
+		expRes       *types.MsgWithdrawRewardsResponse
+		expContracts []sdk.AccAddress
+		expErr       *sdkerrors.Error
+	}{
+		"distribution and engagement": {
+			src:          types.NewMsgWithdrawRewards(myOwnerAddr, true, true),
+			executeFn:    fn,
+			expRes:       &types.MsgWithdrawRewardsResponse{Amount: sdk.NewCoins(sdk.NewCoin(types.DefaultBondDenom, sdk.NewInt(5)))},
+			expContracts: []sdk.AccAddress{myDistributionContract, myEngagementContract},
+		},
+		"distribution only": {
+			src:          types.NewMsgWithdrawRewards(myOwnerAddr, true, false),
+			executeFn:    fn,
+			expRes:       &types.MsgWithdrawRewardsResponse{Amount: sdk.NewCoins(sdk.NewCoin(types.DefaultBondDenom, sdk.NewInt(2)))},
+			expContracts: []sdk.AccAddress{myDistributionContract},
+		},
+		"engagement only": {
+			src:          types.NewMsgWithdrawRewards(myOwnerAddr, false, true),
+			executeFn:    fn,
+			expRes:       &types.MsgWithdrawRewardsResponse{Amount: sdk.NewCoins(sdk.NewCoin(types.DefaultBondDenom, sdk.NewInt(3)))},
+			expContracts: []sdk.AccAddress{myEngagementContract},
+		},
+		"contract execute error": {
+			src: types.NewMsgWithdrawRewards(myOwnerAddr, true, true),
+			executeFn: func(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, msg []byte, coins sdk.Coins) ([]byte, error) {
+				return nil, types.ErrInvalid
+			},
+			expErr: types.ErrInvalid,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			*execs = nil
+			em := sdk.NewEventManager()
+			ctx := sdk.WrapSDKContext(sdk.Context{}.WithContext(context.Background()).WithEventManager(em))
+			// when
+			s := NewMsgServerImpl(poeKeeperMock, &wasmtesting.ContractOpsKeeperMock{ExecuteFn: spec.executeFn}, nil)
+			gotRes, gotErr := s.WithdrawRewards(ctx, spec.src)
+
+			// then
+			if spec.expErr != nil {
+				require.True(t, spec.expErr.Is(gotErr), "exp %v but got %#+v", spec.expErr, gotErr)
+				assert.Nil(t, gotRes)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expRes, gotRes)
+
+			// and contracts called
+			require.Len(t, *execs, len(spec.expContracts))
+			for i, exp := range spec.expContracts {
+				assert.Equal(t, exp, (*execs)[i].ContractAddress)
+				assert.Equal(t, myOwnerAddr, (*execs)[i].Caller)
+				assert.JSONEq(t, `{"withdraw_rewards":{}}`, string((*execs)[i].Msg))
+				assert.Empty(t, (*execs)[i].Coins)
+			}
+
+			// and events emitted
+			require.Len(t, em.Events(), len(spec.expContracts)+1)
+			for i := range spec.expContracts {
+				assert.Equal(t, types.EventTypeWithdrawRewards, em.Events()[i].Type)
+			}
+			assert.Equal(t, sdk.EventTypeMessage, em.Events()[len(spec.expContracts)].Type)
+		})
+	}
+}
+
+func TestUnjail(t *testing.T) {
+	var myOperatorAddr sdk.AccAddress = rand.Bytes(address.Len)
+
+	specs := map[string]struct {
+		src      *types.MsgUnjail
+		unjailFn func(ctx sdk.Context, sender sdk.AccAddress) error
+		expErr   *sdkerrors.Error
+	}{
+		"all good": {
+			src: types.NewMsgUnjail(myOperatorAddr),
+			unjailFn: func(ctx sdk.Context, sender sdk.AccAddress) error {
+				return nil
+			},
+		},
+		"contract execute error": {
+			src: types.NewMsgUnjail(myOperatorAddr),
+			unjailFn: func(ctx sdk.Context, sender sdk.AccAddress) error {
+				return types.ErrInvalid
+			},
+			expErr: types.ErrInvalid,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			var capturedSender sdk.AccAddress
+			poeKeeperMock := PoEKeeperMock{
+				ValsetContractFn: func(ctx sdk.Context) ValsetContract {
+					return poetesting.ValsetContractMock{
+						UnjailValidatorFn: func(ctx sdk.Context, sender sdk.AccAddress) error {
+							capturedSender = sender
+							return spec.unjailFn(ctx, sender)
+						},
+					}
+				},
+			}
+			em := sdk.NewEventManager()
+			ctx := sdk.WrapSDKContext(sdk.Context{}.WithContext(context.Background()).WithEventManager(em))
+			// when
+			s := NewMsgServerImpl(poeKeeperMock, nil, nil)
+			gotRes, gotErr := s.Unjail(ctx, spec.src)
+
+			// then
+			assert.Equal(t, myOperatorAddr, capturedSender)
+			if spec.expErr != nil {
+				require.True(t, spec.expErr.Is(gotErr), "exp %v but got %#+v", spec.expErr, gotErr)
+				assert.Nil(t, gotRes)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, &types.MsgUnjailResponse{}, gotRes)
+
+			// and events emitted
+			require.Len(t, em.Events(), 2)
+			assert.Equal(t, sdk.EventTypeMessage, em.Events()[0].Type)
+			assert.Equal(t, types.EventTypeUnjail, em.Events()[1].Type)
+		})
+	}
+}
+
+func TestSetWithdrawAddress(t *testing.T) {
+	var (
+		myOwnerAddr    sdk.AccAddress = rand.Bytes(address.Len)
+		myWithdrawAddr sdk.AccAddress = rand.Bytes(address.Len)
+	)
+
+	specs := map[string]struct {
+		src        *types.MsgSetWithdrawAddress
+		delegateFn func(ctx sdk.Context, delegated, sender sdk.AccAddress) error
+		expErr     *sdkerrors.Error
+	}{
+		"all good": {
+			src: types.NewMsgSetWithdrawAddress(myOwnerAddr, myWithdrawAddr),
+			delegateFn: func(ctx sdk.Context, delegated, sender sdk.AccAddress) error {
+				return nil
+			},
+		},
+		"contract execute error": {
+			src: types.NewMsgSetWithdrawAddress(myOwnerAddr, myWithdrawAddr),
+			delegateFn: func(ctx sdk.Context, delegated, sender sdk.AccAddress) error {
+				return types.ErrInvalid
+			},
+			expErr: types.ErrInvalid,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			var capturedDelegated, capturedSender sdk.AccAddress
+			poeKeeperMock := PoEKeeperMock{
+				EngagementContractFn: func(ctx sdk.Context) EngagementContract {
+					return poetesting.EngagementContractMock{
+						DelegateWithdrawalFn: func(ctx sdk.Context, delegated, sender sdk.AccAddress) error {
+							capturedDelegated, capturedSender = delegated, sender
+							return spec.delegateFn(ctx, delegated, sender)
+						},
+					}
+				},
+			}
+			em := sdk.NewEventManager()
+			ctx := sdk.WrapSDKContext(sdk.Context{}.WithContext(context.Background()).WithEventManager(em))
+			// when
+			s := NewMsgServerImpl(poeKeeperMock, nil, nil)
+			gotRes, gotErr := s.SetWithdrawAddress(ctx, spec.src)
+
+			// then
+			assert.Equal(t, myWithdrawAddr, capturedDelegated)
+			assert.Equal(t, myOwnerAddr, capturedSender)
+			if spec.expErr != nil {
+				require.True(t, spec.expErr.Is(gotErr), "exp %v but got %#+v", spec.expErr, gotErr)
+				assert.Nil(t, gotRes)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, &types.MsgSetWithdrawAddressResponse{}, gotRes)
+
+			// and events emitted
+			require.Len(t, em.Events(), 2)
+			assert.Equal(t, sdk.EventTypeMessage, em.Events()[0].Type)
+			assert.Equal(t, types.EventTypeSetWithdrawAddress, em.Events()[1].Type)
+		})
+	}
+}
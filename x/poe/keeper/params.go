@@ -24,12 +24,19 @@ func (k *Keeper) MinimumDelegationAmounts(ctx sdk.Context) (res sdk.Coins) {
 	return
 }
 
+// GetFeeSplit returns the shares of the collected TX fees
+func (k *Keeper) GetFeeSplit(ctx sdk.Context) (res types.FeeSplit) {
+	k.paramStore.Get(ctx, types.KeyFeeSplit, &res)
+	return
+}
+
 // GetParams returns all parameters as types.Params
 func (k *Keeper) GetParams(ctx sdk.Context) types.Params {
 	return types.NewParams(
 		k.HistoricalEntries(ctx),
 		k.GetInitialValidatorEngagementPoints(ctx),
 		k.MinimumDelegationAmounts(ctx),
+		k.GetFeeSplit(ctx),
 	)
 }
 
@@ -34,10 +34,15 @@ func (m DistributionContractMock) Address() (sdk.AccAddress, error) {
 
 type ValsetContractMock struct {
 	QueryValidatorFn          func(ctx sdk.Context, opAddr sdk.AccAddress) (*stakingtypes.Validator, error)
+	QueryRawValidatorFn       func(ctx sdk.Context, opAddr sdk.AccAddress) (contract.ValidatorResponse, error)
+	QueryEpochFn              func(ctx sdk.Context) (*contract.ValsetEpochResponse, error)
 	ListValidatorsFn          func(ctx sdk.Context, pagination *contract.Paginator) ([]stakingtypes.Validator, contract.PaginationCursor, error)
 	QueryConfigFn             func(ctx sdk.Context) (*contract.ValsetConfigResponse, error)
 	ListValidatorSlashingFn   func(ctx sdk.Context, opAddr sdk.AccAddress) ([]contract.ValidatorSlashing, error)
+	QueryValidatorSlashingFn  func(ctx sdk.Context, opAddr sdk.AccAddress) (*contract.ListValidatorSlashingResponse, error)
 	UpdateAdminFn             func(ctx sdk.Context, new sdk.AccAddress, sender sdk.AccAddress) error
+	UnjailValidatorFn         func(ctx sdk.Context, sender sdk.AccAddress) error
+	JailValidatorFn           func(ctx sdk.Context, nodeOperator sdk.AccAddress, duration time.Duration, forever bool, sender sdk.AccAddress) error
 	IterateActiveValidatorsFn func(ctx sdk.Context, callback func(c contract.ValidatorInfo) bool, pagination *contract.Paginator) error
 	AddressFn                 func() (sdk.AccAddress, error)
 }
@@ -56,6 +61,20 @@ func (m ValsetContractMock) UpdateAdmin(ctx sdk.Context, new sdk.AccAddress, sen
 	return m.UpdateAdminFn(ctx, new, sender)
 }
 
+func (m ValsetContractMock) UnjailValidator(ctx sdk.Context, sender sdk.AccAddress) error {
+	if m.UnjailValidatorFn == nil {
+		panic("not expected to be called")
+	}
+	return m.UnjailValidatorFn(ctx, sender)
+}
+
+func (m ValsetContractMock) JailValidator(ctx sdk.Context, nodeOperator sdk.AccAddress, duration time.Duration, forever bool, sender sdk.AccAddress) error {
+	if m.JailValidatorFn == nil {
+		panic("not expected to be called")
+	}
+	return m.JailValidatorFn(ctx, nodeOperator, duration, forever, sender)
+}
+
 func (m ValsetContractMock) QueryValidator(ctx sdk.Context, opAddr sdk.AccAddress) (*stakingtypes.Validator, error) {
 	if m.QueryValidatorFn == nil {
 		panic("not expected to be called")
@@ -63,6 +82,20 @@ func (m ValsetContractMock) QueryValidator(ctx sdk.Context, opAddr sdk.AccAddres
 	return m.QueryValidatorFn(ctx, opAddr)
 }
 
+func (m ValsetContractMock) QueryRawValidator(ctx sdk.Context, opAddr sdk.AccAddress) (contract.ValidatorResponse, error) {
+	if m.QueryRawValidatorFn == nil {
+		panic("not expected to be called")
+	}
+	return m.QueryRawValidatorFn(ctx, opAddr)
+}
+
+func (m ValsetContractMock) QueryEpoch(ctx sdk.Context) (*contract.ValsetEpochResponse, error) {
+	if m.QueryEpochFn == nil {
+		panic("not expected to be called")
+	}
+	return m.QueryEpochFn(ctx)
+}
+
 func (m ValsetContractMock) ListValidators(ctx sdk.Context, pagination *contract.Paginator) ([]stakingtypes.Validator, contract.PaginationCursor, error) {
 	if m.ListValidatorsFn == nil {
 		panic("not expected to be called")
@@ -84,6 +117,13 @@ func (m ValsetContractMock) ListValidatorSlashing(ctx sdk.Context, opAddr sdk.Ac
 	return m.ListValidatorSlashingFn(ctx, opAddr)
 }
 
+func (m ValsetContractMock) QueryValidatorSlashing(ctx sdk.Context, opAddr sdk.AccAddress) (*contract.ListValidatorSlashingResponse, error) {
+	if m.QueryValidatorSlashingFn == nil {
+		panic("not expected to be called")
+	}
+	return m.QueryValidatorSlashingFn(ctx, opAddr)
+}
+
 func (m ValsetContractMock) Address() (sdk.AccAddress, error) {
 	if m.AddressFn == nil {
 		panic("not expected to be called")
@@ -132,8 +172,10 @@ func (m StakeContractMock) Address() (sdk.AccAddress, error) {
 
 type EngagementContractMock struct {
 	UpdateAdminFn              func(ctx sdk.Context, newAdmin, sender sdk.AccAddress) error
+	DelegateWithdrawalFn       func(ctx sdk.Context, delegated, sender sdk.AccAddress) error
 	QueryDelegatedFn           func(ctx sdk.Context, ownerAddr sdk.AccAddress) (*contract.DelegatedResponse, error)
 	QueryWithdrawableRewardsFn func(ctx sdk.Context, addr sdk.AccAddress) (sdk.Coin, error)
+	ListMembersByPointsFn      func(ctx sdk.Context, pagination *contract.Paginator) ([]contract.TG4Member, contract.PaginationCursor, error)
 	AddressFn                  func() (sdk.AccAddress, error)
 }
 
@@ -144,6 +186,13 @@ func (m EngagementContractMock) UpdateAdmin(ctx sdk.Context, newAdmin, sender sd
 	return m.UpdateAdminFn(ctx, newAdmin, sender)
 }
 
+func (m EngagementContractMock) DelegateWithdrawal(ctx sdk.Context, delegated, sender sdk.AccAddress) error {
+	if m.DelegateWithdrawalFn == nil {
+		panic("not expected to be called")
+	}
+	return m.DelegateWithdrawalFn(ctx, delegated, sender)
+}
+
 func (m EngagementContractMock) QueryDelegated(ctx sdk.Context, ownerAddr sdk.AccAddress) (*contract.DelegatedResponse, error) {
 	if m.QueryDelegatedFn == nil {
 		panic("not expected to be called")
@@ -164,3 +213,31 @@ func (m EngagementContractMock) QueryWithdrawableRewards(ctx sdk.Context, addr s
 	}
 	return m.QueryWithdrawableRewardsFn(ctx, addr)
 }
+
+func (m EngagementContractMock) ListMembersByPoints(ctx sdk.Context, pagination *contract.Paginator) ([]contract.TG4Member, contract.PaginationCursor, error) {
+	if m.ListMembersByPointsFn == nil {
+		panic("not expected to be called")
+	}
+	return m.ListMembersByPointsFn(ctx, pagination)
+}
+
+// var _ keeper.MixerContract = MixerContractMock{}
+
+type MixerContractMock struct {
+	QueryMixerFunctionFn func(ctx sdk.Context, stake, engagement uint64) (uint64, error)
+	AddressFn            func() (sdk.AccAddress, error)
+}
+
+func (m MixerContractMock) QueryMixerFunction(ctx sdk.Context, stake, engagement uint64) (uint64, error) {
+	if m.QueryMixerFunctionFn == nil {
+		panic("not expected to be called")
+	}
+	return m.QueryMixerFunctionFn(ctx, stake, engagement)
+}
+
+func (m MixerContractMock) Address() (sdk.AccAddress, error) {
+	if m.AddressFn == nil {
+		panic("not expected to be called")
+	}
+	return m.AddressFn()
+}
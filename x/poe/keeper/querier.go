@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/types/query"
 
@@ -27,10 +28,12 @@ type ViewKeeper interface {
 	ContractSource
 	GetHistoricalInfo(ctx sdk.Context, height int64) (stakingtypes.HistoricalInfo, bool)
 	GetBondDenom(ctx sdk.Context) string
+	GetEngagementPoints(ctx sdk.Context, opAddr sdk.AccAddress) (uint64, error)
 	DistributionContract(ctx sdk.Context) DistributionContract
 	ValsetContract(ctx sdk.Context) ValsetContract
 	StakeContract(ctx sdk.Context) StakeContract
 	EngagementContract(ctx sdk.Context) EngagementContract
+	MixerContract(ctx sdk.Context) MixerContract
 }
 
 type Querier struct {
@@ -232,3 +235,194 @@ func (q Querier) ValidatorEngagementReward(c context.Context, req *types.QueryVa
 		Reward: sdk.NewDecCoin(reward.Denom, reward.Amount),
 	}, nil
 }
+
+// EngagementPoints query the engagement points of an address. Returns zero for non members.
+func (q Querier) EngagementPoints(c context.Context, req *types.QueryEngagementPointsRequest) (*types.QueryEngagementPointsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "address cannot be empty")
+	}
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "address invalid")
+	}
+	points, err := q.keeper.GetEngagementPoints(sdk.UnwrapSDKContext(c), addr)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryEngagementPointsResponse{Points: points}, nil
+}
+
+// EngagementMembers query all engagement group members ordered by points descending.
+func (q Querier) EngagementMembers(c context.Context, req *types.QueryEngagementMembersRequest) (*types.QueryEngagementMembersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	pagination, err := contract.NewPaginator(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	members, cursor, err := q.keeper.EngagementContract(ctx).ListMembersByPoints(ctx, pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	result := make([]types.EngagementMember, len(members))
+	for i, m := range members {
+		result[i] = types.EngagementMember{Address: m.Addr, Points: m.Points}
+	}
+	var pageResp *query.PageResponse
+	if len(cursor) != 0 {
+		pageResp = &query.PageResponse{
+			NextKey: cursor,
+		}
+	}
+	return &types.QueryEngagementMembersResponse{
+		Members:    result,
+		Pagination: pageResp,
+	}, nil
+}
+
+// ValidatorJailStatus query the jail status of a validator.
+// returns NotFound error code when none exists for the given address
+func (q Querier) ValidatorJailStatus(c context.Context, req *types.QueryValidatorJailStatusRequest) (*types.QueryValidatorJailStatusResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.ValidatorAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "validator address cannot be empty")
+	}
+	opAddr, err := sdk.AccAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "validator address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	rsp, err := q.keeper.ValsetContract(ctx).QueryRawValidator(ctx, opAddr)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if rsp.Validator == nil {
+		return nil, status.Error(codes.NotFound, "by address")
+	}
+	jailing := rsp.Validator.JailedUntil
+	if jailing == nil {
+		return &types.QueryValidatorJailStatusResponse{}, nil
+	}
+	result := types.QueryValidatorJailStatusResponse{
+		Jailed:  true,
+		Start:   &jailing.Start,
+		Forever: jailing.End.Forever,
+	}
+	if !jailing.End.Forever {
+		result.Until = &jailing.End.Until
+		result.CanUnjail = !ctx.BlockTime().Before(jailing.End.Until)
+	}
+	return &result, nil
+}
+
+// EpochInfo query the current valset epoch and reward information
+func (q Querier) EpochInfo(c context.Context, req *types.QueryEpochInfoRequest) (*types.QueryEpochInfoResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	valset := q.keeper.ValsetContract(ctx)
+	epoch, err := valset.QueryEpoch(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	config, err := valset.QueryConfig(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	epochLength := time.Duration(epoch.EpochLength) * time.Second
+	return &types.QueryEpochInfoResponse{
+		CurrentEpoch:           epoch.CurrentEpoch,
+		EpochLength:            epochLength,
+		NextEpochTime:          time.Unix(int64(epoch.NextUpdateTime), 0).UTC(),
+		EpochReward:            config.EpochReward,
+		LastDistributionHeight: epoch.LastUpdateHeight,
+		LastDistributionTime:   time.Unix(int64(epoch.LastUpdateTime), 0).UTC(),
+	}, nil
+}
+
+// SlashingEvents query the slashing history of all validators. Pagination is over validators.
+func (q Querier) SlashingEvents(c context.Context, req *types.QuerySlashingEventsRequest) (*types.QuerySlashingEventsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.MaxHeight != 0 && req.MinHeight > req.MaxHeight {
+		return nil, status.Error(codes.InvalidArgument, "min height must not be greater than max height")
+	}
+	pagination, err := contract.NewPaginator(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	valset := q.keeper.ValsetContract(ctx)
+	vals, cursor, err := valset.ListValidators(ctx, pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	events := make([]types.SlashingEvent, 0)
+	for _, v := range vals {
+		opAddr, err := sdk.AccAddressFromBech32(v.OperatorAddress)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		slashing, err := valset.QueryValidatorSlashing(ctx, opAddr)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		if len(slashing.Slashing) == 0 {
+			continue
+		}
+		var jailedUntil *time.Time
+		var jailedForever bool
+		if jailing := slashing.JailedUntil; jailing != nil {
+			jailedForever = jailing.End.Forever
+			if !jailedForever {
+				jailedUntil = &jailing.End.Until
+			}
+		}
+		for _, s := range slashing.Slashing {
+			if s.Height < req.MinHeight || (req.MaxHeight != 0 && s.Height > req.MaxHeight) {
+				continue
+			}
+			events = append(events, types.SlashingEvent{
+				Operator:      v.OperatorAddress,
+				Height:        s.Height,
+				Portion:       s.Portion,
+				Tombstoned:    slashing.Tombstoned,
+				JailedUntil:   jailedUntil,
+				JailedForever: jailedForever,
+			})
+		}
+	}
+	var pageResp *query.PageResponse
+	if len(cursor) != 0 {
+		pageResp = &query.PageResponse{
+			NextKey: cursor,
+		}
+	}
+	return &types.QuerySlashingEventsResponse{
+		Events:     events,
+		Pagination: pageResp,
+	}, nil
+}
+
+// PreviewMixedPoints query the points the mixer function calculates for the given stake and engagement points
+func (q Querier) PreviewMixedPoints(c context.Context, req *types.QueryPreviewMixedPointsRequest) (*types.QueryPreviewMixedPointsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	points, err := q.keeper.MixerContract(ctx).QueryMixerFunction(ctx, req.Stake, req.Engagement)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryPreviewMixedPointsResponse{Points: points}, nil
+}
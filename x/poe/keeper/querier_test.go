@@ -519,3 +519,517 @@ func TestValidatorEngagementReward(t *testing.T) {
 		})
 	}
 }
+
+func TestEngagementPoints(t *testing.T) {
+	var anyAddr sdk.AccAddress = rand.Bytes(address.Len)
+
+	specs := map[string]struct {
+		src    *types.QueryEngagementPointsRequest
+		mockFn func(ctx sdk.Context, opAddr sdk.AccAddress) (uint64, error)
+		exp    *types.QueryEngagementPointsResponse
+		expErr error
+	}{
+		"points": {
+			src: &types.QueryEngagementPointsRequest{Address: anyAddr.String()},
+			mockFn: func(ctx sdk.Context, opAddr sdk.AccAddress) (uint64, error) {
+				require.Equal(t, anyAddr, opAddr)
+				return 123, nil
+			},
+			exp: &types.QueryEngagementPointsResponse{Points: 123},
+		},
+		"nil request": {
+			expErr: status.Error(codes.InvalidArgument, "empty request"),
+		},
+		"empty address": {
+			src:    &types.QueryEngagementPointsRequest{},
+			expErr: status.Error(codes.InvalidArgument, "address cannot be empty"),
+		},
+		"invalid address": {
+			src:    &types.QueryEngagementPointsRequest{Address: "invalid"},
+			expErr: status.Error(codes.InvalidArgument, "address invalid"),
+		},
+		"any error": {
+			src: &types.QueryEngagementPointsRequest{Address: anyAddr.String()},
+			mockFn: func(ctx sdk.Context, opAddr sdk.AccAddress) (uint64, error) {
+				return 0, errors.New("testing")
+			},
+			expErr: status.Error(codes.Internal, "testing"),
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			keeperMock := PoEKeeperMock{GetEngagementPointsFn: spec.mockFn}
+
+			c := sdk.WrapSDKContext(sdk.Context{}.WithContext(context.Background()))
+			// when
+			s := NewQuerier(keeperMock)
+			gotResp, gotErr := s.EngagementPoints(c, spec.src)
+			// then
+			if spec.expErr != nil {
+				assert.ErrorIs(t, gotErr, spec.expErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.exp, gotResp)
+		})
+	}
+}
+
+func TestEngagementMembers(t *testing.T) {
+	var (
+		myMemberAddr    sdk.AccAddress = rand.Bytes(address.Len)
+		otherMemberAddr sdk.AccAddress = rand.Bytes(address.Len)
+	)
+	members := []contract.TG4Member{
+		{Addr: myMemberAddr.String(), Points: 3},
+		{Addr: otherMemberAddr.String(), Points: 1},
+	}
+
+	specs := map[string]struct {
+		src    *types.QueryEngagementMembersRequest
+		mock   poetesting.EngagementContractMock
+		exp    *types.QueryEngagementMembersResponse
+		expErr bool
+	}{
+		"all good": {
+			src: &types.QueryEngagementMembersRequest{Pagination: &query.PageRequest{Key: []byte("my_key"), Limit: 2}},
+			mock: poetesting.EngagementContractMock{
+				ListMembersByPointsFn: func(ctx sdk.Context, pagination *contract.Paginator) ([]contract.TG4Member, contract.PaginationCursor, error) {
+					require.Equal(t, &contract.Paginator{StartAfter: []byte("my_key"), Limit: 2}, pagination)
+					return members, []byte("my_next_key"), nil
+				},
+			},
+			exp: &types.QueryEngagementMembersResponse{
+				Members: []types.EngagementMember{
+					{Address: myMemberAddr.String(), Points: 3},
+					{Address: otherMemberAddr.String(), Points: 1},
+				},
+				Pagination: &query.PageResponse{NextKey: []byte("my_next_key")},
+			},
+		},
+		"empty result": {
+			src: &types.QueryEngagementMembersRequest{},
+			mock: poetesting.EngagementContractMock{
+				ListMembersByPointsFn: func(ctx sdk.Context, pagination *contract.Paginator) ([]contract.TG4Member, contract.PaginationCursor, error) {
+					return nil, nil, nil
+				},
+			},
+			exp: &types.QueryEngagementMembersResponse{Members: []types.EngagementMember{}},
+		},
+		"nil request": {
+			expErr: true,
+		},
+		"offset not supported": {
+			src:    &types.QueryEngagementMembersRequest{Pagination: &query.PageRequest{Offset: 1}},
+			expErr: true,
+		},
+		"contract returns error": {
+			src: &types.QueryEngagementMembersRequest{},
+			mock: poetesting.EngagementContractMock{
+				ListMembersByPointsFn: func(ctx sdk.Context, pagination *contract.Paginator) ([]contract.TG4Member, contract.PaginationCursor, error) {
+					return nil, nil, errors.New("testing")
+				},
+			},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			keeperMock := PoEKeeperMock{
+				EngagementContractFn: func(ctx sdk.Context) EngagementContract { return spec.mock },
+			}
+			c := sdk.WrapSDKContext(sdk.Context{}.WithContext(context.Background()))
+			// when
+			s := NewQuerier(keeperMock)
+			gotRes, gotErr := s.EngagementMembers(c, spec.src)
+
+			// then
+			if spec.expErr {
+				assert.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.exp, gotRes)
+		})
+	}
+}
+
+func TestValidatorJailStatus(t *testing.T) {
+	var anyAddr sdk.AccAddress = rand.Bytes(address.Len)
+	blockTime := time.Date(2022, 2, 11, 10, 9, 8, 0, time.UTC)
+	jailStart := blockTime.Add(-time.Hour)
+
+	specs := map[string]struct {
+		src    *types.QueryValidatorJailStatusRequest
+		mock   poetesting.ValsetContractMock
+		exp    *types.QueryValidatorJailStatusResponse
+		expErr error
+	}{
+		"not jailed": {
+			src: &types.QueryValidatorJailStatusRequest{ValidatorAddr: anyAddr.String()},
+			mock: poetesting.ValsetContractMock{QueryRawValidatorFn: func(ctx sdk.Context, opAddr sdk.AccAddress) (contract.ValidatorResponse, error) {
+				require.Equal(t, anyAddr, opAddr)
+				return contract.ValidatorResponse{Validator: &contract.OperatorResponse{Operator: anyAddr.String()}}, nil
+			}},
+			exp: &types.QueryValidatorJailStatusResponse{},
+		},
+		"jailed - period not passed": {
+			src: &types.QueryValidatorJailStatusRequest{ValidatorAddr: anyAddr.String()},
+			mock: poetesting.ValsetContractMock{QueryRawValidatorFn: func(ctx sdk.Context, opAddr sdk.AccAddress) (contract.ValidatorResponse, error) {
+				return contract.ValidatorResponse{Validator: &contract.OperatorResponse{
+					Operator:    anyAddr.String(),
+					JailedUntil: &contract.JailingPeriod{Start: jailStart, End: contract.JailingEnd{Until: blockTime.Add(time.Second)}},
+				}}, nil
+			}},
+			exp: &types.QueryValidatorJailStatusResponse{
+				Jailed: true,
+				Start:  &jailStart,
+				Until:  timePtr(blockTime.Add(time.Second)),
+			},
+		},
+		"jailed - period passed": {
+			src: &types.QueryValidatorJailStatusRequest{ValidatorAddr: anyAddr.String()},
+			mock: poetesting.ValsetContractMock{QueryRawValidatorFn: func(ctx sdk.Context, opAddr sdk.AccAddress) (contract.ValidatorResponse, error) {
+				return contract.ValidatorResponse{Validator: &contract.OperatorResponse{
+					Operator:    anyAddr.String(),
+					JailedUntil: &contract.JailingPeriod{Start: jailStart, End: contract.JailingEnd{Until: blockTime}},
+				}}, nil
+			}},
+			exp: &types.QueryValidatorJailStatusResponse{
+				Jailed:    true,
+				Start:     &jailStart,
+				Until:     &blockTime,
+				CanUnjail: true,
+			},
+		},
+		"jailed forever": {
+			src: &types.QueryValidatorJailStatusRequest{ValidatorAddr: anyAddr.String()},
+			mock: poetesting.ValsetContractMock{QueryRawValidatorFn: func(ctx sdk.Context, opAddr sdk.AccAddress) (contract.ValidatorResponse, error) {
+				return contract.ValidatorResponse{Validator: &contract.OperatorResponse{
+					Operator:    anyAddr.String(),
+					JailedUntil: &contract.JailingPeriod{Start: jailStart, End: contract.JailingEnd{Forever: true}},
+				}}, nil
+			}},
+			exp: &types.QueryValidatorJailStatusResponse{
+				Jailed:  true,
+				Start:   &jailStart,
+				Forever: true,
+			},
+		},
+		"not found": {
+			src: &types.QueryValidatorJailStatusRequest{ValidatorAddr: anyAddr.String()},
+			mock: poetesting.ValsetContractMock{QueryRawValidatorFn: func(ctx sdk.Context, opAddr sdk.AccAddress) (contract.ValidatorResponse, error) {
+				return contract.ValidatorResponse{}, nil
+			}},
+			expErr: status.Error(codes.NotFound, "by address"),
+		},
+		"nil request": {
+			expErr: status.Error(codes.InvalidArgument, "empty request"),
+		},
+		"empty address": {
+			src:    &types.QueryValidatorJailStatusRequest{},
+			expErr: status.Error(codes.InvalidArgument, "validator address cannot be empty"),
+		},
+		"any error": {
+			src: &types.QueryValidatorJailStatusRequest{ValidatorAddr: anyAddr.String()},
+			mock: poetesting.ValsetContractMock{QueryRawValidatorFn: func(ctx sdk.Context, opAddr sdk.AccAddress) (contract.ValidatorResponse, error) {
+				return contract.ValidatorResponse{}, errors.New("testing")
+			}},
+			expErr: status.Error(codes.Internal, "testing"),
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			keeperMock := PoEKeeperMock{
+				ValsetContractFn: func(ctx sdk.Context) ValsetContract { return spec.mock },
+			}
+			c := sdk.WrapSDKContext(sdk.Context{}.WithContext(context.Background()).WithBlockTime(blockTime))
+			// when
+			s := NewQuerier(keeperMock)
+			gotResp, gotErr := s.ValidatorJailStatus(c, spec.src)
+			// then
+			if spec.expErr != nil {
+				assert.ErrorIs(t, gotErr, spec.expErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.exp, gotResp)
+		})
+	}
+}
+
+func TestEpochInfo(t *testing.T) {
+	specs := map[string]struct {
+		src    *types.QueryEpochInfoRequest
+		mock   poetesting.ValsetContractMock
+		exp    *types.QueryEpochInfoResponse
+		expErr bool
+	}{
+		"all good": {
+			src: &types.QueryEpochInfoRequest{},
+			mock: poetesting.ValsetContractMock{
+				QueryEpochFn: func(ctx sdk.Context) (*contract.ValsetEpochResponse, error) {
+					return &contract.ValsetEpochResponse{
+						EpochLength:      60,
+						CurrentEpoch:     10,
+						LastUpdateTime:   600,
+						LastUpdateHeight: 7,
+						NextUpdateTime:   700,
+					}, nil
+				},
+				QueryConfigFn: func(ctx sdk.Context) (*contract.ValsetConfigResponse, error) {
+					return &contract.ValsetConfigResponse{EpochReward: sdk.NewInt64Coin("utgd", 100)}, nil
+				},
+			},
+			exp: &types.QueryEpochInfoResponse{
+				CurrentEpoch:           10,
+				EpochLength:            time.Minute,
+				NextEpochTime:          time.Unix(700, 0).UTC(),
+				EpochReward:            sdk.NewInt64Coin("utgd", 100),
+				LastDistributionHeight: 7,
+				LastDistributionTime:   time.Unix(600, 0).UTC(),
+			},
+		},
+		"nil request": {
+			expErr: true,
+		},
+		"epoch query error": {
+			src: &types.QueryEpochInfoRequest{},
+			mock: poetesting.ValsetContractMock{
+				QueryEpochFn: func(ctx sdk.Context) (*contract.ValsetEpochResponse, error) {
+					return nil, errors.New("testing")
+				},
+			},
+			expErr: true,
+		},
+		"config query error": {
+			src: &types.QueryEpochInfoRequest{},
+			mock: poetesting.ValsetContractMock{
+				QueryEpochFn: func(ctx sdk.Context) (*contract.ValsetEpochResponse, error) {
+					return &contract.ValsetEpochResponse{}, nil
+				},
+				QueryConfigFn: func(ctx sdk.Context) (*contract.ValsetConfigResponse, error) {
+					return nil, errors.New("testing")
+				},
+			},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			keeperMock := PoEKeeperMock{
+				ValsetContractFn: func(ctx sdk.Context) ValsetContract { return spec.mock },
+			}
+			c := sdk.WrapSDKContext(sdk.Context{}.WithContext(context.Background()))
+			// when
+			s := NewQuerier(keeperMock)
+			gotResp, gotErr := s.EpochInfo(c, spec.src)
+			// then
+			if spec.expErr {
+				assert.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.exp, gotResp)
+		})
+	}
+}
+
+func TestSlashingEvents(t *testing.T) {
+	var (
+		myOperator    sdk.AccAddress = rand.Bytes(address.Len)
+		otherOperator sdk.AccAddress = rand.Bytes(address.Len)
+	)
+	jailedUntil := time.Now().UTC()
+	vals := []stakingtypes.Validator{
+		{OperatorAddress: myOperator.String()},
+		{OperatorAddress: otherOperator.String()},
+	}
+	slashingFn := func(ctx sdk.Context, opAddr sdk.AccAddress) (*contract.ListValidatorSlashingResponse, error) {
+		if opAddr.Equals(myOperator) {
+			return &contract.ListValidatorSlashingResponse{
+				Operator:    opAddr.String(),
+				Tombstoned:  true,
+				JailedUntil: &contract.JailingPeriod{End: contract.JailingEnd{Until: jailedUntil}},
+				Slashing: []contract.ValidatorSlashing{
+					{Height: 10, Portion: sdk.NewDecWithPrec(1, 1)},
+					{Height: 20, Portion: sdk.NewDecWithPrec(2, 1)},
+				},
+			}, nil
+		}
+		return &contract.ListValidatorSlashingResponse{
+			Operator: opAddr.String(),
+			Slashing: []contract.ValidatorSlashing{{Height: 15, Portion: sdk.NewDecWithPrec(5, 1)}},
+		}, nil
+	}
+	specs := map[string]struct {
+		src    *types.QuerySlashingEventsRequest
+		mock   poetesting.ValsetContractMock
+		exp    *types.QuerySlashingEventsResponse
+		expErr bool
+	}{
+		"all events": {
+			src: &types.QuerySlashingEventsRequest{Pagination: &query.PageRequest{Key: []byte("my_key"), Limit: 2}},
+			mock: poetesting.ValsetContractMock{
+				ListValidatorsFn: func(ctx sdk.Context, pagination *contract.Paginator) ([]stakingtypes.Validator, contract.PaginationCursor, error) {
+					require.Equal(t, &contract.Paginator{StartAfter: []byte("my_key"), Limit: 2}, pagination)
+					return vals, []byte("my_next_key"), nil
+				},
+				QueryValidatorSlashingFn: slashingFn,
+			},
+			exp: &types.QuerySlashingEventsResponse{
+				Events: []types.SlashingEvent{
+					{Operator: myOperator.String(), Height: 10, Portion: sdk.NewDecWithPrec(1, 1), Tombstoned: true, JailedUntil: &jailedUntil},
+					{Operator: myOperator.String(), Height: 20, Portion: sdk.NewDecWithPrec(2, 1), Tombstoned: true, JailedUntil: &jailedUntil},
+					{Operator: otherOperator.String(), Height: 15, Portion: sdk.NewDecWithPrec(5, 1)},
+				},
+				Pagination: &query.PageResponse{NextKey: []byte("my_next_key")},
+			},
+		},
+		"height range": {
+			src: &types.QuerySlashingEventsRequest{MinHeight: 11, MaxHeight: 15},
+			mock: poetesting.ValsetContractMock{
+				ListValidatorsFn: func(ctx sdk.Context, pagination *contract.Paginator) ([]stakingtypes.Validator, contract.PaginationCursor, error) {
+					return vals, nil, nil
+				},
+				QueryValidatorSlashingFn: slashingFn,
+			},
+			exp: &types.QuerySlashingEventsResponse{
+				Events: []types.SlashingEvent{
+					{Operator: otherOperator.String(), Height: 15, Portion: sdk.NewDecWithPrec(5, 1)},
+				},
+			},
+		},
+		"min height only": {
+			src: &types.QuerySlashingEventsRequest{MinHeight: 16},
+			mock: poetesting.ValsetContractMock{
+				ListValidatorsFn: func(ctx sdk.Context, pagination *contract.Paginator) ([]stakingtypes.Validator, contract.PaginationCursor, error) {
+					return vals, nil, nil
+				},
+				QueryValidatorSlashingFn: slashingFn,
+			},
+			exp: &types.QuerySlashingEventsResponse{
+				Events: []types.SlashingEvent{
+					{Operator: myOperator.String(), Height: 20, Portion: sdk.NewDecWithPrec(2, 1), Tombstoned: true, JailedUntil: &jailedUntil},
+				},
+			},
+		},
+		"no slashing": {
+			src: &types.QuerySlashingEventsRequest{},
+			mock: poetesting.ValsetContractMock{
+				ListValidatorsFn: func(ctx sdk.Context, pagination *contract.Paginator) ([]stakingtypes.Validator, contract.PaginationCursor, error) {
+					return vals, nil, nil
+				},
+				QueryValidatorSlashingFn: func(ctx sdk.Context, opAddr sdk.AccAddress) (*contract.ListValidatorSlashingResponse, error) {
+					return &contract.ListValidatorSlashingResponse{Operator: opAddr.String()}, nil
+				},
+			},
+			exp: &types.QuerySlashingEventsResponse{Events: []types.SlashingEvent{}},
+		},
+		"nil request": {
+			expErr: true,
+		},
+		"min height greater than max height": {
+			src:    &types.QuerySlashingEventsRequest{MinHeight: 2, MaxHeight: 1},
+			expErr: true,
+		},
+		"offset not supported": {
+			src:    &types.QuerySlashingEventsRequest{Pagination: &query.PageRequest{Offset: 1}},
+			expErr: true,
+		},
+		"list validators error": {
+			src: &types.QuerySlashingEventsRequest{},
+			mock: poetesting.ValsetContractMock{
+				ListValidatorsFn: func(ctx sdk.Context, pagination *contract.Paginator) ([]stakingtypes.Validator, contract.PaginationCursor, error) {
+					return nil, nil, errors.New("testing")
+				},
+			},
+			expErr: true,
+		},
+		"slashing query error": {
+			src: &types.QuerySlashingEventsRequest{},
+			mock: poetesting.ValsetContractMock{
+				ListValidatorsFn: func(ctx sdk.Context, pagination *contract.Paginator) ([]stakingtypes.Validator, contract.PaginationCursor, error) {
+					return vals, nil, nil
+				},
+				QueryValidatorSlashingFn: func(ctx sdk.Context, opAddr sdk.AccAddress) (*contract.ListValidatorSlashingResponse, error) {
+					return nil, errors.New("testing")
+				},
+			},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			keeperMock := PoEKeeperMock{
+				ValsetContractFn: func(ctx sdk.Context) ValsetContract { return spec.mock },
+			}
+			c := sdk.WrapSDKContext(sdk.Context{}.WithContext(context.Background()))
+			// when
+			s := NewQuerier(keeperMock)
+			gotResp, gotErr := s.SlashingEvents(c, spec.src)
+			// then
+			if spec.expErr {
+				assert.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.exp, gotResp)
+		})
+	}
+}
+
+func TestPreviewMixedPoints(t *testing.T) {
+	specs := map[string]struct {
+		src    *types.QueryPreviewMixedPointsRequest
+		mock   poetesting.MixerContractMock
+		exp    *types.QueryPreviewMixedPointsResponse
+		expErr bool
+	}{
+		"all good": {
+			src: &types.QueryPreviewMixedPointsRequest{Stake: 10, Engagement: 20},
+			mock: poetesting.MixerContractMock{
+				QueryMixerFunctionFn: func(ctx sdk.Context, stake, engagement uint64) (uint64, error) {
+					require.Equal(t, uint64(10), stake)
+					require.Equal(t, uint64(20), engagement)
+					return 15, nil
+				},
+			},
+			exp: &types.QueryPreviewMixedPointsResponse{Points: 15},
+		},
+		"nil request": {
+			expErr: true,
+		},
+		"contract returns error": {
+			src: &types.QueryPreviewMixedPointsRequest{Stake: 10, Engagement: 20},
+			mock: poetesting.MixerContractMock{
+				QueryMixerFunctionFn: func(ctx sdk.Context, stake, engagement uint64) (uint64, error) {
+					return 0, errors.New("testing")
+				},
+			},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			keeperMock := PoEKeeperMock{
+				MixerContractFn: func(ctx sdk.Context) MixerContract { return spec.mock },
+			}
+			c := sdk.WrapSDKContext(sdk.Context{}.WithContext(context.Background()))
+			// when
+			s := NewQuerier(keeperMock)
+			gotResp, gotErr := s.PreviewMixedPoints(c, spec.src)
+			// then
+			if spec.expErr {
+				assert.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.exp, gotResp)
+		})
+	}
+}
+
+func timePtr(t time.Time) *time.Time {
+	return &t
+}
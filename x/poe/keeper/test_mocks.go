@@ -24,6 +24,7 @@ type PoEKeeperMock struct {
 	SetPoEContractAddressFn               func(ctx sdk.Context, ctype types.PoEContractType, contractAddr sdk.AccAddress)
 	setParamsFn                           func(ctx sdk.Context, params types.Params)
 	GetBondDenomFn                        func(ctx sdk.Context) string
+	GetEngagementPointsFn                 func(ctx sdk.Context, opAddr sdk.AccAddress) (uint64, error)
 	HistoricalEntriesFn                   func(ctx sdk.Context) uint32
 	UnbondingTimeFn                       func(ctx sdk.Context) time.Duration
 	GetHistoricalInfoFn                   func(ctx sdk.Context, height int64) (stakingtypes.HistoricalInfo, bool)
@@ -31,6 +32,8 @@ type PoEKeeperMock struct {
 	ValsetContractFn                      func(ctx sdk.Context) ValsetContract
 	StakeContractFn                       func(ctx sdk.Context) StakeContract
 	EngagementContractFn                  func(ctx sdk.Context) EngagementContract
+	MixerContractFn                       func(ctx sdk.Context) MixerContract
+	GetFeeSplitFn                         func(ctx sdk.Context) types.FeeSplit
 }
 
 func (m PoEKeeperMock) setParams(ctx sdk.Context, params types.Params) {
@@ -54,6 +57,13 @@ func (m PoEKeeperMock) SetPoEContractAddress(ctx sdk.Context, ctype types.PoECon
 	m.SetPoEContractAddressFn(ctx, ctype, contractAddr)
 }
 
+func (m PoEKeeperMock) GetFeeSplit(ctx sdk.Context) types.FeeSplit {
+	if m.GetFeeSplitFn == nil {
+		panic("not expected to be called")
+	}
+	return m.GetFeeSplitFn(ctx)
+}
+
 func (m PoEKeeperMock) GetBondDenom(ctx sdk.Context) string {
 	if m.GetBondDenomFn == nil {
 		panic("not expected to be called")
@@ -61,6 +71,13 @@ func (m PoEKeeperMock) GetBondDenom(ctx sdk.Context) string {
 	return m.GetBondDenomFn(ctx)
 }
 
+func (m PoEKeeperMock) GetEngagementPoints(ctx sdk.Context, opAddr sdk.AccAddress) (uint64, error) {
+	if m.GetEngagementPointsFn == nil {
+		panic("not expected to be called")
+	}
+	return m.GetEngagementPointsFn(ctx, opAddr)
+}
+
 func (m PoEKeeperMock) HistoricalEntries(ctx sdk.Context) uint32 {
 	if m.HistoricalEntriesFn == nil {
 		panic("not expected to be called")
@@ -110,6 +127,13 @@ func (m PoEKeeperMock) EngagementContract(ctx sdk.Context) EngagementContract {
 	return m.EngagementContractFn(ctx)
 }
 
+func (m PoEKeeperMock) MixerContract(ctx sdk.Context) MixerContract {
+	if m.MixerContractFn == nil {
+		panic("not expected to be called")
+	}
+	return m.MixerContractFn(ctx)
+}
+
 // CapturedPoEContractAddress data type
 type CapturedPoEContractAddress struct {
 	Ctype        types.PoEContractType
@@ -0,0 +1,155 @@
+package poe
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/confio/tgrade/x/poe/keeper"
+	"github.com/confio/tgrade/x/poe/types"
+)
+
+// ContractMigrateMsgs contains the migrate message by PoE contract type. Contract types without
+// an entry are migrated with an empty json object.
+type ContractMigrateMsgs map[types.PoEContractType]interface{}
+
+type migrationKeeper interface {
+	twasmKeeper
+	GetCodeInfo(ctx sdk.Context, codeID uint64) *wasmtypes.CodeInfo
+	IterateContractsByCode(ctx sdk.Context, codeID uint64, cb func(address sdk.AccAddress) bool)
+	IsPrivileged(ctx sdk.Context, contractAddr sdk.AccAddress) bool
+	ReleasePrivileges(ctx sdk.Context, contractAddr sdk.AccAddress) error
+}
+
+// MigratePoEContracts migrates all PoE contracts that do not run the embedded code:
+// - the embedded code is stored once per distinct wasm file or reused when a PoE contract runs it already
+// - the contract is migrated by its admin with the message from msgs
+// - privileged contracts release their privileges and are promoted again so that the new code registers its own
+// - the new code is pinned and codes no longer used by any contract are unpinned
+// The PoE setup is verified afterwards.
+func MigratePoEContracts(ctx sdk.Context, k wasmtypes.ContractOpsKeeper, tk migrationKeeper, poeKeeper keeper.ContractSource, msgs ContractMigrateMsgs) error {
+	type poeContract struct {
+		tp   types.PoEContractType
+		addr sdk.AccAddress
+		info *wasmtypes.ContractInfo
+	}
+	var (
+		contracts []poeContract
+		err       error
+	)
+	// code ids by checksum of the code deployed for any PoE contract
+	codeIDs := make(map[string]uint64)
+	types.IteratePoEContractTypes(func(tp types.PoEContractType) bool {
+		var addr sdk.AccAddress
+		addr, err = poeKeeper.GetPoEContractAddress(ctx, tp)
+		if err != nil {
+			err = sdkerrors.Wrapf(err, "address for %s", tp.String())
+			return true
+		}
+		info := tk.GetContractInfo(ctx, addr)
+		if info == nil {
+			err = sdkerrors.Wrapf(types.ErrInvalid, "unknown contract: %s", addr)
+			return true
+		}
+		codeInfo := tk.GetCodeInfo(ctx, info.CodeID)
+		if codeInfo == nil {
+			err = sdkerrors.Wrapf(types.ErrInvalid, "unknown code: %d", info.CodeID)
+			return true
+		}
+		codeIDs[string(codeInfo.CodeHash)] = info.CodeID
+		contracts = append(contracts, poeContract{tp: tp, addr: addr, info: info})
+		return false
+	})
+	if err != nil { // return any error from within the iteration
+		return err
+	}
+
+	logger := keeper.ModuleLogger(ctx)
+	var replacedCodeIDs []uint64
+	for _, c := range contracts {
+		code, err := embeddedContractCode(c.tp)
+		if err != nil {
+			return err
+		}
+		checksum := sha256.Sum256(code)
+		newCodeID, exists := codeIDs[string(checksum[:])]
+		if exists && newCodeID == c.info.CodeID {
+			continue
+		}
+		admin, err := sdk.AccAddressFromBech32(c.info.Admin)
+		if err != nil {
+			return sdkerrors.Wrapf(err, "%s admin", c.tp.String())
+		}
+		if !exists {
+			newCodeID, _, err = k.Create(ctx, admin, code, &wasmtypes.AllowEverybody)
+			if err != nil {
+				return sdkerrors.Wrapf(err, "store %s contract", c.tp.String())
+			}
+			codeIDs[string(checksum[:])] = newCodeID
+		}
+		msg, err := migrateMsg(msgs, c.tp)
+		if err != nil {
+			return err
+		}
+		privileged := tk.IsPrivileged(ctx, c.addr)
+		if privileged {
+			if err := tk.ReleasePrivileges(ctx, c.addr); err != nil {
+				return sdkerrors.Wrapf(err, "release %s contract privileges", c.tp.String())
+			}
+		}
+		if _, err := k.Migrate(ctx, c.addr, admin, newCodeID, msg); err != nil {
+			return sdkerrors.Wrapf(err, "migrate %s contract", c.tp.String())
+		}
+		if privileged {
+			if err := tk.SetPrivileged(ctx, c.addr); err != nil {
+				return sdkerrors.Wrapf(err, "promote %s contract", c.tp.String())
+			}
+		}
+		if err := k.PinCode(ctx, newCodeID); err != nil {
+			return sdkerrors.Wrapf(err, "pin %s contract", c.tp.String())
+		}
+		replacedCodeIDs = append(replacedCodeIDs, c.info.CodeID)
+		logger.Info("migrated PoE contract", "name", c.tp.String(), "address", c.addr.String(), "old_code_id", c.info.CodeID, "code_id", newCodeID)
+	}
+
+	// release codes that are not used anymore
+	unpinned := make(map[uint64]struct{}, len(replacedCodeIDs))
+	for _, codeID := range replacedCodeIDs {
+		if _, ok := unpinned[codeID]; ok {
+			continue
+		}
+		unpinned[codeID] = struct{}{}
+		var used bool
+		tk.IterateContractsByCode(ctx, codeID, func(sdk.AccAddress) bool {
+			used = true
+			return true
+		})
+		if used {
+			continue
+		}
+		if err := k.UnpinCode(ctx, codeID); err != nil {
+			return sdkerrors.Wrapf(err, "unpin code %d", codeID)
+		}
+	}
+	if err := VerifyPoEContracts(ctx, tk, poeKeeper); err != nil {
+		return sdkerrors.Wrap(err, "verify PoE contracts")
+	}
+	logger.Info("Migrated PoE contracts", "version", contractVersion)
+	return nil
+}
+
+// migrateMsg returns the json encoded migrate message for the contract type or an empty json object
+func migrateMsg(msgs ContractMigrateMsgs, tp types.PoEContractType) ([]byte, error) {
+	msg, ok := msgs[tp]
+	if !ok || msg == nil {
+		return []byte("{}"), nil
+	}
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONMarshal, "%s migrate msg: %s", tp.String(), err)
+	}
+	return bz, nil
+}
@@ -0,0 +1,160 @@
+package poe_test
+
+import (
+	"os"
+	"testing"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/confio/tgrade/x/poe"
+	"github.com/confio/tgrade/x/poe/types"
+	twasmkeeper "github.com/confio/tgrade/x/twasm/keeper"
+	twasmtypes "github.com/confio/tgrade/x/twasm/types"
+)
+
+func TestMigratePoEContracts(t *testing.T) {
+	specs := map[string]struct {
+		outdated    map[types.PoEContractType]string
+		expMigrated []types.PoEContractType
+	}{
+		"all up to date": {},
+		"outdated contracts": {
+			outdated: map[types.PoEContractType]string{
+				types.PoEContractTypeEngagement:   "contract/tg4_engagement.wasm",
+				types.PoEContractTypeDistribution: "contract/tg4_engagement.wasm",
+				types.PoEContractTypeStaking:      "contract/tg4_stake.wasm",
+			},
+			expMigrated: []types.PoEContractType{
+				types.PoEContractTypeEngagement,
+				types.PoEContractTypeDistribution,
+				types.PoEContractTypeStaking,
+			},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, example, _ := setupPoEContracts(t)
+			contractKeeper := example.TWasmKeeper.GetContractKeeper()
+			valVotingAddr, err := example.PoEKeeper.GetPoEContractAddress(ctx, types.PoEContractTypeValidatorVoting)
+			require.NoError(t, err)
+
+			codeIDs := make(map[types.PoEContractType]uint64)
+			types.IteratePoEContractTypes(func(tp types.PoEContractType) bool {
+				addr, err := example.PoEKeeper.GetPoEContractAddress(ctx, tp)
+				require.NoError(t, err)
+				codeIDs[tp] = example.TWasmKeeper.GetContractInfo(ctx, addr).CodeID
+				return false
+			})
+
+			// and contracts moved to other code versions
+			for tp, file := range spec.outdated {
+				code, err := os.ReadFile(file)
+				require.NoError(t, err)
+				// a custom section modifies the checksum but not the contract
+				code = append(code, 0x00, 0x05, 0x04, 't', 'e', 's', 't')
+				otherCodeID, _, err := contractKeeper.Create(ctx, valVotingAddr, code, &wasmtypes.AllowEverybody)
+				require.NoError(t, err)
+				addr, err := example.PoEKeeper.GetPoEContractAddress(ctx, tp)
+				require.NoError(t, err)
+				_, err = contractKeeper.Migrate(ctx, addr, valVotingAddr, otherCodeID, []byte("{}"))
+				require.NoError(t, err)
+			}
+
+			// when
+			gotErr := poe.MigratePoEContracts(ctx, contractKeeper, example.TWasmKeeper, example.PoEKeeper, nil)
+
+			// then
+			require.NoError(t, gotErr)
+			migrated := make(map[types.PoEContractType]struct{})
+			for _, tp := range spec.expMigrated {
+				migrated[tp] = struct{}{}
+			}
+			types.IteratePoEContractTypes(func(tp types.PoEContractType) bool {
+				addr, err := example.PoEKeeper.GetPoEContractAddress(ctx, tp)
+				require.NoError(t, err)
+				codeID := example.TWasmKeeper.GetContractInfo(ctx, addr).CodeID
+				if _, ok := migrated[tp]; ok {
+					assert.Greater(t, codeID, codeIDs[tp], tp.String())
+				} else {
+					assert.Equal(t, codeIDs[tp], codeID, tp.String())
+				}
+				assert.True(t, example.TWasmKeeper.IsPinnedCode(ctx, codeID), tp.String())
+				return false
+			})
+			engagementAddr, err := example.PoEKeeper.GetPoEContractAddress(ctx, types.PoEContractTypeEngagement)
+			require.NoError(t, err)
+			distributionAddr, err := example.PoEKeeper.GetPoEContractAddress(ctx, types.PoEContractTypeDistribution)
+			require.NoError(t, err)
+			assert.Equal(t, example.TWasmKeeper.GetContractInfo(ctx, engagementAddr).CodeID, example.TWasmKeeper.GetContractInfo(ctx, distributionAddr).CodeID)
+
+			stakingAddr, err := example.PoEKeeper.GetPoEContractAddress(ctx, types.PoEContractTypeStaking)
+			require.NoError(t, err)
+			assert.True(t, example.TWasmKeeper.IsPrivileged(ctx, stakingAddr))
+			ok, err := example.TWasmKeeper.HasPrivilegedContract(ctx, stakingAddr, twasmtypes.PrivilegeDelegator)
+			require.NoError(t, err)
+			assert.True(t, ok)
+			require.NoError(t, poe.VerifyPoEContracts(ctx, example.TWasmKeeper, example.PoEKeeper))
+		})
+	}
+}
+
+func TestMigratePoEContractsWithMigrateMsg(t *testing.T) {
+	ctx, example, _ := setupPoEContracts(t)
+	contractKeeper := example.TWasmKeeper.GetContractKeeper()
+	valVotingAddr, err := example.PoEKeeper.GetPoEContractAddress(ctx, types.PoEContractTypeValidatorVoting)
+	require.NoError(t, err)
+	code, err := os.ReadFile("contract/tg4_mixer.wasm")
+	require.NoError(t, err)
+	otherCodeID, _, err := contractKeeper.Create(ctx, valVotingAddr, append(code, 0x00, 0x05, 0x04, 't', 'e', 's', 't'), &wasmtypes.AllowEverybody)
+	require.NoError(t, err)
+	mixerAddr, err := example.PoEKeeper.GetPoEContractAddress(ctx, types.PoEContractTypeMixer)
+	require.NoError(t, err)
+	_, err = contractKeeper.Migrate(ctx, mixerAddr, valVotingAddr, otherCodeID, []byte("{}"))
+	require.NoError(t, err)
+
+	// when
+	gotErr := poe.MigratePoEContracts(ctx, contractKeeper, example.TWasmKeeper, example.PoEKeeper, poe.ContractMigrateMsgs{
+		types.PoEContractTypeMixer: "not an object",
+	})
+
+	// then the contract rejects the message
+	require.Error(t, gotErr)
+}
+
+func TestMigratePoEContractsUpdatesPrivileges(t *testing.T) {
+	ctx, example, _ := setupPoEContracts(t)
+	contractKeeper := example.TWasmKeeper.GetContractKeeper()
+	valVotingAddr, err := example.PoEKeeper.GetPoEContractAddress(ctx, types.PoEContractTypeValidatorVoting)
+	require.NoError(t, err)
+	stakingAddr, err := example.PoEKeeper.GetPoEContractAddress(ctx, types.PoEContractTypeStaking)
+	require.NoError(t, err)
+	code, err := os.ReadFile("contract/tg4_stake.wasm")
+	require.NoError(t, err)
+	otherCodeID, _, err := contractKeeper.Create(ctx, valVotingAddr, append(code, 0x00, 0x05, 0x04, 't', 'e', 's', 't'), &wasmtypes.AllowEverybody)
+	require.NoError(t, err)
+	_, err = contractKeeper.Migrate(ctx, stakingAddr, valVotingAddr, otherCodeID, []byte("{}"))
+	require.NoError(t, err)
+	// and the outdated code registered a privilege that the embedded code does not request
+	h := twasmkeeper.NewTgradeHandler(example.EncodingConfig.Marshaler, example.TWasmKeeper, nil, nil, nil)
+	_, _, err = h.DispatchMsg(ctx, stakingAddr, "", wasmvmtypes.CosmosMsg{Custom: []byte(`{"privilege":{"request":"token_minter"}}`)})
+	require.NoError(t, err)
+	ok, err := example.TWasmKeeper.HasPrivilegedContract(ctx, stakingAddr, twasmtypes.PrivilegeTypeTokenMinter)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	// when
+	gotErr := poe.MigratePoEContracts(ctx, contractKeeper, example.TWasmKeeper, example.PoEKeeper, nil)
+
+	// then
+	require.NoError(t, gotErr)
+	assert.True(t, example.TWasmKeeper.IsPrivileged(ctx, stakingAddr))
+	ok, err = example.TWasmKeeper.HasPrivilegedContract(ctx, stakingAddr, twasmtypes.PrivilegeTypeTokenMinter)
+	require.NoError(t, err)
+	assert.False(t, ok)
+	ok, err = example.TWasmKeeper.HasPrivilegedContract(ctx, stakingAddr, twasmtypes.PrivilegeDelegator)
+	require.NoError(t, err)
+	assert.True(t, ok)
+}
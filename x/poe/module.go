@@ -120,6 +120,7 @@ type twasmKeeper interface {
 	HasPrivilegedContract(ctx sdk.Context, contractAddr sdk.AccAddress, privilegeType twasmtypes.PrivilegeType) (bool, error)
 	IsPinnedCode(ctx sdk.Context, codeID uint64) bool
 	GetContractInfo(ctx sdk.Context, contractAddress sdk.AccAddress) *wasmtypes.ContractInfo
+	IterateContractState(ctx sdk.Context, contractAddress sdk.AccAddress, cb func(key, value []byte) bool)
 }
 
 // NewAppModule creates a new AppModule object
@@ -137,6 +138,7 @@ func NewAppModule(poeKeeper *keeper.Keeper, twasmKeeper twasmKeeper, bankKeeper
 }
 
 func (am AppModule) RegisterInvariants(registry sdk.InvariantRegistry) {
+	RegisterInvariants(registry, am.twasmKeeper, am.poeKeeper, am.bankKeeper)
 }
 
 func (am AppModule) Route() sdk.Route {
@@ -159,6 +161,11 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	stakingtypes.RegisterQueryServer(cfg.QueryServer(), keeper.NewLegacyStakingGRPCQuerier(am.poeKeeper))
 	slashingtypes.RegisterQueryServer(cfg.QueryServer(), keeper.NewLegacySlashingGRPCQuerier(am.poeKeeper))
 	distributiontypes.RegisterQueryServer(cfg.QueryServer(), keeper.NewLegacyDistributionGRPCQuerier(am.poeKeeper))
+
+	m := keeper.NewMigrator(am.poeKeeper)
+	if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(err)
+	}
 }
 
 func (am AppModule) BeginBlock(ctx sdk.Context, block abci.RequestBeginBlock) {
@@ -166,7 +173,6 @@ func (am AppModule) BeginBlock(ctx sdk.Context, block abci.RequestBeginBlock) {
 }
 
 func (am AppModule) EndBlock(ctx sdk.Context, block abci.RequestEndBlock) []abci.ValidatorUpdate {
-	ClearEmbeddedContracts() // release memory
 	return EndBlocker(ctx, am.twasmKeeper)
 }
 
@@ -244,7 +250,7 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 // introduced by the module. To avoid wrong/empty versions, the initial version
 // should be set to 1.
 func (am AppModule) ConsensusVersion() uint64 {
-	return 1
+	return 2
 }
 
 // GenerateGenesisState creates a randomized GenState of the PoE module.
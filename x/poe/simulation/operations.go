@@ -23,10 +23,13 @@ import (
 //
 //nolint:gosec
 const (
-	OpWeightMsgCreateValidator = "op_weight_msg_create_validator"
-	OpWeightMsgUpdateValidator = "op_weight_msg_update_validator"
-	OpWeightMsgDelegate        = "op_weight_msg_delegate"
-	OpWeightMsgUndelegate      = "op_weight_msg_undelegate"
+	OpWeightMsgCreateValidator    = "op_weight_msg_create_validator"
+	OpWeightMsgUpdateValidator    = "op_weight_msg_update_validator"
+	OpWeightMsgDelegate           = "op_weight_msg_delegate"
+	OpWeightMsgUndelegate         = "op_weight_msg_undelegate"
+	OpWeightMsgWithdrawRewards    = "op_weight_msg_withdraw_rewards"
+	OpWeightMsgUnjail             = "op_weight_msg_unjail"
+	OpWeightMsgSetWithdrawAddress = "op_weight_msg_set_withdraw_address"
 )
 
 // BankKeeper extended bank keeper used by simulations
@@ -46,10 +49,13 @@ type poeKeeper interface {
 // WeightedOperations returns all the operations from the module with their respective weights
 func WeightedOperations(appParams simtypes.AppParams, cdc codec.JSONCodec, bk BankKeeper, ak types.AccountKeeper, k poeKeeper) simulation.WeightedOperations {
 	var (
-		weightMsgCreateValidator int
-		weightMsgUpdateValidator int
-		weightMsgDelegate        int
-		weightMsgUndelegate      int
+		weightMsgCreateValidator    int
+		weightMsgUpdateValidator    int
+		weightMsgDelegate           int
+		weightMsgUndelegate         int
+		weightMsgWithdrawRewards    int
+		weightMsgUnjail             int
+		weightMsgSetWithdrawAddress int
 	)
 
 	appParams.GetOrGenerate(cdc, OpWeightMsgCreateValidator, &weightMsgCreateValidator, nil,
@@ -76,6 +82,24 @@ func WeightedOperations(appParams simtypes.AppParams, cdc codec.JSONCodec, bk Ba
 		},
 	)
 
+	appParams.GetOrGenerate(cdc, OpWeightMsgWithdrawRewards, &weightMsgWithdrawRewards, nil,
+		func(_ *rand.Rand) {
+			weightMsgWithdrawRewards = params.DefaultWeightMsgWithdrawRewards
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgUnjail, &weightMsgUnjail, nil,
+		func(_ *rand.Rand) {
+			weightMsgUnjail = params.DefaultWeightMsgUnjail
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgSetWithdrawAddress, &weightMsgSetWithdrawAddress, nil,
+		func(_ *rand.Rand) {
+			weightMsgSetWithdrawAddress = params.DefaultWeightMsgSetWithdrawAddress
+		},
+	)
+
 	return simulation.WeightedOperations{
 		simulation.NewWeightedOperation(
 			weightMsgCreateValidator,
@@ -93,6 +117,18 @@ func WeightedOperations(appParams simtypes.AppParams, cdc codec.JSONCodec, bk Ba
 			weightMsgUndelegate,
 			SimulateMsgUndelegate(bk, ak, k),
 		),
+		simulation.NewWeightedOperation(
+			weightMsgWithdrawRewards,
+			SimulateMsgWithdrawRewards(bk, ak, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgUnjail,
+			SimulateMsgUnjail(bk, ak, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgSetWithdrawAddress,
+			SimulateMsgSetWithdrawAddress(bk, ak, k),
+		),
 	}
 }
 
@@ -279,6 +315,77 @@ func SimulateMsgUndelegate(bk BankKeeper, ak types.AccountKeeper, k poeKeeper) s
 	}
 }
 
+// SimulateMsgWithdrawRewards generates a MsgWithdrawRewards with random values
+func SimulateMsgWithdrawRewards(bk BankKeeper, ak types.AccountKeeper, k poeKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		_, valAddr, err := getRandValidator(ctx, k)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgWithdrawRewards, "cannot fetch random validator"), nil, err
+		}
+
+		simAccount, found := simtypes.FindAccount(accs, valAddr)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgWithdrawRewards, "unable to find account"), nil, fmt.Errorf("validator %s not found", valAddr.String())
+		}
+
+		// at least one rewards source must be selected
+		distribution := r.Intn(2) == 0
+		engagement := !distribution || r.Intn(2) == 0
+
+		msg := types.NewMsgWithdrawRewards(valAddr, distribution, engagement)
+		txCtx := BuildOperationInput(r, app, ctx, msg, simAccount, ak, bk, nil)
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// SimulateMsgUnjail generates a MsgUnjail for a random jailed validator
+func SimulateMsgUnjail(bk BankKeeper, ak types.AccountKeeper, k poeKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		val, valAddr, err := getRandValidator(ctx, k)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgUnjail, "cannot fetch random validator"), nil, err
+		}
+		if !val.IsJailed() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgUnjail, "validator is not jailed"), nil, nil
+		}
+
+		simAccount, found := simtypes.FindAccount(accs, valAddr)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgUnjail, "unable to find account"), nil, fmt.Errorf("validator %s not found", valAddr.String())
+		}
+
+		msg := types.NewMsgUnjail(valAddr)
+		txCtx := BuildOperationInput(r, app, ctx, msg, simAccount, ak, bk, nil)
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// SimulateMsgSetWithdrawAddress generates a MsgSetWithdrawAddress with random values
+func SimulateMsgSetWithdrawAddress(bk BankKeeper, ak types.AccountKeeper, k poeKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		_, valAddr, err := getRandValidator(ctx, k)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSetWithdrawAddress, "cannot fetch random validator"), nil, err
+		}
+
+		simAccount, found := simtypes.FindAccount(accs, valAddr)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSetWithdrawAddress, "unable to find account"), nil, fmt.Errorf("validator %s not found", valAddr.String())
+		}
+
+		withdrawAccount, _ := simtypes.RandomAcc(r, accs)
+		msg := types.NewMsgSetWithdrawAddress(valAddr, withdrawAccount.Address)
+		txCtx := BuildOperationInput(r, app, ctx, msg, simAccount, ak, bk, nil)
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
 func getRandValidator(ctx sdk.Context, k poeKeeper) (stakingtypes.Validator, sdk.AccAddress, error) {
 	validators, _, err := k.ValsetContract(ctx).ListValidators(ctx, nil)
 	if len(validators) == 0 || err != nil {
@@ -18,8 +18,15 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateValidator{}, "tgrade/MsgUpdateValidator", nil)
 	cdc.RegisterConcrete(&MsgDelegate{}, "tgrade/MsgDelegate", nil)
 	cdc.RegisterConcrete(&MsgUndelegate{}, "tgrade/MsgUndelegate", nil)
+	cdc.RegisterConcrete(&MsgWithdrawRewards{}, "tgrade/MsgWithdrawRewards", nil)
+	cdc.RegisterConcrete(&MsgUnjail{}, "tgrade/MsgUnjail", nil)
+	cdc.RegisterConcrete(&MsgSetWithdrawAddress{}, "tgrade/MsgSetWithdrawAddress", nil)
 }
 
+// TxExtensionOptionI is the interface of TX extension options. The types must be registered with the interface
+// registry so that the TX decoder can resolve them.
+type TxExtensionOptionI interface{}
+
 // RegisterInterfaces registers the x/poe interfaces types with the interface registry
 func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
@@ -27,7 +34,12 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgUpdateValidator{},
 		&MsgDelegate{},
 		&MsgUndelegate{},
+		&MsgWithdrawRewards{},
+		&MsgUnjail{},
+		&MsgSetWithdrawAddress{},
 	)
+	registry.RegisterInterface("confio.poe.v1beta1.TxExtensionOptionI", (*TxExtensionOptionI)(nil))
+	registry.RegisterImplementations((*TxExtensionOptionI)(nil), &FeeSponsorExtension{})
 	stakingtypes.RegisterInterfaces(registry)
 	slashingtypes.RegisterInterfaces(registry)
 	distributiontypes.RegisterInterfaces(registry)
@@ -0,0 +1,45 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFeeSponsorExtensionEncoding(t *testing.T) {
+	registry := codectypes.NewInterfaceRegistry()
+	RegisterInterfaces(registry)
+	txConfig := authtx.NewTxConfig(codec.NewProtoCodec(registry), authtx.DefaultSignModes)
+
+	myContractAddr := RandomAccAddress()
+	ext, err := codectypes.NewAnyWithValue(&FeeSponsorExtension{Contract: myContractAddr.String()})
+	require.NoError(t, err)
+	txBuilder := txConfig.NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(NewMsgUnjail(RandomAccAddress())))
+	txBuilder.(authtx.ExtensionOptionsTxBuilder).SetExtensionOptions(ext)
+
+	bz, err := txConfig.TxEncoder()(txBuilder.GetTx())
+	require.NoError(t, err)
+
+	// when
+	gotTx, err := txConfig.TxDecoder()(bz)
+
+	// then
+	require.NoError(t, err)
+	gotOpts := gotTx.(ante.HasExtensionOptionsTx).GetExtensionOptions()
+	require.Len(t, gotOpts, 1)
+	var gotExt FeeSponsorExtension
+	require.NoError(t, gotExt.Unmarshal(gotOpts[0].Value))
+	assert.Equal(t, myContractAddr.String(), gotExt.Contract)
+
+	// and json
+	jsonBz, err := txConfig.TxJSONEncoder()(txBuilder.GetTx())
+	require.NoError(t, err)
+	_, err = txConfig.TxJSONDecoder()(jsonBz)
+	require.NoError(t, err)
+}
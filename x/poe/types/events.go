@@ -2,13 +2,25 @@ package types
 
 // staking module event types
 const (
-	EventTypeCreateValidator = "create_validator"
-	EventTypeUpdateValidator = "update_validator"
-	EventTypeDelegate        = "delegate"
-	EventTypeUndelegate      = "undelegate"
+	EventTypeCreateValidator    = "create_validator"
+	EventTypeUpdateValidator    = "update_validator"
+	EventTypeDelegate           = "delegate"
+	EventTypeUndelegate         = "undelegate"
+	EventTypeWithdrawRewards    = "withdraw_rewards"
+	EventTypeUnjail             = "unjail"
+	EventTypeSetWithdrawAddress = "set_withdraw_address"
+	EventTypeDistributeFee      = "distribute_fee"
 
-	AttributeKeyValOperator = "operator"
-	AttributeKeyMoniker     = "moniker"
-	AttributeKeyPubKeyHex   = "pubkey"
-	AttributeValueCategory  = ModuleName
+	AttributeKeyValOperator    = "operator"
+	AttributeKeyMoniker        = "moniker"
+	AttributeKeyPubKeyHex      = "pubkey"
+	AttributeKeyOwner          = "owner"
+	AttributeKeyRewardsSource  = "source"
+	AttributeKeyWithdrawAddr   = "withdraw_address"
+	AttributeKeyValsetFee      = "valset"
+	AttributeKeyCommunityPool  = "community_pool"
+	AttributeKeyBurnedFee      = "burn"
+	AttributeValueCategory     = ModuleName
+	AttributeValueDistribution = "distribution"
+	AttributeValueEngagement   = "engagement"
 )
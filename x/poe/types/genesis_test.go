@@ -61,6 +61,29 @@ func TestValidateGenesis(t *testing.T) {
 			}),
 			expErr: true,
 		},
+		"fee split with community pool and burn share": {
+			source: GenesisStateFixture(func(m *GenesisState) {
+				m.Params.FeeSplit = FeeSplit{Valset: sdk.NewDecWithPrec(5, 1), CommunityPool: sdk.NewDecWithPrec(3, 1), Burn: sdk.NewDecWithPrec(2, 1)}
+			}),
+		},
+		"fee split shares not summing up to 1": {
+			source: GenesisStateFixture(func(m *GenesisState) {
+				m.Params.FeeSplit = FeeSplit{Valset: sdk.NewDecWithPrec(5, 1), CommunityPool: sdk.NewDecWithPrec(3, 1), Burn: sdk.NewDecWithPrec(1, 1)}
+			}),
+			expErr: true,
+		},
+		"fee split with negative share": {
+			source: GenesisStateFixture(func(m *GenesisState) {
+				m.Params.FeeSplit = FeeSplit{Valset: sdk.NewDecWithPrec(12, 1), CommunityPool: sdk.ZeroDec(), Burn: sdk.NewDecWithPrec(-2, 1)}
+			}),
+			expErr: true,
+		},
+		"fee split not set": {
+			source: GenesisStateFixture(func(m *GenesisState) {
+				m.Params.FeeSplit = FeeSplit{}
+			}),
+			expErr: true,
+		},
 		"empty bond denum": {
 			source: GenesisStateFixture(func(m *GenesisState) {
 				m.GetSeedContracts().BondDenom = ""
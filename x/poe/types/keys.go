@@ -19,6 +19,9 @@ const (
 
 // nolint
 var (
-	ContractPrefix    = []byte{0x01}
-	HistoricalInfoKey = []byte{0x02}
+	ContractPrefix                = []byte{0x01}
+	HistoricalInfoKey             = []byte{0x02}
+	HistoricalInfoValsetRefKey    = []byte{0x03}
+	HistoricalValsetKey           = []byte{0x04}
+	HistoricalValsetRefCounterKey = []byte{0x05}
 )
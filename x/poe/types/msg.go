@@ -9,15 +9,23 @@ import (
 )
 
 const (
-	TypeMsgCreateValidator = "create_validator"
-	TypeMsgUpdateValidator = "update_validator"
-	TypeMsgUndelegate      = "begin_unbonding"
-	TypeMsgDelegate        = "delegate"
+	TypeMsgCreateValidator    = "create_validator"
+	TypeMsgUpdateValidator    = "update_validator"
+	TypeMsgUndelegate         = "begin_unbonding"
+	TypeMsgDelegate           = "delegate"
+	TypeMsgWithdrawRewards    = "withdraw_rewards"
+	TypeMsgUnjail             = "unjail"
+	TypeMsgSetWithdrawAddress = "set_withdraw_address"
 )
 
 var (
 	_ sdk.Msg = &MsgCreateValidator{}
 	_ sdk.Msg = &MsgUpdateValidator{}
+	_ sdk.Msg = &MsgDelegate{}
+	_ sdk.Msg = &MsgUndelegate{}
+	_ sdk.Msg = &MsgWithdrawRewards{}
+	_ sdk.Msg = &MsgUnjail{}
+	_ sdk.Msg = &MsgSetWithdrawAddress{}
 )
 
 // NewMsgCreateValidator creates a new MsgCreateValidator instance.
@@ -258,3 +266,120 @@ func (msg MsgUndelegate) ValidateBasic() error {
 
 	return nil
 }
+
+// NewMsgWithdrawRewards constructor
+func NewMsgWithdrawRewards(owner sdk.AccAddress, distribution, engagement bool) *MsgWithdrawRewards {
+	return &MsgWithdrawRewards{
+		Owner:        owner.String(),
+		Distribution: distribution,
+		Engagement:   engagement,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgWithdrawRewards) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgWithdrawRewards) Type() string { return TypeMsgWithdrawRewards }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgWithdrawRewards) GetSigners() []sdk.AccAddress {
+	ownerAddr, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{ownerAddr}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgWithdrawRewards) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgWithdrawRewards) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return sdkerrors.Wrap(ErrEmpty, "owner address")
+	}
+	if !msg.Distribution && !msg.Engagement {
+		return sdkerrors.Wrap(ErrEmpty, "rewards source")
+	}
+	return nil
+}
+
+// NewMsgUnjail constructor
+func NewMsgUnjail(opAddr sdk.AccAddress) *MsgUnjail {
+	return &MsgUnjail{
+		OperatorAddress: opAddr.String(),
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgUnjail) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgUnjail) Type() string { return TypeMsgUnjail }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgUnjail) GetSigners() []sdk.AccAddress {
+	opAddr, err := sdk.AccAddressFromBech32(msg.OperatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{opAddr}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgUnjail) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgUnjail) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.OperatorAddress); err != nil {
+		return sdkerrors.Wrap(ErrEmpty, "operator address")
+	}
+	return nil
+}
+
+// NewMsgSetWithdrawAddress constructor
+func NewMsgSetWithdrawAddress(owner, withdrawAddr sdk.AccAddress) *MsgSetWithdrawAddress {
+	return &MsgSetWithdrawAddress{
+		Owner:           owner.String(),
+		WithdrawAddress: withdrawAddr.String(),
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgSetWithdrawAddress) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgSetWithdrawAddress) Type() string { return TypeMsgSetWithdrawAddress }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgSetWithdrawAddress) GetSigners() []sdk.AccAddress {
+	ownerAddr, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{ownerAddr}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgSetWithdrawAddress) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgSetWithdrawAddress) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return sdkerrors.Wrap(ErrEmpty, "owner address")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.WithdrawAddress); err != nil {
+		return sdkerrors.Wrap(ErrInvalid, "withdraw address")
+	}
+	return nil
+}
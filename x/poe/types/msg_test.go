@@ -19,6 +19,7 @@ var (
 	coinZero    = sdk.NewInt64Coin(sdk.DefaultBondDenom, 0)
 	pk1         = ed25519.GenPrivKey().PubKey()
 	valAddr1    = sdk.AccAddress(pk1.Address())
+	valAddr2    = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
 	emptyAddr   sdk.AccAddress
 	emptyPubkey cryptotypes.PubKey
 )
@@ -191,3 +192,71 @@ func TestMsgUndelegate(t *testing.T) {
 		}
 	}
 }
+
+func TestMsgWithdrawRewards(t *testing.T) {
+	tests := []struct {
+		name         string
+		owner        sdk.AccAddress
+		distribution bool
+		engagement   bool
+		expectPass   bool
+	}{
+		{"distribution and engagement", sdk.AccAddress(valAddr1), true, true, true},
+		{"distribution only", sdk.AccAddress(valAddr1), true, false, true},
+		{"engagement only", sdk.AccAddress(valAddr1), false, true, true},
+		{"no rewards source", sdk.AccAddress(valAddr1), false, false, false},
+		{"empty owner", sdk.AccAddress(emptyAddr), true, true, false},
+	}
+
+	for _, tc := range tests {
+		msg := NewMsgWithdrawRewards(tc.owner, tc.distribution, tc.engagement)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		}
+	}
+}
+
+func TestMsgUnjail(t *testing.T) {
+	tests := []struct {
+		name         string
+		operatorAddr sdk.AccAddress
+		expectPass   bool
+	}{
+		{"regular", sdk.AccAddress(valAddr1), true},
+		{"empty operator", sdk.AccAddress(emptyAddr), false},
+	}
+
+	for _, tc := range tests {
+		msg := NewMsgUnjail(tc.operatorAddr)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		}
+	}
+}
+
+func TestMsgSetWithdrawAddress(t *testing.T) {
+	tests := []struct {
+		name         string
+		owner        sdk.AccAddress
+		withdrawAddr sdk.AccAddress
+		expectPass   bool
+	}{
+		{"regular", sdk.AccAddress(valAddr1), sdk.AccAddress(valAddr2), true},
+		{"same address", sdk.AccAddress(valAddr1), sdk.AccAddress(valAddr1), true},
+		{"empty owner", sdk.AccAddress(emptyAddr), sdk.AccAddress(valAddr2), false},
+		{"empty withdraw address", sdk.AccAddress(valAddr1), sdk.AccAddress(emptyAddr), false},
+	}
+
+	for _, tc := range tests {
+		msg := NewMsgSetWithdrawAddress(tc.owner, tc.withdrawAddr)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		}
+	}
+}
@@ -24,6 +24,7 @@ var (
 	KeyHistoricalEntries          = []byte("HistoricalEntries")
 	KeyInitialValEngagementPoints = []byte("InitialValidatorEngagementPoints")
 	KeyMinDelegationAmounts       = []byte("MinDelegationAmounts")
+	KeyFeeSplit                   = []byte("FeeSplit")
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
@@ -34,11 +35,12 @@ func ParamKeyTable() paramtypes.KeyTable {
 }
 
 // NewParams creates a new Params instance
-func NewParams(historicalEntries uint32, engagementPoints uint64, min sdk.Coins) Params {
+func NewParams(historicalEntries uint32, engagementPoints uint64, min sdk.Coins, feeSplit FeeSplit) Params {
 	return Params{
 		HistoricalEntries:          historicalEntries,
 		InitialValEngagementPoints: engagementPoints,
 		MinDelegationAmounts:       min,
+		FeeSplit:                   feeSplit,
 	}
 }
 
@@ -48,6 +50,7 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyHistoricalEntries, &p.HistoricalEntries, validateUint32),
 		paramtypes.NewParamSetPair(KeyInitialValEngagementPoints, &p.InitialValEngagementPoints, validateUint64),
 		paramtypes.NewParamSetPair(KeyMinDelegationAmounts, &p.MinDelegationAmounts, validateSDKCoins),
+		paramtypes.NewParamSetPair(KeyFeeSplit, &p.FeeSplit, validateFeeSplit),
 	}
 }
 
@@ -57,9 +60,19 @@ func DefaultParams() Params {
 		DefaultHistoricalEntries,
 		DefaultInitialValidatorEngagementPoints,
 		sdk.Coins{},
+		DefaultFeeSplit(),
 	)
 }
 
+// DefaultFeeSplit returns the fee split that sends all fees to the valset contract
+func DefaultFeeSplit() FeeSplit {
+	return FeeSplit{
+		Valset:        sdk.OneDec(),
+		CommunityPool: sdk.ZeroDec(),
+		Burn:          sdk.ZeroDec(),
+	}
+}
+
 // String returns a human-readable string representation of the parameters.
 func (p Params) String() string {
 	out, err := yaml.Marshal(p)
@@ -71,7 +84,37 @@ func (p Params) String() string {
 
 // Validate validate a set of params
 func (p Params) Validate() error {
-	return sdkerrors.Wrap(p.MinDelegationAmounts.Validate(), "min delegation amounts")
+	if err := p.MinDelegationAmounts.Validate(); err != nil {
+		return sdkerrors.Wrap(err, "min delegation amounts")
+	}
+	return sdkerrors.Wrap(p.FeeSplit.Validate(), "fee split")
+}
+
+// Validate validates that the shares are not negative and sum up to 1
+func (f FeeSplit) Validate() error {
+	for _, v := range []struct {
+		name  string
+		share sdk.Dec
+	}{{"valset", f.Valset}, {"community pool", f.CommunityPool}, {"burn", f.Burn}} {
+		if v.share.IsNil() || v.share.IsNegative() {
+			return sdkerrors.Wrapf(ErrInvalid, "%s share must not be negative", v.name)
+		}
+	}
+	if sum := f.Valset.Add(f.CommunityPool).Add(f.Burn); !sum.Equal(sdk.OneDec()) {
+		return sdkerrors.Wrapf(ErrInvalid, "shares must sum up to 1 but got %s", sum)
+	}
+	return nil
+}
+
+// Split returns the community pool and burn portions of the fees. The valset contract receives the remainder.
+func (f FeeSplit) Split(fees sdk.Coins) (valset, communityPool, burn sdk.Coins) {
+	communityPool, burn = sdk.NewCoins(), sdk.NewCoins()
+	for _, c := range fees {
+		amount := sdk.NewDecFromInt(c.Amount)
+		communityPool = communityPool.Add(sdk.NewCoin(c.Denom, amount.Mul(f.CommunityPool).TruncateInt()))
+		burn = burn.Add(sdk.NewCoin(c.Denom, amount.Mul(f.Burn).TruncateInt()))
+	}
+	return fees.Sub(communityPool).Sub(burn), communityPool, burn
 }
 
 func validateUint64(i interface{}) error {
@@ -90,6 +133,14 @@ func validateUint32(i interface{}) error {
 	return nil
 }
 
+func validateFeeSplit(i interface{}) error {
+	f, ok := i.(FeeSplit)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return f.Validate()
+}
+
 func validateSDKCoins(i interface{}) error {
 	c, ok := i.(sdk.Coins)
 	if !ok {
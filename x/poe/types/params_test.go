@@ -0,0 +1,56 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFeeSplitSplit(t *testing.T) {
+	specs := map[string]struct {
+		split            FeeSplit
+		fees             sdk.Coins
+		expValset        sdk.Coins
+		expCommunityPool sdk.Coins
+		expBurn          sdk.Coins
+	}{
+		"default": {
+			split:            DefaultFeeSplit(),
+			fees:             sdk.NewCoins(sdk.NewCoin("ALX", sdk.NewInt(10))),
+			expValset:        sdk.NewCoins(sdk.NewCoin("ALX", sdk.NewInt(10))),
+			expCommunityPool: sdk.NewCoins(),
+			expBurn:          sdk.NewCoins(),
+		},
+		"all shares": {
+			split:            FeeSplit{Valset: sdk.NewDecWithPrec(5, 1), CommunityPool: sdk.NewDecWithPrec(3, 1), Burn: sdk.NewDecWithPrec(2, 1)},
+			fees:             sdk.NewCoins(sdk.NewCoin("ALX", sdk.NewInt(10)), sdk.NewCoin("BLX", sdk.NewInt(20))),
+			expValset:        sdk.NewCoins(sdk.NewCoin("ALX", sdk.NewInt(5)), sdk.NewCoin("BLX", sdk.NewInt(10))),
+			expCommunityPool: sdk.NewCoins(sdk.NewCoin("ALX", sdk.NewInt(3)), sdk.NewCoin("BLX", sdk.NewInt(6))),
+			expBurn:          sdk.NewCoins(sdk.NewCoin("ALX", sdk.NewInt(2)), sdk.NewCoin("BLX", sdk.NewInt(4))),
+		},
+		"remainder to valset": {
+			split:            FeeSplit{Valset: sdk.ZeroDec(), CommunityPool: sdk.NewDecWithPrec(5, 1), Burn: sdk.NewDecWithPrec(5, 1)},
+			fees:             sdk.NewCoins(sdk.NewCoin("ALX", sdk.NewInt(3))),
+			expValset:        sdk.NewCoins(sdk.NewCoin("ALX", sdk.NewInt(1))),
+			expCommunityPool: sdk.NewCoins(sdk.NewCoin("ALX", sdk.NewInt(1))),
+			expBurn:          sdk.NewCoins(sdk.NewCoin("ALX", sdk.NewInt(1))),
+		},
+		"burn all": {
+			split:            FeeSplit{Valset: sdk.ZeroDec(), CommunityPool: sdk.ZeroDec(), Burn: sdk.OneDec()},
+			fees:             sdk.NewCoins(sdk.NewCoin("ALX", sdk.NewInt(3))),
+			expValset:        sdk.NewCoins(),
+			expCommunityPool: sdk.NewCoins(),
+			expBurn:          sdk.NewCoins(sdk.NewCoin("ALX", sdk.NewInt(3))),
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			gotValset, gotCommunityPool, gotBurn := spec.split.Split(spec.fees)
+			assert.Equal(t, spec.expValset.String(), gotValset.String())
+			assert.Equal(t, spec.expCommunityPool.String(), gotCommunityPool.String())
+			assert.Equal(t, spec.expBurn.String(), gotBurn.String())
+			assert.Equal(t, spec.fees, gotValset.Add(gotCommunityPool...).Add(gotBurn...))
+		})
+	}
+}
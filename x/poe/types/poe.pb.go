@@ -102,6 +102,9 @@ type Params struct {
 	// needs to self delegate to receive any engagement points. One must be
 	// exceeded. No minimum condition set when empty.
 	MinDelegationAmounts github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=min_delegation_amounts,json=minDelegationAmounts,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"min_delegation_amounts" yaml:"min_delegation_amounts"`
+	// FeeSplit defines the shares of the collected TX fees that go to the valset
+	// contract, the community pool contract and are burned
+	FeeSplit FeeSplit `protobuf:"bytes,4,opt,name=fee_split,json=feeSplit,proto3" json:"fee_split" yaml:"fee_split"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -162,66 +165,131 @@ func (m *Params) GetMinDelegationAmounts() github_com_cosmos_cosmos_sdk_types.Co
 	return nil
 }
 
+func (m *Params) GetFeeSplit() FeeSplit {
+	if m != nil {
+		return m.FeeSplit
+	}
+	return FeeSplit{}
+}
+
+// FeeSplit defines the shares of the collected TX fees. The shares must sum
+// up to 1.
+type FeeSplit struct {
+	// Valset share is sent to the valset contract for distribution to the
+	// validators
+	Valset github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=valset,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"valset"`
+	// CommunityPool share is sent to the community pool contract
+	CommunityPool github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=community_pool,json=communityPool,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"community_pool" yaml:"community_pool"`
+	// Burn share is burned
+	Burn github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=burn,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"burn"`
+}
+
+func (m *FeeSplit) Reset()         { *m = FeeSplit{} }
+func (m *FeeSplit) String() string { return proto.CompactTextString(m) }
+func (*FeeSplit) ProtoMessage()    {}
+func (*FeeSplit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df6d9ea68813554a, []int{1}
+}
+
+func (m *FeeSplit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *FeeSplit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeSplit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *FeeSplit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeSplit.Merge(m, src)
+}
+
+func (m *FeeSplit) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *FeeSplit) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeSplit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeSplit proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("confio.poe.v1beta1.PoEContractType", PoEContractType_name, PoEContractType_value)
 	proto.RegisterType((*Params)(nil), "confio.poe.v1beta1.Params")
+	proto.RegisterType((*FeeSplit)(nil), "confio.poe.v1beta1.FeeSplit")
 }
 
 func init() { proto.RegisterFile("confio/poe/v1beta1/poe.proto", fileDescriptor_df6d9ea68813554a) }
 
 var fileDescriptor_df6d9ea68813554a = []byte{
-	// 806 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x54, 0x4f, 0x6f, 0xe3, 0x44,
-	0x1c, 0x4d, 0xfa, 0x6f, 0x77, 0x67, 0x17, 0x30, 0xde, 0xb2, 0x6a, 0x86, 0xc6, 0x1e, 0x42, 0x57,
-	0x44, 0xa0, 0x26, 0x14, 0x38, 0xa0, 0x95, 0x58, 0xc9, 0x69, 0x4c, 0xb0, 0x48, 0xe2, 0xe0, 0xb8,
-	0x11, 0x70, 0xb1, 0x26, 0xc9, 0xd4, 0x1d, 0x35, 0x9e, 0x89, 0x3c, 0x93, 0x6a, 0xf3, 0x0d, 0x90,
-	0x4f, 0x88, 0x13, 0x17, 0x4b, 0x2b, 0xb8, 0xf1, 0x49, 0xf6, 0xb8, 0x47, 0x4e, 0x05, 0xb5, 0x17,
-	0xce, 0xfd, 0x04, 0xc8, 0x7f, 0xd2, 0x2e, 0xde, 0x02, 0x17, 0x7b, 0x7e, 0xf3, 0xde, 0xef, 0xbd,
-	0xd1, 0xfb, 0x8d, 0x06, 0xec, 0x4e, 0x38, 0x3b, 0xa6, 0xbc, 0x39, 0xe7, 0xa4, 0x79, 0x76, 0x30,
-	0x26, 0x12, 0x1f, 0x24, 0xeb, 0xc6, 0x3c, 0xe4, 0x92, 0xab, 0x6a, 0x86, 0x36, 0x92, 0x9d, 0x1c,
-	0x85, 0xdb, 0x3e, 0xf7, 0x79, 0x0a, 0x37, 0x93, 0x55, 0xc6, 0x84, 0x15, 0x9f, 0x73, 0x7f, 0x46,
-	0x9a, 0x69, 0x35, 0x5e, 0x1c, 0x37, 0x31, 0x5b, 0xe6, 0x90, 0x56, 0x84, 0xa6, 0x8b, 0x10, 0x4b,
-	0xca, 0x59, 0x8e, 0xeb, 0x45, 0x5c, 0xd2, 0x80, 0x08, 0x89, 0x83, 0xf9, 0x4a, 0x7b, 0xc2, 0x45,
-	0xc0, 0x85, 0x97, 0x99, 0x66, 0xc5, 0x4a, 0x3b, 0xab, 0x9a, 0x63, 0x2c, 0x6e, 0xce, 0x3f, 0xe1,
-	0x74, 0xa5, 0xbd, 0x97, 0xe3, 0x42, 0xe2, 0x53, 0xca, 0xfc, 0x6b, 0x4a, 0x5e, 0xe7, 0xac, 0x5d,
-	0x49, 0xd8, 0x94, 0x84, 0x01, 0x65, 0xb2, 0x29, 0x97, 0x73, 0x22, 0xb2, 0x6f, 0x86, 0xd6, 0xae,
-	0xd6, 0xc0, 0xd6, 0x00, 0x87, 0x38, 0x10, 0x6a, 0x17, 0xa8, 0x27, 0x54, 0x48, 0x1e, 0xd2, 0x09,
-	0x9e, 0x79, 0x84, 0xc9, 0x90, 0x12, 0xb1, 0x53, 0x46, 0xe5, 0xfa, 0x1b, 0xad, 0xea, 0xd5, 0xb9,
-	0x5e, 0x59, 0xe2, 0x60, 0xf6, 0xa4, 0xf6, 0x3a, 0xa7, 0xe6, 0xbc, 0x7d, 0xb3, 0x69, 0x66, 0x7b,
-	0xea, 0x29, 0xa8, 0x52, 0x46, 0x25, 0xc5, 0x33, 0xef, 0x2c, 0xa5, 0xfa, 0xd8, 0x27, 0x01, 0x61,
-	0xd2, 0x9b, 0x73, 0xca, 0xa4, 0xd8, 0x59, 0x43, 0xe5, 0xfa, 0x46, 0xab, 0x7e, 0x75, 0xae, 0xef,
-	0x65, 0xc2, 0xff, 0x49, 0xaf, 0x39, 0x30, 0xc7, 0x47, 0x89, 0xc7, 0x0a, 0x1d, 0xa4, 0xa0, 0xfa,
-	0x4b, 0x19, 0x3c, 0x0a, 0x28, 0xf3, 0xa6, 0x64, 0x46, 0xfc, 0x34, 0x7e, 0x0f, 0x07, 0x7c, 0x91,
-	0xd8, 0xac, 0xa3, 0xf5, 0xfa, 0xfd, 0x4f, 0x2a, 0x8d, 0x3c, 0xd9, 0x24, 0xcb, 0xd5, 0xb4, 0x1b,
-	0x87, 0x9c, 0xb2, 0xd6, 0x37, 0x2f, 0xce, 0xf5, 0xd2, 0xd5, 0xb9, 0x5e, 0xcd, 0x4e, 0x71, 0xbb,
-	0x4c, 0xed, 0xb7, 0x3f, 0xf4, 0xba, 0x4f, 0xe5, 0xc9, 0x62, 0xdc, 0x98, 0xf0, 0x20, 0x9f, 0x53,
-	0xfe, 0xdb, 0x17, 0xd3, 0xd3, 0x3c, 0xd4, 0x44, 0x51, 0x38, 0xdb, 0x01, 0x65, 0xed, 0x6b, 0x0d,
-	0x23, 0x93, 0x78, 0x72, 0xf7, 0xe7, 0xe7, 0x7a, 0xe9, 0xaf, 0xe7, 0x7a, 0xf9, 0xc3, 0x9f, 0x36,
-	0xc1, 0x5b, 0x03, 0x6e, 0x1e, 0x72, 0x26, 0x43, 0x3c, 0x91, 0xee, 0x72, 0x4e, 0xd4, 0x8f, 0xc0,
-	0xbd, 0xa3, 0x7e, 0xdb, 0xfc, 0xd2, 0xea, 0x9b, 0x6d, 0xa5, 0x04, 0x77, 0xa3, 0x18, 0xed, 0x14,
-	0x38, 0x47, 0x6c, 0x4a, 0x8e, 0x29, 0x23, 0x53, 0xf5, 0x03, 0x70, 0x67, 0xe8, 0x1a, 0x5f, 0x5b,
-	0xfd, 0x8e, 0x52, 0x86, 0x30, 0x8a, 0xd1, 0xa3, 0x02, 0x75, 0x98, 0x5d, 0x01, 0xf5, 0x31, 0xd8,
-	0x1a, 0x19, 0xdd, 0xa1, 0xe9, 0x2a, 0x6b, 0xb0, 0x12, 0xc5, 0xe8, 0x9d, 0x02, 0x6f, 0x84, 0x67,
-	0x82, 0x48, 0x75, 0x1f, 0x00, 0xb3, 0xdf, 0x31, 0x3a, 0x66, 0xcf, 0xec, 0xbb, 0xca, 0x3a, 0xac,
-	0x46, 0x31, 0xaa, 0x14, 0xa8, 0x37, 0xa1, 0xab, 0xef, 0x83, 0xcd, 0x9e, 0xf5, 0xad, 0xe9, 0x28,
-	0x1b, 0x70, 0x27, 0x8a, 0xd1, 0x76, 0x81, 0xd9, 0xa3, 0xcf, 0x48, 0xa8, 0x1e, 0x80, 0x07, 0x6d,
-	0x6b, 0xe8, 0x3a, 0x56, 0xeb, 0xc8, 0xb5, 0xec, 0xbe, 0xb2, 0x09, 0xf5, 0x28, 0x46, 0xef, 0x16,
-	0xb8, 0x6d, 0x2a, 0x64, 0x48, 0xc7, 0x8b, 0x24, 0x28, 0xf5, 0x29, 0x78, 0x68, 0x8f, 0x4c, 0x67,
-	0x68, 0x75, 0xbe, 0x72, 0xbd, 0x43, 0xbb, 0xd7, 0x3b, 0xea, 0x5b, 0xee, 0x77, 0xca, 0x16, 0x7c,
-	0x1c, 0xc5, 0xe8, 0xbd, 0x42, 0xa7, 0x7d, 0x46, 0x42, 0x41, 0xfd, 0x13, 0x79, 0xc8, 0x83, 0x60,
-	0xc1, 0xa8, 0x5c, 0xaa, 0x2e, 0xa8, 0xde, 0xd2, 0xef, 0x0d, 0x1c, 0x7b, 0x60, 0x0f, 0x8d, 0xee,
-	0x50, 0xb9, 0x03, 0x0f, 0xa2, 0x18, 0xed, 0xff, 0xaf, 0x52, 0x87, 0x9f, 0x0d, 0x42, 0x3e, 0xe7,
-	0x02, 0xcf, 0x84, 0xfa, 0x19, 0x78, 0xf3, 0x15, 0x2d, 0xdb, 0xee, 0x2a, 0x77, 0x21, 0x8a, 0x62,
-	0xb4, 0x5b, 0x90, 0xb9, 0xee, 0x1e, 0x70, 0x3e, 0x53, 0x3f, 0x07, 0xca, 0xc8, 0xe8, 0x5a, 0x6d,
-	0xc3, 0xb5, 0x1d, 0x6f, 0x64, 0xbb, 0xc9, 0xac, 0xee, 0xc1, 0x5a, 0x14, 0x23, 0xed, 0xf5, 0x19,
-	0xd0, 0x29, 0x96, 0x3c, 0x1c, 0x71, 0x99, 0xcc, 0xec, 0x63, 0xf0, 0xc0, 0x70, 0x5a, 0x96, 0x6b,
-	0x3a, 0x99, 0x1b, 0x80, 0x5a, 0x14, 0x23, 0x58, 0xe8, 0x32, 0xc2, 0x31, 0x95, 0x24, 0x4c, 0xbd,
-	0xbe, 0x00, 0x0f, 0x5f, 0xed, 0x58, 0xd9, 0xdd, 0x87, 0x7b, 0x51, 0x8c, 0xd0, 0xbf, 0x37, 0x66,
-	0x86, 0x70, 0xe3, 0x87, 0x5f, 0xb5, 0x52, 0xeb, 0xe9, 0x8b, 0x0b, 0xad, 0xfc, 0xf2, 0x42, 0x2b,
-	0xff, 0x79, 0xa1, 0x95, 0x7f, 0xbc, 0xd4, 0x4a, 0x2f, 0x2f, 0xb5, 0xd2, 0xef, 0x97, 0x5a, 0xe9,
-	0xfb, 0xbd, 0x7f, 0x5c, 0xfc, 0xf4, 0x45, 0x95, 0x7e, 0x88, 0xa7, 0xa4, 0xf9, 0x2c, 0x7d, 0x5a,
-	0xd3, 0xab, 0x3f, 0xde, 0x4a, 0x1f, 0x94, 0x4f, 0xff, 0x0e, 0x00, 0x00, 0xff, 0xff, 0xa6, 0x74,
-	0x0b, 0xa9, 0x75, 0x05, 0x00, 0x00,
+	// 928 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xcd, 0x6e, 0xe2, 0xd6,
+	0x17, 0xc7, 0x81, 0x30, 0xe1, 0x66, 0x66, 0xfe, 0xfe, 0x7b, 0x32, 0x23, 0x70, 0xc1, 0x76, 0x69,
+	0xa6, 0x45, 0xad, 0x02, 0xcd, 0xb4, 0x8b, 0x2a, 0x52, 0x47, 0xc2, 0xe0, 0x50, 0xab, 0x80, 0xa9,
+	0x71, 0x50, 0xdb, 0x8d, 0x65, 0xe0, 0xe2, 0x5c, 0xc5, 0xf6, 0x45, 0xf6, 0x05, 0x0d, 0x6f, 0x50,
+	0x59, 0x5d, 0x54, 0x5d, 0x75, 0x63, 0x69, 0xd4, 0xee, 0xfa, 0x22, 0x9d, 0xe5, 0x2c, 0xab, 0x2e,
+	0x68, 0x95, 0x6c, 0xba, 0xce, 0x13, 0x54, 0xfe, 0x20, 0x99, 0x38, 0xd3, 0xcf, 0x0d, 0xf8, 0x9e,
+	0xdf, 0xc7, 0x39, 0x3e, 0xe7, 0xc0, 0x05, 0xe5, 0x09, 0x76, 0x66, 0x08, 0x37, 0xe6, 0x18, 0x36,
+	0x96, 0x87, 0x63, 0x48, 0x8c, 0xc3, 0xf0, 0xb9, 0x3e, 0x77, 0x31, 0xc1, 0x0c, 0x13, 0xa3, 0xf5,
+	0x30, 0x92, 0xa0, 0xec, 0x9e, 0x89, 0x4d, 0x1c, 0xc1, 0x8d, 0xf0, 0x29, 0x66, 0xb2, 0x25, 0x13,
+	0x63, 0xd3, 0x82, 0x8d, 0xe8, 0x34, 0x5e, 0xcc, 0x1a, 0x86, 0xb3, 0x4a, 0x20, 0x2e, 0x0d, 0x4d,
+	0x17, 0xae, 0x41, 0x10, 0x76, 0x12, 0x9c, 0x4f, 0xe3, 0x04, 0xd9, 0xd0, 0x23, 0x86, 0x3d, 0xdf,
+	0x78, 0x4f, 0xb0, 0x67, 0x63, 0x4f, 0x8f, 0x93, 0xc6, 0x87, 0x8d, 0x77, 0x7c, 0x6a, 0x8c, 0x0d,
+	0xef, 0xba, 0xfe, 0x09, 0x46, 0x1b, 0xef, 0xfd, 0x04, 0xf7, 0x88, 0x71, 0x86, 0x1c, 0xf3, 0x8a,
+	0x92, 0x9c, 0x13, 0x56, 0x99, 0x40, 0x67, 0x0a, 0x5d, 0x1b, 0x39, 0xa4, 0x41, 0x56, 0x73, 0xe8,
+	0xc5, 0x9f, 0x31, 0x5a, 0xfd, 0x29, 0x0b, 0xf2, 0x03, 0xc3, 0x35, 0x6c, 0x8f, 0xe9, 0x02, 0xe6,
+	0x14, 0x79, 0x04, 0xbb, 0x68, 0x62, 0x58, 0x3a, 0x74, 0x88, 0x8b, 0xa0, 0x57, 0xa4, 0x04, 0xaa,
+	0x76, 0x4f, 0xac, 0x5c, 0xae, 0xf9, 0xd2, 0xca, 0xb0, 0xad, 0xa3, 0xea, 0x6d, 0x4e, 0x55, 0xfd,
+	0xff, 0x75, 0x50, 0x8a, 0x63, 0xcc, 0x19, 0xa8, 0x20, 0x07, 0x11, 0x64, 0x58, 0xfa, 0x32, 0xa2,
+	0x9a, 0x86, 0x09, 0x6d, 0xe8, 0x10, 0x7d, 0x8e, 0x91, 0x43, 0xbc, 0xe2, 0x96, 0x40, 0xd5, 0x72,
+	0x62, 0xed, 0x72, 0xcd, 0xef, 0xc7, 0xc6, 0x7f, 0x49, 0xaf, 0xaa, 0x6c, 0x82, 0x8f, 0xc2, 0x1c,
+	0x1b, 0x74, 0x10, 0x81, 0xcc, 0xf7, 0x14, 0x78, 0x64, 0x23, 0x47, 0x9f, 0x42, 0x0b, 0x9a, 0x51,
+	0xfb, 0x75, 0xc3, 0xc6, 0x8b, 0x30, 0x4d, 0x56, 0xc8, 0xd6, 0x76, 0x9f, 0x94, 0xea, 0x49, 0x67,
+	0xc3, 0x5e, 0x6e, 0xa6, 0x5d, 0x6f, 0x61, 0xe4, 0x88, 0x9f, 0xbd, 0x58, 0xf3, 0x99, 0xcb, 0x35,
+	0x5f, 0x89, 0xab, 0x78, 0xbd, 0x4d, 0xf5, 0xc7, 0x5f, 0xf9, 0x9a, 0x89, 0xc8, 0xe9, 0x62, 0x5c,
+	0x9f, 0x60, 0x3b, 0x99, 0x53, 0xf2, 0x75, 0xe0, 0x4d, 0xcf, 0x92, 0xa6, 0x86, 0x8e, 0x9e, 0xba,
+	0x67, 0x23, 0xa7, 0x7d, 0xe5, 0xd1, 0x8c, 0x2d, 0x98, 0x21, 0x28, 0xcc, 0x20, 0xd4, 0xbd, 0xb9,
+	0x85, 0x48, 0x31, 0x27, 0x50, 0xb5, 0xdd, 0x27, 0xe5, 0xfa, 0xed, 0x1d, 0xac, 0x1f, 0x43, 0x38,
+	0x0c, 0x39, 0x62, 0x31, 0xa9, 0x8c, 0x8e, 0x2b, 0xbb, 0x12, 0x57, 0xd5, 0x9d, 0x59, 0xc2, 0x39,
+	0xda, 0xf9, 0xee, 0x39, 0x9f, 0xf9, 0xfd, 0x39, 0x4f, 0x55, 0xbf, 0xde, 0x02, 0x3b, 0x1b, 0x29,
+	0x73, 0x0c, 0xf2, 0x4b, 0xc3, 0xf2, 0x20, 0x89, 0xe6, 0x57, 0x10, 0xeb, 0xa1, 0xd5, 0x2f, 0x6b,
+	0xfe, 0xed, 0x7f, 0xf0, 0x0e, 0x6d, 0x38, 0x51, 0x13, 0x35, 0xe3, 0x80, 0xfb, 0x13, 0x6c, 0xdb,
+	0x0b, 0x07, 0x91, 0x95, 0x3e, 0xc7, 0xd8, 0x8a, 0xc6, 0x56, 0x10, 0x3b, 0xff, 0xce, 0xef, 0x72,
+	0xcd, 0x3f, 0x8c, 0x5f, 0xe2, 0xa6, 0x5b, 0x55, 0xbd, 0x77, 0x15, 0x18, 0x60, 0x6c, 0x31, 0x22,
+	0xc8, 0x8d, 0x17, 0xae, 0x53, 0xcc, 0xfe, 0xa7, 0xaa, 0x23, 0xed, 0x51, 0x2e, 0x6c, 0xc7, 0xbb,
+	0xdf, 0x6e, 0x83, 0xff, 0x0d, 0xb0, 0xd4, 0xc2, 0x0e, 0x71, 0x8d, 0x09, 0xd1, 0x56, 0x73, 0xc8,
+	0xbc, 0x07, 0x0a, 0x27, 0xfd, 0xb6, 0x74, 0x2c, 0xf7, 0xa5, 0x36, 0x9d, 0x61, 0xcb, 0x7e, 0x20,
+	0x14, 0x53, 0x9c, 0x13, 0x67, 0x0a, 0x67, 0xc8, 0x81, 0x53, 0xe6, 0x1d, 0x70, 0x67, 0xa8, 0x35,
+	0x3f, 0x95, 0xfb, 0x1d, 0x9a, 0x62, 0x59, 0x3f, 0x10, 0x1e, 0xa5, 0xa8, 0xc3, 0xf8, 0x67, 0xc6,
+	0x3c, 0x06, 0xf9, 0x51, 0xb3, 0x3b, 0x94, 0x34, 0x7a, 0x8b, 0x2d, 0xf9, 0x81, 0xf0, 0x30, 0xc5,
+	0x1b, 0xc5, 0xad, 0x3c, 0x00, 0x40, 0xea, 0x77, 0x9a, 0x1d, 0xa9, 0x27, 0xf5, 0x35, 0x3a, 0xcb,
+	0x56, 0xfc, 0x40, 0x28, 0xa5, 0xa8, 0xd7, 0x8b, 0xcd, 0xbc, 0x05, 0xb6, 0x7b, 0xf2, 0xe7, 0x92,
+	0x4a, 0xe7, 0xd8, 0xa2, 0x1f, 0x08, 0x7b, 0x29, 0x66, 0x0f, 0x3d, 0x83, 0x2e, 0x73, 0x08, 0xee,
+	0xb6, 0xe5, 0xa1, 0xa6, 0xca, 0xe2, 0x89, 0x26, 0x2b, 0x7d, 0x7a, 0x9b, 0xe5, 0xfd, 0x40, 0x78,
+	0x23, 0xc5, 0x6d, 0x23, 0x8f, 0xb8, 0x68, 0xbc, 0x08, 0x97, 0x91, 0x79, 0x0a, 0x1e, 0x28, 0x23,
+	0x49, 0x1d, 0xca, 0x9d, 0x4f, 0x34, 0xbd, 0xa5, 0xf4, 0x7a, 0x27, 0x7d, 0x59, 0xfb, 0x82, 0xce,
+	0xb3, 0x8f, 0xfd, 0x40, 0x78, 0x33, 0xa5, 0x54, 0x96, 0xd0, 0xf5, 0x90, 0x79, 0x4a, 0x5a, 0x9b,
+	0x29, 0x31, 0x1a, 0xa8, 0xbc, 0x46, 0xaf, 0x0f, 0x54, 0x65, 0xa0, 0x0c, 0x9b, 0xdd, 0x21, 0x7d,
+	0x87, 0x3d, 0xf4, 0x03, 0xe1, 0xe0, 0x6f, 0x9d, 0x3a, 0x78, 0x39, 0x70, 0xf1, 0x1c, 0x7b, 0x86,
+	0xe5, 0x31, 0x1f, 0x82, 0xfb, 0xaf, 0x78, 0x29, 0x4a, 0x97, 0xde, 0x61, 0x05, 0x3f, 0x10, 0xca,
+	0x29, 0x9b, 0xd6, 0x8d, 0x6d, 0xf9, 0x08, 0xd0, 0xa3, 0x66, 0x57, 0x6e, 0x37, 0x35, 0x45, 0xd5,
+	0x47, 0x8a, 0x16, 0xce, 0xaa, 0xc0, 0x56, 0xfd, 0x40, 0xe0, 0x6e, 0xcf, 0x00, 0x4d, 0x0d, 0x82,
+	0xdd, 0x11, 0x26, 0xe1, 0xcc, 0xde, 0x07, 0x77, 0x9b, 0xaa, 0x28, 0x6b, 0x92, 0x1a, 0x67, 0x03,
+	0x2c, 0xe7, 0x07, 0x02, 0x9b, 0x52, 0x35, 0xdd, 0x31, 0x22, 0xd0, 0x8d, 0x72, 0x7d, 0x0c, 0x1e,
+	0xbc, 0xaa, 0xd8, 0xa4, 0xdb, 0x65, 0xf7, 0xfd, 0x40, 0x10, 0xfe, 0x5c, 0x18, 0x27, 0x64, 0x73,
+	0x5f, 0xfd, 0xc0, 0x65, 0xc4, 0xa7, 0x2f, 0xce, 0x39, 0xea, 0xe5, 0x39, 0x47, 0xfd, 0x76, 0xce,
+	0x51, 0xdf, 0x5c, 0x70, 0x99, 0x97, 0x17, 0x5c, 0xe6, 0xe7, 0x0b, 0x2e, 0xf3, 0xe5, 0xfe, 0x8d,
+	0x15, 0x8f, 0x6e, 0x2d, 0x62, 0xba, 0xc6, 0x14, 0x36, 0x9e, 0x45, 0xd7, 0x57, 0xb4, 0xe4, 0xe3,
+	0x7c, 0xf4, 0xa7, 0xfd, 0xc1, 0x1f, 0x03, 0x00, 0xe8, 0x9c, 0x11, 0xbd, 0xd9, 0x06, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -257,6 +325,40 @@ func (this *Params) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if !this.FeeSplit.Equal(&that1.FeeSplit) {
+		return false
+	}
+	return true
+}
+
+func (this *FeeSplit) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FeeSplit)
+	if !ok {
+		that2, ok := that.(FeeSplit)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Valset.Equal(that1.Valset) {
+		return false
+	}
+	if !this.CommunityPool.Equal(that1.CommunityPool) {
+		return false
+	}
+	if !this.Burn.Equal(that1.Burn) {
+		return false
+	}
 	return true
 }
 
@@ -280,6 +382,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.FeeSplit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintPoe(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
 	if len(m.MinDelegationAmounts) > 0 {
 		for iNdEx := len(m.MinDelegationAmounts) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -307,6 +419,59 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FeeSplit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeSplit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeSplit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Burn.Size()
+		i -= size
+		if _, err := m.Burn.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPoe(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.CommunityPool.Size()
+		i -= size
+		if _, err := m.CommunityPool.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPoe(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Valset.Size()
+		i -= size
+		if _, err := m.Valset.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPoe(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintPoe(dAtA []byte, offset int, v uint64) int {
 	offset -= sovPoe(v)
 	base := offset
@@ -337,6 +502,23 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovPoe(uint64(l))
 		}
 	}
+	l = m.FeeSplit.Size()
+	n += 1 + l + sovPoe(uint64(l))
+	return n
+}
+
+func (m *FeeSplit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Valset.Size()
+	n += 1 + l + sovPoe(uint64(l))
+	l = m.CommunityPool.Size()
+	n += 1 + l + sovPoe(uint64(l))
+	l = m.Burn.Size()
+	n += 1 + l + sovPoe(uint64(l))
 	return n
 }
 
@@ -449,6 +631,192 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeSplit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPoe
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPoe
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPoe
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeSplit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPoe(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPoe
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *FeeSplit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPoe
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeSplit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeSplit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Valset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPoe
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPoe
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPoe
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Valset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPool", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPoe
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPoe
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPoe
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommunityPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPoe
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPoe
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPoe
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Burn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPoe(dAtA[iNdEx:])
@@ -11,6 +11,7 @@ import (
 	math_bits "math/bits"
 	time "time"
 
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	types1 "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -23,6 +24,7 @@ import (
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
 	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -676,1057 +678,4362 @@ func (m *QueryValidatorEngagementRewardResponse) GetReward() types.DecCoin {
 	return types.DecCoin{}
 }
 
-func init() {
-	proto.RegisterType((*QueryContractAddressRequest)(nil), "confio.poe.v1beta1.QueryContractAddressRequest")
-	proto.RegisterType((*QueryContractAddressResponse)(nil), "confio.poe.v1beta1.QueryContractAddressResponse")
-	proto.RegisterType((*QueryUnbondingPeriodRequest)(nil), "confio.poe.v1beta1.QueryUnbondingPeriodRequest")
-	proto.RegisterType((*QueryUnbondingPeriodResponse)(nil), "confio.poe.v1beta1.QueryUnbondingPeriodResponse")
-	proto.RegisterType((*QueryValidatorDelegationRequest)(nil), "confio.poe.v1beta1.QueryValidatorDelegationRequest")
-	proto.RegisterType((*QueryValidatorDelegationResponse)(nil), "confio.poe.v1beta1.QueryValidatorDelegationResponse")
-	proto.RegisterType((*QueryValidatorUnbondingDelegationsRequest)(nil), "confio.poe.v1beta1.QueryValidatorUnbondingDelegationsRequest")
-	proto.RegisterType((*QueryValidatorUnbondingDelegationsResponse)(nil), "confio.poe.v1beta1.QueryValidatorUnbondingDelegationsResponse")
-	proto.RegisterType((*QueryValidatorOutstandingRewardRequest)(nil), "confio.poe.v1beta1.QueryValidatorOutstandingRewardRequest")
-	proto.RegisterType((*QueryValidatorOutstandingRewardResponse)(nil), "confio.poe.v1beta1.QueryValidatorOutstandingRewardResponse")
-	proto.RegisterType((*QueryValidatorEngagementRewardRequest)(nil), "confio.poe.v1beta1.QueryValidatorEngagementRewardRequest")
-	proto.RegisterType((*QueryValidatorEngagementRewardResponse)(nil), "confio.poe.v1beta1.QueryValidatorEngagementRewardResponse")
+// QueryEngagementPointsRequest is the request type for the
+// Query/EngagementPoints RPC method.
+type QueryEngagementPointsRequest struct {
+	// address defines the address to query for.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func init() { proto.RegisterFile("confio/poe/v1beta1/query.proto", fileDescriptor_55a2242dcc0e0cfb) }
-
-var fileDescriptor_55a2242dcc0e0cfb = []byte{
-	// 1019 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x97, 0xcb, 0x6f, 0x1b, 0x45,
-	0x18, 0xc0, 0x3d, 0xa5, 0x34, 0xed, 0x94, 0xa4, 0x30, 0x70, 0x48, 0x4d, 0x62, 0x47, 0x4b, 0x93,
-	0x96, 0x40, 0x77, 0x1a, 0xa7, 0x52, 0xa9, 0x81, 0x4a, 0xe4, 0xd1, 0x06, 0x09, 0x44, 0xb0, 0x5a,
-	0x90, 0x90, 0x90, 0x35, 0xde, 0x9d, 0x6c, 0x46, 0xb5, 0x67, 0x9c, 0x9d, 0x71, 0xc0, 0x8a, 0x72,
-	0xe1, 0xc4, 0x11, 0x09, 0x21, 0x21, 0xb8, 0x54, 0x2a, 0xa7, 0x5e, 0xf8, 0x23, 0x40, 0xa2, 0xc7,
-	0x4a, 0x5c, 0x38, 0xb5, 0x55, 0xd2, 0x03, 0x17, 0x2e, 0xfc, 0x05, 0x68, 0x67, 0x67, 0xd6, 0xde,
-	0x64, 0xd7, 0x76, 0xd2, 0x03, 0xa7, 0xec, 0xec, 0xf7, 0xfa, 0x7d, 0x8f, 0x9d, 0x2f, 0x86, 0x25,
-	0x4f, 0xf0, 0x0d, 0x26, 0x70, 0x5b, 0x50, 0xbc, 0xbd, 0xd0, 0xa0, 0x8a, 0x2c, 0xe0, 0xad, 0x0e,
-	0x0d, 0xbb, 0x6e, 0x3b, 0x14, 0x4a, 0x20, 0x14, 0xcb, 0xdd, 0xb6, 0xa0, 0xae, 0x91, 0x17, 0xe7,
-	0x3d, 0x21, 0x5b, 0x42, 0xe2, 0x06, 0x91, 0x34, 0x56, 0x4e, 0x4c, 0xdb, 0x24, 0x60, 0x9c, 0x28,
-	0x26, 0x78, 0x6c, 0x5f, 0x7c, 0x2d, 0x10, 0x81, 0xd0, 0x8f, 0x38, 0x7a, 0x32, 0x6f, 0x4b, 0x81,
-	0x10, 0x41, 0x93, 0x62, 0x7d, 0x6a, 0x74, 0x36, 0xb0, 0xdf, 0x09, 0xfb, 0xad, 0xa6, 0x8c, 0x9c,
-	0xb4, 0x19, 0x26, 0x9c, 0x0b, 0xa5, 0x85, 0xd2, 0x4a, 0x33, 0x98, 0x23, 0x3e, 0xe3, 0xbb, 0x9f,
-	0xce, 0x8a, 0x3d, 0xc1, 0xac, 0xef, 0x0b, 0x46, 0x2e, 0x15, 0xb9, 0xcb, 0x78, 0x90, 0xa8, 0x98,
-	0xb3, 0xd1, 0x72, 0x72, 0xb4, 0xfa, 0x6a, 0xe3, 0x6c, 0xc1, 0xd7, 0x3f, 0x8d, 0x8e, 0xcb, 0x82,
-	0xab, 0x90, 0x78, 0xea, 0x03, 0xdf, 0x0f, 0xa9, 0x94, 0x35, 0xba, 0xd5, 0xa1, 0x52, 0xa1, 0x35,
-	0x38, 0xee, 0x19, 0x49, 0x5d, 0x75, 0xdb, 0x74, 0x12, 0xcc, 0x80, 0x4b, 0x13, 0x95, 0x37, 0xdc,
-	0xc3, 0x25, 0x75, 0xd7, 0xc5, 0xaa, 0xf5, 0x72, 0xbb, 0xdb, 0xa6, 0xb5, 0x97, 0xbc, 0xbe, 0x53,
-	0xf5, 0xf4, 0xb7, 0xf7, 0xca, 0x85, 0xbf, 0xef, 0x95, 0x0b, 0xce, 0x3b, 0x70, 0x2a, 0x3b, 0xa4,
-	0x6c, 0x0b, 0x2e, 0x29, 0x9a, 0x84, 0x63, 0x24, 0x7e, 0xa5, 0xa3, 0x9d, 0xa9, 0xd9, 0xa3, 0x33,
-	0x6d, 0x60, 0xef, 0xf0, 0x86, 0xe0, 0x3e, 0xe3, 0xc1, 0x3a, 0x0d, 0x99, 0xf0, 0x0d, 0xac, 0xf3,
-	0xb9, 0x71, 0x7c, 0x48, 0x6c, 0x1c, 0x5f, 0x83, 0x27, 0x15, 0x6b, 0xc5, 0x39, 0x9c, 0xad, 0x9c,
-	0x77, 0xe3, 0x06, 0xb9, 0xb6, 0x81, 0xee, 0x8a, 0x69, 0xe0, 0xd2, 0xe9, 0x87, 0x8f, 0xcb, 0x85,
-	0x1f, 0x9f, 0x94, 0x41, 0x4d, 0x1b, 0x38, 0x6b, 0xb0, 0xac, 0x1d, 0x7f, 0x46, 0x9a, 0xcc, 0x27,
-	0x4a, 0x84, 0x2b, 0xb4, 0x49, 0x03, 0xad, 0x6b, 0x0b, 0x35, 0x0b, 0x27, 0xb6, 0xad, 0xb4, 0x1e,
-	0xf1, 0x1a, 0xf6, 0xf1, 0xe4, 0x6d, 0x94, 0xa6, 0xf3, 0x25, 0x9c, 0xc9, 0xf7, 0x64, 0x30, 0xaf,
-	0xc3, 0xb1, 0x06, 0x69, 0x12, 0xee, 0xf5, 0x48, 0xe3, 0x46, 0xba, 0xd1, 0x38, 0x24, 0xe5, 0x5e,
-	0x16, 0x8c, 0x2f, 0x9d, 0x8c, 0x48, 0x6b, 0x56, 0xdf, 0xf9, 0x09, 0xc0, 0x37, 0xd3, 0xfe, 0x93,
-	0x5a, 0xf4, 0x02, 0xc9, 0xa3, 0x31, 0xa3, 0x9b, 0x10, 0xf6, 0x3e, 0x89, 0xc9, 0x13, 0x1a, 0x69,
-	0x2e, 0x85, 0x14, 0x0f, 0x54, 0x32, 0x07, 0x24, 0xa0, 0x26, 0x44, 0xad, 0xcf, 0xd2, 0xf9, 0x03,
-	0xc0, 0xf9, 0x51, 0xe0, 0x4c, 0x19, 0xd6, 0xe1, 0x18, 0xe5, 0x2a, 0x64, 0x34, 0x1a, 0x83, 0x17,
-	0x2e, 0x9d, 0xad, 0x5c, 0xb1, 0x31, 0xed, 0x94, 0xdb, 0x80, 0x19, 0x6e, 0x56, 0xb9, 0x0a, 0xbb,
-	0xb6, 0x3a, 0xc6, 0x0d, 0xba, 0x95, 0x91, 0xc8, 0xc5, 0xa1, 0x89, 0xc4, 0x38, 0xa9, 0x4c, 0xee,
-	0xc0, 0xb9, 0x74, 0x22, 0x9f, 0x74, 0x94, 0x54, 0x44, 0x33, 0xd4, 0xe8, 0x57, 0x24, 0xb4, 0x23,
-	0x89, 0xde, 0x82, 0xaf, 0xa4, 0x4b, 0xdc, 0x9b, 0xea, 0x97, 0x53, 0x55, 0x8e, 0xc6, 0xfb, 0x17,
-	0x00, 0x2f, 0x0e, 0xf5, 0x6b, 0xaa, 0xd3, 0x85, 0xa7, 0x42, 0xfd, 0xc6, 0xcc, 0xc8, 0x54, 0xe6,
-	0x8c, 0xac, 0x50, 0x4f, 0x8f, 0xc9, 0x72, 0x54, 0x88, 0x7f, 0x1f, 0x97, 0xc7, 0xbb, 0xa4, 0xd5,
-	0xac, 0x3a, 0xb1, 0xa5, 0xf3, 0xe0, 0x49, 0x79, 0x3e, 0x60, 0x6a, 0xb3, 0xd3, 0x70, 0x3d, 0xd1,
-	0xc2, 0xe6, 0xb6, 0x88, 0xff, 0x5c, 0x96, 0xfe, 0x5d, 0x1c, 0x7d, 0xf1, 0xd2, 0x3a, 0xa9, 0x99,
-	0x80, 0xce, 0x6d, 0x38, 0x9b, 0xa6, 0x5c, 0xe5, 0x01, 0x09, 0x68, 0x8b, 0x72, 0xf5, 0x1c, 0xc9,
-	0xdf, 0x07, 0x07, 0x8b, 0x7a, 0xd8, 0xed, 0xff, 0x9e, 0x7b, 0xe5, 0xb7, 0x71, 0xf8, 0xa2, 0xa6,
-	0x44, 0x0f, 0x00, 0x3c, 0x77, 0xe0, 0x06, 0x43, 0x38, 0xeb, 0x5a, 0x1c, 0x70, 0xbd, 0x16, 0xaf,
-	0x8c, 0x6e, 0x10, 0xe7, 0xee, 0x5c, 0xfd, 0xe6, 0xcf, 0x67, 0xdf, 0x9f, 0x70, 0xd1, 0xdb, 0x58,
-	0x05, 0x21, 0xf1, 0x69, 0x6a, 0x81, 0xd8, 0x0b, 0x17, 0xef, 0xa4, 0x2e, 0xed, 0x5d, 0xf4, 0x03,
-	0x80, 0x30, 0xa9, 0xab, 0x44, 0x6e, 0xde, 0x97, 0x94, 0x6e, 0x40, 0x82, 0x89, 0x47, 0xd6, 0x37,
-	0x94, 0x73, 0x9a, 0x72, 0x06, 0x95, 0xb2, 0x28, 0xb7, 0x7b, 0x20, 0xf7, 0x01, 0x3c, 0x93, 0x98,
-	0xa3, 0xcb, 0xa3, 0x85, 0xb1, 0x54, 0xee, 0xa8, 0xea, 0x06, 0xea, 0x9a, 0x86, 0x5a, 0x40, 0x78,
-	0x30, 0x14, 0xde, 0x49, 0x0f, 0xed, 0x2e, 0xfa, 0x19, 0xc0, 0x73, 0x07, 0x76, 0xca, 0x80, 0x56,
-	0x67, 0x2f, 0xa7, 0x01, 0xad, 0xce, 0x59, 0x57, 0xce, 0xac, 0xe6, 0x2d, 0xa3, 0xe9, 0x2c, 0xde,
-	0x8e, 0x35, 0x42, 0xbf, 0x03, 0xf8, 0x6a, 0xc6, 0x3a, 0x41, 0x8b, 0xb9, 0x01, 0xf3, 0xd7, 0x58,
-	0xf1, 0xea, 0xd1, 0x8c, 0x0c, 0xe9, 0x92, 0x26, 0x7d, 0x0f, 0x55, 0x35, 0xa2, 0xa1, 0x1d, 0xa1,
-	0xb2, 0xd8, 0xef, 0xe1, 0xfe, 0x03, 0xe0, 0xf4, 0xc0, 0xc5, 0x80, 0xde, 0x1f, 0xce, 0x36, 0x60,
-	0xdb, 0x15, 0x6f, 0x1c, 0xd7, 0xdc, 0x24, 0xf9, 0xb1, 0x4e, 0xf2, 0x16, 0x5a, 0x3d, 0xe2, 0xf8,
-	0xf4, 0x5a, 0x55, 0xf7, 0xfb, 0xb2, 0xf9, 0x15, 0xc0, 0x89, 0x35, 0x26, 0x95, 0x08, 0x99, 0x47,
-	0x9a, 0x1f, 0xf2, 0x0d, 0x81, 0x2a, 0x03, 0x07, 0x3a, 0xad, 0x6c, 0xb3, 0x5a, 0x3c, 0x92, 0xcd,
-	0x28, 0x97, 0xc8, 0x66, 0x62, 0x53, 0x67, 0x7c, 0x43, 0xe0, 0x9d, 0x4d, 0xca, 0x82, 0x4d, 0xb5,
-	0x8b, 0x9e, 0x01, 0x58, 0xcc, 0xdf, 0x4c, 0xa8, 0x3a, 0xbc, 0xbe, 0x79, 0x6b, 0xb2, 0xf8, 0xee,
-	0xb1, 0x6c, 0x9f, 0xaf, 0x31, 0x54, 0xca, 0x5d, 0x2c, 0x7a, 0x5e, 0xeb, 0xf1, 0x15, 0x8f, 0x9e,
-	0x02, 0x78, 0x3e, 0x77, 0x07, 0xa1, 0xeb, 0xc3, 0x49, 0x73, 0xd6, 0x61, 0xb1, 0x7a, 0x1c, 0x53,
-	0x93, 0xe3, 0x47, 0x3a, 0xc7, 0x9b, 0x68, 0xe5, 0x18, 0x39, 0xd2, 0xc4, 0xa9, 0x49, 0x71, 0xe9,
-	0xc6, 0xc3, 0xbd, 0x12, 0x78, 0xb4, 0x57, 0x02, 0x4f, 0xf7, 0x4a, 0xe0, 0xbb, 0xfd, 0x52, 0xe1,
-	0xd1, 0x7e, 0xa9, 0xf0, 0xd7, 0x7e, 0xa9, 0xf0, 0xc5, 0x85, 0xd4, 0x4e, 0xd4, 0xbf, 0x50, 0x4c,
-	0xc0, 0xaf, 0xe3, 0x6f, 0x3b, 0xda, 0x8a, 0x8d, 0x53, 0xfa, 0x5f, 0xe6, 0xc5, 0xff, 0x02, 0x00,
-	0x00, 0xff, 0xff, 0x66, 0x42, 0xe2, 0x28, 0x79, 0x0d, 0x00, 0x00,
+func (m *QueryEngagementPointsRequest) Reset()         { *m = QueryEngagementPointsRequest{} }
+func (m *QueryEngagementPointsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEngagementPointsRequest) ProtoMessage()    {}
+func (*QueryEngagementPointsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55a2242dcc0e0cfb, []int{12}
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *QueryEngagementPointsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *QueryEngagementPointsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEngagementPointsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// ContractAddress queries the address for one of the PoE contracts
-	ContractAddress(ctx context.Context, in *QueryContractAddressRequest, opts ...grpc.CallOption) (*QueryContractAddressResponse, error)
-	// Validators queries all validators that match the given status.
-	Validators(ctx context.Context, in *types1.QueryValidatorsRequest, opts ...grpc.CallOption) (*types1.QueryValidatorsResponse, error)
-	// Validator queries validator info for given validator address.
-	Validator(ctx context.Context, in *types1.QueryValidatorRequest, opts ...grpc.CallOption) (*types1.QueryValidatorResponse, error)
-	// Validator queries validator info for given validator address.
-	UnbondingPeriod(ctx context.Context, in *QueryUnbondingPeriodRequest, opts ...grpc.CallOption) (*QueryUnbondingPeriodResponse, error)
-	// ValidatorDelegation queries self delegated amount for given validator.
-	ValidatorDelegation(ctx context.Context, in *QueryValidatorDelegationRequest, opts ...grpc.CallOption) (*QueryValidatorDelegationResponse, error)
-	// ValidatorUnbondingDelegations queries unbonding delegations of a validator.
-	ValidatorUnbondingDelegations(ctx context.Context, in *QueryValidatorUnbondingDelegationsRequest, opts ...grpc.CallOption) (*QueryValidatorUnbondingDelegationsResponse, error)
-	// HistoricalInfo queries the historical info for given height.
-	HistoricalInfo(ctx context.Context, in *types1.QueryHistoricalInfoRequest, opts ...grpc.CallOption) (*types1.QueryHistoricalInfoResponse, error)
-	// ValidatorOutstandingRewards queries rewards of a validator address.
-	ValidatorOutstandingReward(ctx context.Context, in *QueryValidatorOutstandingRewardRequest, opts ...grpc.CallOption) (*QueryValidatorOutstandingRewardResponse, error)
-	// ValidatorEngagementReward queries rewards of a validator address.
-	ValidatorEngagementReward(ctx context.Context, in *QueryValidatorEngagementRewardRequest, opts ...grpc.CallOption) (*QueryValidatorEngagementRewardResponse, error)
+func (m *QueryEngagementPointsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEngagementPointsRequest.Merge(m, src)
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryEngagementPointsRequest) XXX_Size() int {
+	return m.Size()
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryEngagementPointsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEngagementPointsRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) ContractAddress(ctx context.Context, in *QueryContractAddressRequest, opts ...grpc.CallOption) (*QueryContractAddressResponse, error) {
-	out := new(QueryContractAddressResponse)
-	err := c.cc.Invoke(ctx, "/confio.poe.v1beta1.Query/ContractAddress", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryEngagementPointsRequest proto.InternalMessageInfo
+
+func (m *QueryEngagementPointsRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) Validators(ctx context.Context, in *types1.QueryValidatorsRequest, opts ...grpc.CallOption) (*types1.QueryValidatorsResponse, error) {
-	out := new(types1.QueryValidatorsResponse)
-	err := c.cc.Invoke(ctx, "/confio.poe.v1beta1.Query/Validators", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryEngagementPointsResponse is the response type for the
+// Query/EngagementPoints RPC method.
+type QueryEngagementPointsResponse struct {
+	// points is the engagement points of the address. Zero for non members.
+	Points uint64 `protobuf:"varint,1,opt,name=points,proto3" json:"points,omitempty"`
 }
 
-func (c *queryClient) Validator(ctx context.Context, in *types1.QueryValidatorRequest, opts ...grpc.CallOption) (*types1.QueryValidatorResponse, error) {
-	out := new(types1.QueryValidatorResponse)
-	err := c.cc.Invoke(ctx, "/confio.poe.v1beta1.Query/Validator", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryEngagementPointsResponse) Reset()         { *m = QueryEngagementPointsResponse{} }
+func (m *QueryEngagementPointsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEngagementPointsResponse) ProtoMessage()    {}
+func (*QueryEngagementPointsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55a2242dcc0e0cfb, []int{13}
 }
 
-func (c *queryClient) UnbondingPeriod(ctx context.Context, in *QueryUnbondingPeriodRequest, opts ...grpc.CallOption) (*QueryUnbondingPeriodResponse, error) {
-	out := new(QueryUnbondingPeriodResponse)
-	err := c.cc.Invoke(ctx, "/confio.poe.v1beta1.Query/UnbondingPeriod", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryEngagementPointsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
 
-func (c *queryClient) ValidatorDelegation(ctx context.Context, in *QueryValidatorDelegationRequest, opts ...grpc.CallOption) (*QueryValidatorDelegationResponse, error) {
-	out := new(QueryValidatorDelegationResponse)
-	err := c.cc.Invoke(ctx, "/confio.poe.v1beta1.Query/ValidatorDelegation", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryEngagementPointsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEngagementPointsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
 
-func (c *queryClient) ValidatorUnbondingDelegations(ctx context.Context, in *QueryValidatorUnbondingDelegationsRequest, opts ...grpc.CallOption) (*QueryValidatorUnbondingDelegationsResponse, error) {
-	out := new(QueryValidatorUnbondingDelegationsResponse)
-	err := c.cc.Invoke(ctx, "/confio.poe.v1beta1.Query/ValidatorUnbondingDelegations", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryEngagementPointsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEngagementPointsResponse.Merge(m, src)
 }
 
-func (c *queryClient) HistoricalInfo(ctx context.Context, in *types1.QueryHistoricalInfoRequest, opts ...grpc.CallOption) (*types1.QueryHistoricalInfoResponse, error) {
-	out := new(types1.QueryHistoricalInfoResponse)
-	err := c.cc.Invoke(ctx, "/confio.poe.v1beta1.Query/HistoricalInfo", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryEngagementPointsResponse) XXX_Size() int {
+	return m.Size()
 }
 
-func (c *queryClient) ValidatorOutstandingReward(ctx context.Context, in *QueryValidatorOutstandingRewardRequest, opts ...grpc.CallOption) (*QueryValidatorOutstandingRewardResponse, error) {
-	out := new(QueryValidatorOutstandingRewardResponse)
-	err := c.cc.Invoke(ctx, "/confio.poe.v1beta1.Query/ValidatorOutstandingReward", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryEngagementPointsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEngagementPointsResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) ValidatorEngagementReward(ctx context.Context, in *QueryValidatorEngagementRewardRequest, opts ...grpc.CallOption) (*QueryValidatorEngagementRewardResponse, error) {
-	out := new(QueryValidatorEngagementRewardResponse)
-	err := c.cc.Invoke(ctx, "/confio.poe.v1beta1.Query/ValidatorEngagementReward", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryEngagementPointsResponse proto.InternalMessageInfo
+
+func (m *QueryEngagementPointsResponse) GetPoints() uint64 {
+	if m != nil {
+		return m.Points
 	}
-	return out, nil
+	return 0
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// ContractAddress queries the address for one of the PoE contracts
-	ContractAddress(context.Context, *QueryContractAddressRequest) (*QueryContractAddressResponse, error)
-	// Validators queries all validators that match the given status.
-	Validators(context.Context, *types1.QueryValidatorsRequest) (*types1.QueryValidatorsResponse, error)
-	// Validator queries validator info for given validator address.
-	Validator(context.Context, *types1.QueryValidatorRequest) (*types1.QueryValidatorResponse, error)
-	// Validator queries validator info for given validator address.
-	UnbondingPeriod(context.Context, *QueryUnbondingPeriodRequest) (*QueryUnbondingPeriodResponse, error)
-	// ValidatorDelegation queries self delegated amount for given validator.
-	ValidatorDelegation(context.Context, *QueryValidatorDelegationRequest) (*QueryValidatorDelegationResponse, error)
-	// ValidatorUnbondingDelegations queries unbonding delegations of a validator.
-	ValidatorUnbondingDelegations(context.Context, *QueryValidatorUnbondingDelegationsRequest) (*QueryValidatorUnbondingDelegationsResponse, error)
-	// HistoricalInfo queries the historical info for given height.
-	HistoricalInfo(context.Context, *types1.QueryHistoricalInfoRequest) (*types1.QueryHistoricalInfoResponse, error)
-	// ValidatorOutstandingRewards queries rewards of a validator address.
-	ValidatorOutstandingReward(context.Context, *QueryValidatorOutstandingRewardRequest) (*QueryValidatorOutstandingRewardResponse, error)
-	// ValidatorEngagementReward queries rewards of a validator address.
-	ValidatorEngagementReward(context.Context, *QueryValidatorEngagementRewardRequest) (*QueryValidatorEngagementRewardResponse, error)
+// QueryEngagementMembersRequest is the request type for the
+// Query/EngagementMembers RPC method.
+type QueryEngagementMembersRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct{}
-
-func (*UnimplementedQueryServer) ContractAddress(ctx context.Context, req *QueryContractAddressRequest) (*QueryContractAddressResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ContractAddress not implemented")
+func (m *QueryEngagementMembersRequest) Reset()         { *m = QueryEngagementMembersRequest{} }
+func (m *QueryEngagementMembersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEngagementMembersRequest) ProtoMessage()    {}
+func (*QueryEngagementMembersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55a2242dcc0e0cfb, []int{14}
 }
 
-func (*UnimplementedQueryServer) Validators(ctx context.Context, req *types1.QueryValidatorsRequest) (*types1.QueryValidatorsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Validators not implemented")
+func (m *QueryEngagementMembersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
 
-func (*UnimplementedQueryServer) Validator(ctx context.Context, req *types1.QueryValidatorRequest) (*types1.QueryValidatorResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Validator not implemented")
+func (m *QueryEngagementMembersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEngagementMembersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
 
-func (*UnimplementedQueryServer) UnbondingPeriod(ctx context.Context, req *QueryUnbondingPeriodRequest) (*QueryUnbondingPeriodResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UnbondingPeriod not implemented")
+func (m *QueryEngagementMembersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEngagementMembersRequest.Merge(m, src)
 }
 
-func (*UnimplementedQueryServer) ValidatorDelegation(ctx context.Context, req *QueryValidatorDelegationRequest) (*QueryValidatorDelegationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ValidatorDelegation not implemented")
+func (m *QueryEngagementMembersRequest) XXX_Size() int {
+	return m.Size()
 }
 
-func (*UnimplementedQueryServer) ValidatorUnbondingDelegations(ctx context.Context, req *QueryValidatorUnbondingDelegationsRequest) (*QueryValidatorUnbondingDelegationsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ValidatorUnbondingDelegations not implemented")
+func (m *QueryEngagementMembersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEngagementMembersRequest.DiscardUnknown(m)
 }
 
-func (*UnimplementedQueryServer) HistoricalInfo(ctx context.Context, req *types1.QueryHistoricalInfoRequest) (*types1.QueryHistoricalInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HistoricalInfo not implemented")
+var xxx_messageInfo_QueryEngagementMembersRequest proto.InternalMessageInfo
+
+func (m *QueryEngagementMembersRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (*UnimplementedQueryServer) ValidatorOutstandingReward(ctx context.Context, req *QueryValidatorOutstandingRewardRequest) (*QueryValidatorOutstandingRewardResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ValidatorOutstandingReward not implemented")
+// QueryEngagementMembersResponse is the response type for the
+// Query/EngagementMembers RPC method.
+type QueryEngagementMembersResponse struct {
+	// members ordered by points descending
+	Members []EngagementMember `protobuf:"bytes,1,rep,name=members,proto3" json:"members"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (*UnimplementedQueryServer) ValidatorEngagementReward(ctx context.Context, req *QueryValidatorEngagementRewardRequest) (*QueryValidatorEngagementRewardResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ValidatorEngagementReward not implemented")
+func (m *QueryEngagementMembersResponse) Reset()         { *m = QueryEngagementMembersResponse{} }
+func (m *QueryEngagementMembersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEngagementMembersResponse) ProtoMessage()    {}
+func (*QueryEngagementMembersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55a2242dcc0e0cfb, []int{15}
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryEngagementMembersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
 
-func _Query_ContractAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryContractAddressRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ContractAddress(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/confio.poe.v1beta1.Query/ContractAddress",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ContractAddress(ctx, req.(*QueryContractAddressRequest))
+func (m *QueryEngagementMembersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEngagementMembersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_Validators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(types1.QueryValidatorsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Validators(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/confio.poe.v1beta1.Query/Validators",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Validators(ctx, req.(*types1.QueryValidatorsRequest))
+func (m *QueryEngagementMembersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEngagementMembersResponse.Merge(m, src)
+}
+
+func (m *QueryEngagementMembersResponse) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QueryEngagementMembersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEngagementMembersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEngagementMembersResponse proto.InternalMessageInfo
+
+func (m *QueryEngagementMembersResponse) GetMembers() []EngagementMember {
+	if m != nil {
+		return m.Members
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_Validator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(types1.QueryValidatorRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *QueryEngagementMembersResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).Validator(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/confio.poe.v1beta1.Query/Validator",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Validator(ctx, req.(*types1.QueryValidatorRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_UnbondingPeriod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryUnbondingPeriodRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).UnbondingPeriod(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/confio.poe.v1beta1.Query/UnbondingPeriod",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).UnbondingPeriod(ctx, req.(*QueryUnbondingPeriodRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// EngagementMember is a member of the engagement group
+type EngagementMember struct {
+	// address is the bech32 address string of the member
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// points is the engagement points of the member
+	Points uint64 `protobuf:"varint,2,opt,name=points,proto3" json:"points,omitempty"`
 }
 
-func _Query_ValidatorDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryValidatorDelegationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ValidatorDelegation(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/confio.poe.v1beta1.Query/ValidatorDelegation",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ValidatorDelegation(ctx, req.(*QueryValidatorDelegationRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *EngagementMember) Reset()         { *m = EngagementMember{} }
+func (m *EngagementMember) String() string { return proto.CompactTextString(m) }
+func (*EngagementMember) ProtoMessage()    {}
+func (*EngagementMember) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55a2242dcc0e0cfb, []int{16}
 }
 
-func _Query_ValidatorUnbondingDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryValidatorUnbondingDelegationsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ValidatorUnbondingDelegations(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/confio.poe.v1beta1.Query/ValidatorUnbondingDelegations",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ValidatorUnbondingDelegations(ctx, req.(*QueryValidatorUnbondingDelegationsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *EngagementMember) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
 
-func _Query_HistoricalInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(types1.QueryHistoricalInfoRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).HistoricalInfo(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/confio.poe.v1beta1.Query/HistoricalInfo",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).HistoricalInfo(ctx, req.(*types1.QueryHistoricalInfoRequest))
+func (m *EngagementMember) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EngagementMember.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_ValidatorOutstandingReward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryValidatorOutstandingRewardRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ValidatorOutstandingReward(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/confio.poe.v1beta1.Query/ValidatorOutstandingReward",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ValidatorOutstandingReward(ctx, req.(*QueryValidatorOutstandingRewardRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *EngagementMember) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EngagementMember.Merge(m, src)
 }
 
-func _Query_ValidatorEngagementReward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryValidatorEngagementRewardRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ValidatorEngagementReward(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/confio.poe.v1beta1.Query/ValidatorEngagementReward",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ValidatorEngagementReward(ctx, req.(*QueryValidatorEngagementRewardRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *EngagementMember) XXX_Size() int {
+	return m.Size()
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "confio.poe.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "ContractAddress",
-			Handler:    _Query_ContractAddress_Handler,
-		},
-		{
-			MethodName: "Validators",
-			Handler:    _Query_Validators_Handler,
-		},
-		{
-			MethodName: "Validator",
-			Handler:    _Query_Validator_Handler,
-		},
-		{
-			MethodName: "UnbondingPeriod",
-			Handler:    _Query_UnbondingPeriod_Handler,
-		},
-		{
-			MethodName: "ValidatorDelegation",
-			Handler:    _Query_ValidatorDelegation_Handler,
-		},
-		{
-			MethodName: "ValidatorUnbondingDelegations",
-			Handler:    _Query_ValidatorUnbondingDelegations_Handler,
-		},
-		{
-			MethodName: "HistoricalInfo",
-			Handler:    _Query_HistoricalInfo_Handler,
-		},
-		{
-			MethodName: "ValidatorOutstandingReward",
-			Handler:    _Query_ValidatorOutstandingReward_Handler,
-		},
-		{
-			MethodName: "ValidatorEngagementReward",
-			Handler:    _Query_ValidatorEngagementReward_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "confio/poe/v1beta1/query.proto",
+func (m *EngagementMember) XXX_DiscardUnknown() {
+	xxx_messageInfo_EngagementMember.DiscardUnknown(m)
 }
 
-func (m *QueryContractAddressRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EngagementMember proto.InternalMessageInfo
+
+func (m *EngagementMember) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryContractAddressRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EngagementMember) GetPoints() uint64 {
+	if m != nil {
+		return m.Points
+	}
+	return 0
 }
 
-func (m *QueryContractAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ContractType != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ContractType))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+// QueryValidatorJailStatusRequest is the request type for the
+// Query/ValidatorJailStatus RPC method.
+type QueryValidatorJailStatusRequest struct {
+	// validator_addr defines the validator address to query for.
+	ValidatorAddr string `protobuf:"bytes,1,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
 }
 
-func (m *QueryContractAddressResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryValidatorJailStatusRequest) Reset()         { *m = QueryValidatorJailStatusRequest{} }
+func (m *QueryValidatorJailStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorJailStatusRequest) ProtoMessage()    {}
+func (*QueryValidatorJailStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55a2242dcc0e0cfb, []int{17}
 }
 
-func (m *QueryContractAddressResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryValidatorJailStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
 
-func (m *QueryContractAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryValidatorJailStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorJailStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
 
-func (m *QueryUnbondingPeriodRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryValidatorJailStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorJailStatusRequest.Merge(m, src)
 }
 
-func (m *QueryUnbondingPeriodRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryValidatorJailStatusRequest) XXX_Size() int {
+	return m.Size()
 }
 
-func (m *QueryUnbondingPeriodRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryValidatorJailStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorJailStatusRequest.DiscardUnknown(m)
 }
 
-func (m *QueryUnbondingPeriodResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryValidatorJailStatusRequest proto.InternalMessageInfo
+
+func (m *QueryValidatorJailStatusRequest) GetValidatorAddr() string {
+	if m != nil {
+		return m.ValidatorAddr
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryUnbondingPeriodResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryValidatorJailStatusResponse is the response type for the
+// Query/ValidatorJailStatus RPC method.
+type QueryValidatorJailStatusResponse struct {
+	// jailed is true when the validator is currently jailed
+	Jailed bool `protobuf:"varint,1,opt,name=jailed,proto3" json:"jailed,omitempty"`
+	// start is the time the validator was jailed
+	Start *time.Time `protobuf:"bytes,2,opt,name=start,proto3,stdtime" json:"start,omitempty"`
+	// until is the time the jailing period ends. Empty when jailed forever.
+	Until *time.Time `protobuf:"bytes,3,opt,name=until,proto3,stdtime" json:"until,omitempty"`
+	// forever is true when the validator was jailed without an end
+	Forever bool `protobuf:"varint,4,opt,name=forever,proto3" json:"forever,omitempty"`
+	// can_unjail is true when the jailing period has passed and the operator
+	// can unjail itself
+	CanUnjail bool `protobuf:"varint,5,opt,name=can_unjail,json=canUnjail,proto3" json:"can_unjail,omitempty"`
 }
 
-func (m *QueryUnbondingPeriodResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Time):])
-	if err1 != nil {
-		return 0, err1
-	}
-	i -= n1
-	i = encodeVarintQuery(dAtA, i, uint64(n1))
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+func (m *QueryValidatorJailStatusResponse) Reset()         { *m = QueryValidatorJailStatusResponse{} }
+func (m *QueryValidatorJailStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorJailStatusResponse) ProtoMessage()    {}
+func (*QueryValidatorJailStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55a2242dcc0e0cfb, []int{18}
 }
 
-func (m *QueryValidatorDelegationRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryValidatorJailStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
 
-func (m *QueryValidatorDelegationRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryValidatorJailStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorJailStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
 
-func (m *QueryValidatorDelegationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ValidatorAddr) > 0 {
-		i -= len(m.ValidatorAddr)
-		copy(dAtA[i:], m.ValidatorAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QueryValidatorJailStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorJailStatusResponse.Merge(m, src)
 }
 
-func (m *QueryValidatorDelegationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryValidatorJailStatusResponse) XXX_Size() int {
+	return m.Size()
 }
 
-func (m *QueryValidatorDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryValidatorJailStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorJailStatusResponse.DiscardUnknown(m)
 }
 
-func (m *QueryValidatorDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Balance.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+var xxx_messageInfo_QueryValidatorJailStatusResponse proto.InternalMessageInfo
+
+func (m *QueryValidatorJailStatusResponse) GetJailed() bool {
+	if m != nil {
+		return m.Jailed
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return false
 }
 
-func (m *QueryValidatorUnbondingDelegationsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryValidatorJailStatusResponse) GetStart() *time.Time {
+	if m != nil {
+		return m.Start
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryValidatorUnbondingDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryValidatorJailStatusResponse) GetUntil() *time.Time {
+	if m != nil {
+		return m.Until
+	}
+	return nil
 }
 
-func (m *QueryValidatorUnbondingDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ValidatorAddr) > 0 {
-		i -= len(m.ValidatorAddr)
-		copy(dAtA[i:], m.ValidatorAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryValidatorJailStatusResponse) GetForever() bool {
+	if m != nil {
+		return m.Forever
 	}
-	return len(dAtA) - i, nil
+	return false
 }
 
-func (m *QueryValidatorUnbondingDelegationsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryValidatorJailStatusResponse) GetCanUnjail() bool {
+	if m != nil {
+		return m.CanUnjail
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *QueryValidatorUnbondingDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryEpochInfoRequest is the request type for the Query/EpochInfo RPC
+// method.
+type QueryEpochInfoRequest struct{}
+
+func (m *QueryEpochInfoRequest) Reset()         { *m = QueryEpochInfoRequest{} }
+func (m *QueryEpochInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochInfoRequest) ProtoMessage()    {}
+func (*QueryEpochInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55a2242dcc0e0cfb, []int{19}
 }
 
-func (m *QueryValidatorUnbondingDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Entries) > 0 {
-		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryEpochInfoRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryEpochInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEpochInfoRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
 
-func (m *QueryValidatorOutstandingRewardRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryEpochInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochInfoRequest.Merge(m, src)
 }
 
-func (m *QueryValidatorOutstandingRewardRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryEpochInfoRequest) XXX_Size() int {
+	return m.Size()
 }
 
-func (m *QueryValidatorOutstandingRewardRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QueryEpochInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochInfoRequest.DiscardUnknown(m)
 }
 
-func (m *QueryValidatorOutstandingRewardResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var xxx_messageInfo_QueryEpochInfoRequest proto.InternalMessageInfo
+
+// QueryEpochInfoResponse is the response type for the Query/EpochInfo RPC
+// method.
+type QueryEpochInfoResponse struct {
+	// current_epoch is the current epoch number (block time / epoch length)
+	CurrentEpoch uint64 `protobuf:"varint,1,opt,name=current_epoch,json=currentEpoch,proto3" json:"current_epoch,omitempty"`
+	// epoch_length is the duration of one epoch
+	EpochLength time.Duration `protobuf:"bytes,2,opt,name=epoch_length,json=epochLength,proto3,stdduration" json:"epoch_length"`
+	// next_epoch_time is the start time of the next epoch. Validator set updates
+	// and reward distribution happen at the first block after this time.
+	NextEpochTime time.Time `protobuf:"bytes,3,opt,name=next_epoch_time,json=nextEpochTime,proto3,stdtime" json:"next_epoch_time"`
+	// epoch_reward is the configured amount of reward tokens for an epoch
+	EpochReward types.Coin `protobuf:"bytes,4,opt,name=epoch_reward,json=epochReward,proto3" json:"epoch_reward"`
+	// last_distribution_height is the block height of the last validator set
+	// update and reward distribution
+	LastDistributionHeight uint64 `protobuf:"varint,5,opt,name=last_distribution_height,json=lastDistributionHeight,proto3" json:"last_distribution_height,omitempty"`
+	// last_distribution_time is the block time of the last validator set update
+	// and reward distribution
+	LastDistributionTime time.Time `protobuf:"bytes,6,opt,name=last_distribution_time,json=lastDistributionTime,proto3,stdtime" json:"last_distribution_time"`
 }
 
-func (m *QueryValidatorOutstandingRewardResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryEpochInfoResponse) Reset()         { *m = QueryEpochInfoResponse{} }
+func (m *QueryEpochInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochInfoResponse) ProtoMessage()    {}
+func (*QueryEpochInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55a2242dcc0e0cfb, []int{20}
 }
 
-func (m *QueryValidatorOutstandingRewardResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Reward.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryEpochInfoResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryEpochInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEpochInfoResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
 
-func (m *QueryValidatorEngagementRewardRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryEpochInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochInfoResponse.Merge(m, src)
 }
 
-func (m *QueryValidatorEngagementRewardRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryEpochInfoResponse) XXX_Size() int {
+	return m.Size()
 }
 
-func (m *QueryValidatorEngagementRewardRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryEpochInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochInfoResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEpochInfoResponse proto.InternalMessageInfo
+
+func (m *QueryEpochInfoResponse) GetCurrentEpoch() uint64 {
+	if m != nil {
+		return m.CurrentEpoch
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryValidatorEngagementRewardResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryEpochInfoResponse) GetEpochLength() time.Duration {
+	if m != nil {
+		return m.EpochLength
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryValidatorEngagementRewardResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryEpochInfoResponse) GetNextEpochTime() time.Time {
+	if m != nil {
+		return m.NextEpochTime
+	}
+	return time.Time{}
 }
 
-func (m *QueryValidatorEngagementRewardResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Reward.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryEpochInfoResponse) GetEpochReward() types.Coin {
+	if m != nil {
+		return m.EpochReward
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return types.Coin{}
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryEpochInfoResponse) GetLastDistributionHeight() uint64 {
+	if m != nil {
+		return m.LastDistributionHeight
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return 0
 }
 
-func (m *QueryContractAddressRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ContractType != 0 {
-		n += 1 + sovQuery(uint64(m.ContractType))
+func (m *QueryEpochInfoResponse) GetLastDistributionTime() time.Time {
+	if m != nil {
+		return m.LastDistributionTime
 	}
-	return n
+	return time.Time{}
 }
 
-func (m *QueryContractAddressResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+// QuerySlashingEventsRequest is the request type for the Query/SlashingEvents
+// RPC method.
+type QuerySlashingEventsRequest struct {
+	// min_height is the lowest slashing height to include. Zero for no lower
+	// bound.
+	MinHeight uint64 `protobuf:"varint,1,opt,name=min_height,json=minHeight,proto3" json:"min_height,omitempty"`
+	// max_height is the highest slashing height to include. Zero for no upper
+	// bound.
+	MaxHeight uint64 `protobuf:"varint,2,opt,name=max_height,json=maxHeight,proto3" json:"max_height,omitempty"`
+	// pagination defines an optional pagination for the request. Pages are
+	// over validators so that a page contains the events of all validators in
+	// it.
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySlashingEventsRequest) Reset()         { *m = QuerySlashingEventsRequest{} }
+func (m *QuerySlashingEventsRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySlashingEventsRequest) ProtoMessage()    {}
+func (*QuerySlashingEventsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55a2242dcc0e0cfb, []int{21}
+}
+
+func (m *QuerySlashingEventsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
 
-func (m *QueryUnbondingPeriodRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySlashingEventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySlashingEventsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
 }
 
-func (m *QueryUnbondingPeriodResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Time)
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QuerySlashingEventsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySlashingEventsRequest.Merge(m, src)
 }
 
-func (m *QueryValidatorDelegationRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ValidatorAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QuerySlashingEventsRequest) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QuerySlashingEventsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySlashingEventsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySlashingEventsRequest proto.InternalMessageInfo
+
+func (m *QuerySlashingEventsRequest) GetMinHeight() uint64 {
+	if m != nil {
+		return m.MinHeight
 	}
-	return n
+	return 0
 }
 
-func (m *QueryValidatorDelegationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySlashingEventsRequest) GetMaxHeight() uint64 {
+	if m != nil {
+		return m.MaxHeight
 	}
-	var l int
-	_ = l
-	l = m.Balance.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return 0
 }
 
-func (m *QueryValidatorUnbondingDelegationsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySlashingEventsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	l = len(m.ValidatorAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+// QuerySlashingEventsResponse is the response type for the
+// Query/SlashingEvents RPC method.
+type QuerySlashingEventsResponse struct {
+	// events ordered by validator operator address and slashing height
+	Events []SlashingEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySlashingEventsResponse) Reset()         { *m = QuerySlashingEventsResponse{} }
+func (m *QuerySlashingEventsResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySlashingEventsResponse) ProtoMessage()    {}
+func (*QuerySlashingEventsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55a2242dcc0e0cfb, []int{22}
+}
+
+func (m *QuerySlashingEventsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QuerySlashingEventsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySlashingEventsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+}
+
+func (m *QuerySlashingEventsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySlashingEventsResponse.Merge(m, src)
+}
+
+func (m *QuerySlashingEventsResponse) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QuerySlashingEventsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySlashingEventsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySlashingEventsResponse proto.InternalMessageInfo
+
+func (m *QuerySlashingEventsResponse) GetEvents() []SlashingEvent {
+	if m != nil {
+		return m.Events
 	}
-	return n
+	return nil
 }
 
-func (m *QueryValidatorUnbondingDelegationsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySlashingEventsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	if len(m.Entries) > 0 {
-		for _, e := range m.Entries {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+// SlashingEvent is a slashing of a validator as recorded by the valset
+// contract. The contract does not store the cause or the jailing period of a
+// single slashing. A tombstoned validator was slashed for double signing.
+// The jailing fields show the current jailing of the validator.
+type SlashingEvent struct {
+	// operator is the bech32 address string of the validator operator
+	Operator string `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
+	// height is the block height of the slashing
+	Height uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// portion is the slashed portion of the validator's stake and rewards
+	Portion github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=portion,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"portion"`
+	// tombstoned is true when the validator was tombstoned for double signing
+	Tombstoned bool `protobuf:"varint,4,opt,name=tombstoned,proto3" json:"tombstoned,omitempty"`
+	// jailed_until is the end of the current jailing of the validator. Empty
+	// when not jailed or jailed forever.
+	JailedUntil *time.Time `protobuf:"bytes,5,opt,name=jailed_until,json=jailedUntil,proto3,stdtime" json:"jailed_until,omitempty"`
+	// jailed_forever is true when the validator is jailed without an end
+	JailedForever bool `protobuf:"varint,6,opt,name=jailed_forever,json=jailedForever,proto3" json:"jailed_forever,omitempty"`
+}
+
+func (m *SlashingEvent) Reset()         { *m = SlashingEvent{} }
+func (m *SlashingEvent) String() string { return proto.CompactTextString(m) }
+func (*SlashingEvent) ProtoMessage()    {}
+func (*SlashingEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55a2242dcc0e0cfb, []int{23}
+}
+
+func (m *SlashingEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *SlashingEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SlashingEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
 }
 
-func (m *QueryValidatorOutstandingRewardRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *SlashingEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlashingEvent.Merge(m, src)
+}
+
+func (m *SlashingEvent) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *SlashingEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlashingEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SlashingEvent proto.InternalMessageInfo
+
+func (m *SlashingEvent) GetOperator() string {
+	if m != nil {
+		return m.Operator
 	}
-	return n
+	return ""
 }
 
-func (m *QueryValidatorOutstandingRewardResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SlashingEvent) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
 	}
-	var l int
-	_ = l
-	l = m.Reward.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return 0
 }
 
-func (m *QueryValidatorEngagementRewardRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SlashingEvent) GetTombstoned() bool {
+	if m != nil {
+		return m.Tombstoned
 	}
-	var l int
-	_ = l
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return false
+}
+
+func (m *SlashingEvent) GetJailedUntil() *time.Time {
+	if m != nil {
+		return m.JailedUntil
 	}
-	return n
+	return nil
 }
 
-func (m *QueryValidatorEngagementRewardResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SlashingEvent) GetJailedForever() bool {
+	if m != nil {
+		return m.JailedForever
 	}
-	var l int
-	_ = l
-	l = m.Reward.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return false
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+// QueryPreviewMixedPointsRequest is the request type for the
+// Query/PreviewMixedPoints RPC method.
+type QueryPreviewMixedPointsRequest struct {
+	// stake is the stake points. These are the bonded tokens divided by the
+	// tokens per point of the staking contract.
+	Stake uint64 `protobuf:"varint,1,opt,name=stake,proto3" json:"stake,omitempty"`
+	// engagement is the engagement points
+	Engagement uint64 `protobuf:"varint,2,opt,name=engagement,proto3" json:"engagement,omitempty"`
 }
 
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *QueryPreviewMixedPointsRequest) Reset()         { *m = QueryPreviewMixedPointsRequest{} }
+func (m *QueryPreviewMixedPointsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPreviewMixedPointsRequest) ProtoMessage()    {}
+func (*QueryPreviewMixedPointsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55a2242dcc0e0cfb, []int{24}
 }
 
-func (m *QueryContractAddressRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
+func (m *QueryPreviewMixedPointsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryPreviewMixedPointsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPreviewMixedPointsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *QueryPreviewMixedPointsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPreviewMixedPointsRequest.Merge(m, src)
+}
+
+func (m *QueryPreviewMixedPointsRequest) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QueryPreviewMixedPointsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPreviewMixedPointsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPreviewMixedPointsRequest proto.InternalMessageInfo
+
+func (m *QueryPreviewMixedPointsRequest) GetStake() uint64 {
+	if m != nil {
+		return m.Stake
+	}
+	return 0
+}
+
+func (m *QueryPreviewMixedPointsRequest) GetEngagement() uint64 {
+	if m != nil {
+		return m.Engagement
+	}
+	return 0
+}
+
+// QueryPreviewMixedPointsResponse is the response type for the
+// Query/PreviewMixedPoints RPC method.
+type QueryPreviewMixedPointsResponse struct {
+	// points is the result of the mixer function
+	Points uint64 `protobuf:"varint,1,opt,name=points,proto3" json:"points,omitempty"`
+}
+
+func (m *QueryPreviewMixedPointsResponse) Reset()         { *m = QueryPreviewMixedPointsResponse{} }
+func (m *QueryPreviewMixedPointsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPreviewMixedPointsResponse) ProtoMessage()    {}
+func (*QueryPreviewMixedPointsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55a2242dcc0e0cfb, []int{25}
+}
+
+func (m *QueryPreviewMixedPointsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryPreviewMixedPointsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPreviewMixedPointsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *QueryPreviewMixedPointsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPreviewMixedPointsResponse.Merge(m, src)
+}
+
+func (m *QueryPreviewMixedPointsResponse) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QueryPreviewMixedPointsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPreviewMixedPointsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPreviewMixedPointsResponse proto.InternalMessageInfo
+
+func (m *QueryPreviewMixedPointsResponse) GetPoints() uint64 {
+	if m != nil {
+		return m.Points
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*QueryContractAddressRequest)(nil), "confio.poe.v1beta1.QueryContractAddressRequest")
+	proto.RegisterType((*QueryContractAddressResponse)(nil), "confio.poe.v1beta1.QueryContractAddressResponse")
+	proto.RegisterType((*QueryUnbondingPeriodRequest)(nil), "confio.poe.v1beta1.QueryUnbondingPeriodRequest")
+	proto.RegisterType((*QueryUnbondingPeriodResponse)(nil), "confio.poe.v1beta1.QueryUnbondingPeriodResponse")
+	proto.RegisterType((*QueryValidatorDelegationRequest)(nil), "confio.poe.v1beta1.QueryValidatorDelegationRequest")
+	proto.RegisterType((*QueryValidatorDelegationResponse)(nil), "confio.poe.v1beta1.QueryValidatorDelegationResponse")
+	proto.RegisterType((*QueryValidatorUnbondingDelegationsRequest)(nil), "confio.poe.v1beta1.QueryValidatorUnbondingDelegationsRequest")
+	proto.RegisterType((*QueryValidatorUnbondingDelegationsResponse)(nil), "confio.poe.v1beta1.QueryValidatorUnbondingDelegationsResponse")
+	proto.RegisterType((*QueryValidatorOutstandingRewardRequest)(nil), "confio.poe.v1beta1.QueryValidatorOutstandingRewardRequest")
+	proto.RegisterType((*QueryValidatorOutstandingRewardResponse)(nil), "confio.poe.v1beta1.QueryValidatorOutstandingRewardResponse")
+	proto.RegisterType((*QueryValidatorEngagementRewardRequest)(nil), "confio.poe.v1beta1.QueryValidatorEngagementRewardRequest")
+	proto.RegisterType((*QueryValidatorEngagementRewardResponse)(nil), "confio.poe.v1beta1.QueryValidatorEngagementRewardResponse")
+	proto.RegisterType((*QueryEngagementPointsRequest)(nil), "confio.poe.v1beta1.QueryEngagementPointsRequest")
+	proto.RegisterType((*QueryEngagementPointsResponse)(nil), "confio.poe.v1beta1.QueryEngagementPointsResponse")
+	proto.RegisterType((*QueryEngagementMembersRequest)(nil), "confio.poe.v1beta1.QueryEngagementMembersRequest")
+	proto.RegisterType((*QueryEngagementMembersResponse)(nil), "confio.poe.v1beta1.QueryEngagementMembersResponse")
+	proto.RegisterType((*EngagementMember)(nil), "confio.poe.v1beta1.EngagementMember")
+	proto.RegisterType((*QueryValidatorJailStatusRequest)(nil), "confio.poe.v1beta1.QueryValidatorJailStatusRequest")
+	proto.RegisterType((*QueryValidatorJailStatusResponse)(nil), "confio.poe.v1beta1.QueryValidatorJailStatusResponse")
+	proto.RegisterType((*QueryEpochInfoRequest)(nil), "confio.poe.v1beta1.QueryEpochInfoRequest")
+	proto.RegisterType((*QueryEpochInfoResponse)(nil), "confio.poe.v1beta1.QueryEpochInfoResponse")
+	proto.RegisterType((*QuerySlashingEventsRequest)(nil), "confio.poe.v1beta1.QuerySlashingEventsRequest")
+	proto.RegisterType((*QuerySlashingEventsResponse)(nil), "confio.poe.v1beta1.QuerySlashingEventsResponse")
+	proto.RegisterType((*SlashingEvent)(nil), "confio.poe.v1beta1.SlashingEvent")
+	proto.RegisterType((*QueryPreviewMixedPointsRequest)(nil), "confio.poe.v1beta1.QueryPreviewMixedPointsRequest")
+	proto.RegisterType((*QueryPreviewMixedPointsResponse)(nil), "confio.poe.v1beta1.QueryPreviewMixedPointsResponse")
+}
+
+func init() { proto.RegisterFile("confio/poe/v1beta1/query.proto", fileDescriptor_55a2242dcc0e0cfb) }
+
+var fileDescriptor_55a2242dcc0e0cfb = []byte{
+	// 1763 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0xdd, 0x6b, 0x24, 0xc7,
+	0x11, 0xd7, 0xe8, 0x5b, 0xa5, 0x8f, 0xb3, 0x3b, 0x67, 0x65, 0x6f, 0x4e, 0xda, 0x95, 0xe7, 0x4e,
+	0xb2, 0x2c, 0xc7, 0x33, 0x27, 0xe9, 0xc8, 0xf9, 0xe4, 0xd8, 0x21, 0xfa, 0xb2, 0x12, 0xee, 0x88,
+	0xb2, 0x3e, 0x39, 0x60, 0x08, 0xcb, 0xec, 0x6e, 0x6b, 0x34, 0xb9, 0xdd, 0xe9, 0xbd, 0x99, 0x5e,
+	0x59, 0x42, 0xe8, 0x25, 0x10, 0xc8, 0xa3, 0x21, 0x04, 0xf2, 0x01, 0xc1, 0xc4, 0x01, 0x83, 0x21,
+	0x38, 0x7f, 0x41, 0x9e, 0x12, 0xe2, 0x47, 0x43, 0x5e, 0x42, 0x1e, 0xce, 0xe6, 0xe4, 0x87, 0xbc,
+	0xe4, 0x25, 0x7f, 0x41, 0xe8, 0x9e, 0xea, 0xd9, 0x9d, 0xdd, 0x19, 0xed, 0xac, 0x6c, 0xc8, 0x93,
+	0xb6, 0xbb, 0xfa, 0x57, 0xf5, 0xab, 0xea, 0xea, 0xaa, 0x29, 0x04, 0xf9, 0x0a, 0xf3, 0x0e, 0x5d,
+	0x66, 0x35, 0x18, 0xb5, 0x8e, 0x57, 0xcb, 0x94, 0xdb, 0xab, 0xd6, 0x93, 0x26, 0xf5, 0x4f, 0xcd,
+	0x86, 0xcf, 0x38, 0x23, 0x24, 0x94, 0x9b, 0x0d, 0x46, 0x4d, 0x94, 0xeb, 0x2b, 0x15, 0x16, 0xd4,
+	0x59, 0x60, 0x95, 0xed, 0x80, 0x86, 0x87, 0x23, 0x68, 0xc3, 0x76, 0x5c, 0xcf, 0xe6, 0x2e, 0xf3,
+	0x42, 0xbc, 0x7e, 0xdd, 0x61, 0x0e, 0x93, 0x3f, 0x2d, 0xf1, 0x0b, 0x77, 0xf3, 0x0e, 0x63, 0x4e,
+	0x8d, 0x5a, 0x72, 0x55, 0x6e, 0x1e, 0x5a, 0xd5, 0xa6, 0xdf, 0x8e, 0x2a, 0x74, 0xca, 0xb9, 0x5b,
+	0xa7, 0x01, 0xb7, 0xeb, 0x0d, 0x3c, 0x30, 0x87, 0x07, 0xec, 0x86, 0x6b, 0xd9, 0x9e, 0xc7, 0xb8,
+	0x44, 0x07, 0x4a, 0x9a, 0xe0, 0x94, 0x70, 0x00, 0x8d, 0xb7, 0xd3, 0x57, 0xe2, 0x0a, 0x73, 0x95,
+	0xf1, 0xdb, 0x28, 0x0f, 0xb8, 0xfd, 0xd8, 0xf5, 0x9c, 0xe8, 0x08, 0xae, 0xf1, 0x94, 0x91, 0x72,
+	0xaa, 0x2d, 0x78, 0xc6, 0x13, 0xb8, 0xf9, 0x23, 0xb1, 0xdc, 0x62, 0x1e, 0xf7, 0xed, 0x0a, 0xff,
+	0x5e, 0xb5, 0xea, 0xd3, 0x20, 0x28, 0xd2, 0x27, 0x4d, 0x1a, 0x70, 0xb2, 0x07, 0xd3, 0x15, 0x94,
+	0x94, 0xf8, 0x69, 0x83, 0xe6, 0xb4, 0x05, 0x6d, 0x79, 0x66, 0xed, 0x96, 0xd9, 0x1d, 0x73, 0x73,
+	0x9f, 0xed, 0x28, 0x2d, 0x8f, 0x4e, 0x1b, 0xb4, 0x38, 0x55, 0x69, 0x5b, 0x6d, 0x8c, 0xff, 0xe2,
+	0x83, 0xc2, 0xc0, 0xbf, 0x3f, 0x28, 0x0c, 0x18, 0xaf, 0xc1, 0x5c, 0xb2, 0xc9, 0xa0, 0xc1, 0xbc,
+	0x80, 0x92, 0x1c, 0x8c, 0xd9, 0xe1, 0x96, 0xb4, 0x36, 0x51, 0x54, 0x4b, 0x63, 0x1e, 0xc9, 0x1e,
+	0x78, 0x65, 0xe6, 0x55, 0x5d, 0xcf, 0xd9, 0xa7, 0xbe, 0xcb, 0xaa, 0x48, 0xd6, 0xf8, 0x31, 0xcc,
+	0x25, 0x8b, 0x51, 0xf1, 0x3d, 0x18, 0x16, 0x97, 0x24, 0xb5, 0x4e, 0xae, 0xdd, 0x30, 0xc3, 0x0b,
+	0x32, 0xd5, 0x0d, 0x9a, 0xdb, 0x78, 0xc3, 0x9b, 0xe3, 0x9f, 0x3e, 0x2d, 0x0c, 0xfc, 0xfa, 0xf3,
+	0x82, 0x56, 0x94, 0x00, 0x63, 0x0f, 0x0a, 0x52, 0xf1, 0x3b, 0x76, 0xcd, 0xad, 0xda, 0x9c, 0xf9,
+	0xdb, 0xb4, 0x46, 0x1d, 0x79, 0x56, 0x05, 0x6a, 0x11, 0x66, 0x8e, 0x95, 0xb4, 0x24, 0xf8, 0x22,
+	0xf7, 0xe9, 0x68, 0x57, 0xb8, 0x69, 0xfc, 0x04, 0x16, 0xd2, 0x35, 0x21, 0xcd, 0xfb, 0x30, 0x56,
+	0xb6, 0x6b, 0xb6, 0x57, 0x69, 0x31, 0x0d, 0x2f, 0xd2, 0x14, 0xe9, 0x10, 0x85, 0x7b, 0x8b, 0xb9,
+	0xde, 0xe6, 0xb0, 0x60, 0x5a, 0x54, 0xe7, 0x8d, 0xdf, 0x6a, 0xf0, 0x72, 0x5c, 0x7f, 0x14, 0x8b,
+	0x96, 0xa1, 0xa0, 0x3f, 0xce, 0x64, 0x17, 0xa0, 0xf5, 0x66, 0x72, 0x83, 0x92, 0xd2, 0x52, 0x8c,
+	0x52, 0x98, 0x50, 0x51, 0x1e, 0xd8, 0x0e, 0x45, 0x13, 0xc5, 0x36, 0xa4, 0xf1, 0x77, 0x0d, 0x56,
+	0xb2, 0x90, 0xc3, 0x30, 0xec, 0xc3, 0x18, 0xf5, 0xb8, 0xef, 0x52, 0x91, 0x06, 0x43, 0xcb, 0x93,
+	0x6b, 0x77, 0x94, 0x4d, 0x95, 0xe5, 0xca, 0x60, 0x82, 0x9a, 0x1d, 0x8f, 0xfb, 0xa7, 0x2a, 0x3a,
+	0xa8, 0x86, 0xbc, 0x95, 0xe0, 0xc8, 0x4b, 0x3d, 0x1d, 0x09, 0xe9, 0xc4, 0x3c, 0x39, 0x80, 0xa5,
+	0xb8, 0x23, 0x3f, 0x6c, 0xf2, 0x80, 0xdb, 0x92, 0x43, 0x91, 0xbe, 0x67, 0xfb, 0x2a, 0x25, 0xc9,
+	0x2b, 0xf0, 0x7c, 0x3c, 0xc4, 0xad, 0xac, 0x7e, 0x2e, 0x16, 0x65, 0x91, 0xde, 0x7f, 0xd4, 0xe0,
+	0xa5, 0x9e, 0x7a, 0x31, 0x3a, 0xa7, 0x30, 0xea, 0xcb, 0x1d, 0xcc, 0x91, 0xb9, 0xc4, 0x1c, 0xd9,
+	0xa6, 0x15, 0x99, 0x26, 0x5b, 0x22, 0x10, 0xff, 0x7d, 0x5a, 0x98, 0x3e, 0xb5, 0xeb, 0xb5, 0x0d,
+	0x23, 0x44, 0x1a, 0x1f, 0x7f, 0x5e, 0x58, 0x71, 0x5c, 0x7e, 0xd4, 0x2c, 0x9b, 0x15, 0x56, 0xb7,
+	0xb0, 0x5a, 0x84, 0x7f, 0x5e, 0x0d, 0xaa, 0x8f, 0x2d, 0xf1, 0xe2, 0x03, 0xa5, 0xa4, 0x88, 0x06,
+	0x8d, 0x47, 0xb0, 0x18, 0x67, 0xb9, 0xe3, 0x39, 0xb6, 0x43, 0xeb, 0xd4, 0xe3, 0x5f, 0xc1, 0xf9,
+	0x0f, 0x35, 0x58, 0xea, 0xa5, 0xf6, 0xff, 0xef, 0xbb, 0xaa, 0x5d, 0x2d, 0x6e, 0xfb, 0xcc, 0xf5,
+	0x78, 0xf4, 0xa4, 0xd2, 0x6b, 0xd7, 0x3d, 0x98, 0x4f, 0x41, 0xa2, 0x57, 0xb3, 0x30, 0xda, 0x90,
+	0x3b, 0x12, 0x39, 0x5c, 0xc4, 0x95, 0xe1, 0x74, 0x01, 0x1f, 0xd2, 0x7a, 0x99, 0xfa, 0x91, 0xcd,
+	0xf8, 0xfb, 0xd4, 0xae, 0xfc, 0x3e, 0x3f, 0xd1, 0x20, 0x9f, 0x66, 0x09, 0x39, 0x6e, 0xc3, 0x58,
+	0x3d, 0xdc, 0xc2, 0x37, 0x79, 0x3b, 0xa9, 0x11, 0x74, 0xe2, 0xd5, 0x3b, 0x44, 0xe8, 0xd7, 0xf7,
+	0x0e, 0xb7, 0xe1, 0xb9, 0x4e, 0x5b, 0xe9, 0x37, 0xd0, 0x16, 0xe0, 0xc1, 0x58, 0x80, 0xbb, 0xaa,
+	0xfb, 0x0f, 0x6c, 0xb7, 0xf6, 0x36, 0xb7, 0x79, 0xb3, 0xcf, 0x4a, 0x69, 0x5c, 0x68, 0xb0, 0x90,
+	0xae, 0xaa, 0x75, 0xcf, 0x3f, 0xb5, 0xdd, 0x1a, 0x0d, 0xb3, 0x77, 0xbc, 0x88, 0x2b, 0xf2, 0x6d,
+	0x18, 0x09, 0xb8, 0xed, 0x73, 0x0c, 0x88, 0xde, 0xd5, 0x9e, 0x1e, 0xa9, 0x0f, 0x8c, 0xcd, 0xe1,
+	0xf7, 0x45, 0x6f, 0x0a, 0x8f, 0x0b, 0x5c, 0xd3, 0xe3, 0x6e, 0x2d, 0x37, 0x94, 0x15, 0x27, 0x8f,
+	0x8b, 0x40, 0x1d, 0x32, 0x9f, 0x1e, 0x53, 0x3f, 0x37, 0x2c, 0x89, 0xa8, 0x25, 0x99, 0x07, 0xa8,
+	0xd8, 0x5e, 0xa9, 0xe9, 0x09, 0x66, 0xb9, 0x11, 0x29, 0x9c, 0xa8, 0xd8, 0xde, 0x81, 0xdc, 0x30,
+	0xbe, 0x09, 0x2f, 0x84, 0x69, 0xd2, 0x60, 0x95, 0xa3, 0xef, 0x7b, 0x87, 0x4c, 0xf5, 0xdf, 0x3f,
+	0x0d, 0xc1, 0x6c, 0xa7, 0x04, 0x9d, 0xbe, 0x05, 0xd3, 0x95, 0xa6, 0xef, 0x53, 0x8f, 0x97, 0xa8,
+	0x10, 0x62, 0x8e, 0x4f, 0xe1, 0xa6, 0x04, 0x90, 0x5d, 0x98, 0x92, 0xc2, 0x52, 0x8d, 0x7a, 0x0e,
+	0x3f, 0xca, 0x0d, 0x66, 0xef, 0xd3, 0x93, 0x12, 0xf8, 0x40, 0xe2, 0xc8, 0x03, 0xb8, 0xe6, 0xd1,
+	0x13, 0xb4, 0x54, 0x92, 0x2d, 0xbf, 0x77, 0x6c, 0xa4, 0x2e, 0x19, 0x9f, 0x69, 0x01, 0x96, 0x8c,
+	0x84, 0x94, 0x6c, 0x2a, 0x56, 0x58, 0x73, 0x86, 0xb3, 0xf5, 0xe4, 0x90, 0x51, 0x58, 0xb9, 0xc8,
+	0x6b, 0x90, 0xab, 0xd9, 0x01, 0x2f, 0x55, 0xdd, 0x80, 0xfb, 0x6e, 0xb9, 0x29, 0xd8, 0x97, 0x8e,
+	0xa8, 0xeb, 0x1c, 0x71, 0x19, 0xdf, 0xe1, 0xe2, 0xac, 0x90, 0x6f, 0xb7, 0x89, 0xf7, 0xa4, 0x94,
+	0xbc, 0x0b, 0xb3, 0xdd, 0x48, 0xe9, 0xd2, 0x68, 0x1f, 0x2e, 0x5d, 0xef, 0xd4, 0x2e, 0x0e, 0x19,
+	0x7f, 0xd0, 0x40, 0x97, 0xf7, 0xf5, 0x76, 0xcd, 0x0e, 0x8e, 0x5c, 0xcf, 0xd9, 0x39, 0xa6, 0x6d,
+	0xb5, 0x6c, 0x1e, 0xa0, 0xee, 0x46, 0x34, 0xc3, 0x0b, 0x9b, 0xa8, 0xbb, 0x8a, 0x99, 0x10, 0xdb,
+	0x27, 0x4a, 0x3c, 0x88, 0x62, 0xfb, 0x04, 0xc5, 0xf1, 0xaa, 0x34, 0x74, 0xe5, 0xaa, 0xf4, 0x91,
+	0x06, 0x37, 0x13, 0x49, 0x62, 0x66, 0x7d, 0x17, 0x46, 0xa9, 0xdc, 0xc1, 0x8a, 0xf4, 0x62, 0x52,
+	0x45, 0x8a, 0x61, 0xf1, 0x82, 0x10, 0xf6, 0xf5, 0x55, 0xa3, 0xdf, 0x0c, 0xc2, 0x74, 0xcc, 0x10,
+	0xd1, 0x61, 0x9c, 0x35, 0xa8, 0x2f, 0x0a, 0x01, 0x16, 0x8c, 0x68, 0x2d, 0xca, 0x40, 0x2c, 0x74,
+	0xb8, 0x22, 0x7b, 0x30, 0xd6, 0x60, 0x7e, 0x14, 0xb4, 0x89, 0x4d, 0x53, 0xb0, 0xfd, 0xd7, 0xd3,
+	0xc2, 0x52, 0xb6, 0x76, 0x55, 0x54, 0x70, 0x92, 0x07, 0xe0, 0xac, 0x5e, 0x0e, 0x38, 0xf3, 0x68,
+	0x15, 0xdf, 0x78, 0xdb, 0x0e, 0xd9, 0x82, 0xa9, 0xb0, 0xf4, 0x94, 0xc2, 0xfa, 0x31, 0x92, 0xb1,
+	0x7e, 0x4c, 0x86, 0xa8, 0x03, 0x59, 0x45, 0x16, 0x61, 0x06, 0x95, 0xa8, 0x62, 0x32, 0x2a, 0x0d,
+	0x4d, 0x87, 0xbb, 0xbb, 0xe1, 0xa6, 0xf1, 0x0e, 0xb6, 0x96, 0x7d, 0x9f, 0x1e, 0xbb, 0xf4, 0xbd,
+	0x87, 0xee, 0x09, 0xad, 0xc6, 0x3b, 0xe7, 0x75, 0x59, 0xfe, 0x1e, 0x53, 0x4c, 0xb4, 0x70, 0x21,
+	0x7c, 0xa0, 0x51, 0x85, 0xc7, 0x48, 0xb5, 0xed, 0x18, 0xf7, 0xa1, 0x90, 0xaa, 0xf7, 0xf2, 0xbe,
+	0xba, 0xf6, 0x97, 0x17, 0x60, 0x44, 0x62, 0xc9, 0xc7, 0x1a, 0x5c, 0xeb, 0x18, 0x46, 0x88, 0x95,
+	0x94, 0x46, 0x97, 0x4c, 0x4a, 0xfa, 0x9d, 0xec, 0x80, 0x90, 0x98, 0x71, 0xf7, 0x67, 0xff, 0xf8,
+	0xf2, 0x97, 0x83, 0x26, 0xf9, 0x96, 0xc5, 0x1d, 0xdf, 0xae, 0xd2, 0xd8, 0x2c, 0xa8, 0x66, 0x27,
+	0xeb, 0x2c, 0x36, 0x7f, 0x9d, 0x93, 0x5f, 0x69, 0x00, 0x51, 0x7b, 0x09, 0x88, 0x99, 0xf6, 0x51,
+	0x1c, 0xef, 0x43, 0x11, 0x4d, 0x2b, 0xf3, 0x79, 0x64, 0xb9, 0x24, 0x59, 0x2e, 0x90, 0x7c, 0x12,
+	0xcb, 0xe3, 0x16, 0x91, 0x0f, 0x35, 0x98, 0x88, 0xe0, 0xe4, 0xd5, 0x6c, 0x66, 0x14, 0x2b, 0x33,
+	0xeb, 0x71, 0x24, 0x75, 0x4f, 0x92, 0x5a, 0x25, 0xd6, 0xe5, 0xa4, 0xac, 0xb3, 0x78, 0xd7, 0x3e,
+	0x27, 0xbf, 0xd3, 0xe0, 0x5a, 0xc7, 0x78, 0x78, 0xc9, 0x55, 0x27, 0xcf, 0x99, 0xfa, 0x9d, 0xec,
+	0x00, 0xe4, 0xbb, 0x28, 0xf9, 0x16, 0xc8, 0x7c, 0x12, 0xdf, 0xa6, 0x02, 0x91, 0xbf, 0x6a, 0xf0,
+	0x8d, 0x84, 0xc9, 0x90, 0xac, 0xa7, 0x1a, 0x4c, 0x9f, 0x48, 0xf5, 0xbb, 0xfd, 0x81, 0x90, 0xe9,
+	0xa6, 0x64, 0xfa, 0x1d, 0xb2, 0x21, 0x29, 0x22, 0xdb, 0x0c, 0x91, 0xb5, 0xaa, 0x2d, 0xba, 0xff,
+	0xd1, 0x60, 0xfe, 0xd2, 0x19, 0x8f, 0xbc, 0xd1, 0x9b, 0xdb, 0x25, 0x83, 0xab, 0xfe, 0xe6, 0x55,
+	0xe1, 0xe8, 0xe4, 0x43, 0xe9, 0xe4, 0x5b, 0x64, 0xa7, 0xcf, 0xf4, 0x69, 0x5d, 0x55, 0xa9, 0xda,
+	0xe6, 0xcd, 0x27, 0x1a, 0xcc, 0xec, 0xb9, 0x01, 0x67, 0xbe, 0x5b, 0xb1, 0x6b, 0xe2, 0xbb, 0x87,
+	0xac, 0x5d, 0x9a, 0xd0, 0xf1, 0xc3, 0xca, 0xab, 0xf5, 0xbe, 0x30, 0x59, 0x8a, 0xc8, 0x51, 0x84,
+	0x29, 0xb9, 0xde, 0x21, 0xb3, 0xce, 0xc2, 0x1e, 0x73, 0x4e, 0xbe, 0xd4, 0x40, 0x4f, 0x1f, 0x32,
+	0xc9, 0x46, 0xef, 0xf8, 0xa6, 0x4d, 0xbc, 0xfa, 0xeb, 0x57, 0xc2, 0x7e, 0xb5, 0x8b, 0xa1, 0x41,
+	0x70, 0x6e, 0xb1, 0x96, 0x56, 0xfc, 0x54, 0x23, 0x5f, 0x68, 0x70, 0x23, 0x75, 0x9c, 0x24, 0xf7,
+	0x7b, 0x33, 0x4d, 0x99, 0x6c, 0xf5, 0x8d, 0xab, 0x40, 0xd1, 0xc7, 0x07, 0xd2, 0xc7, 0x5d, 0xb2,
+	0x7d, 0x05, 0x1f, 0x5b, 0x9d, 0x4f, 0xb9, 0xf8, 0x67, 0xad, 0x7d, 0x06, 0x0a, 0x5b, 0x1f, 0x49,
+	0x2f, 0x50, 0x29, 0x73, 0xab, 0xbe, 0xda, 0x07, 0x22, 0x4b, 0x0d, 0x6e, 0x23, 0x1a, 0xb6, 0x5b,
+	0xeb, 0x4c, 0x39, 0x21, 0xda, 0xed, 0xf3, 0x5d, 0x23, 0x26, 0xc9, 0xc2, 0x20, 0x3e, 0xf8, 0xea,
+	0x6b, 0xfd, 0x40, 0x90, 0xb5, 0x29, 0x59, 0x2f, 0x93, 0xa5, 0x1e, 0xac, 0xd5, 0xac, 0xfa, 0xb7,
+	0xf6, 0x92, 0xdc, 0x9a, 0xe6, 0xb2, 0x94, 0xe4, 0xae, 0x31, 0x52, 0xbf, 0xdb, 0x1f, 0x08, 0x29,
+	0x6f, 0x49, 0xca, 0x6f, 0x90, 0xd7, 0xfb, 0xad, 0x56, 0xe2, 0x13, 0xac, 0x14, 0x84, 0x7c, 0x7f,
+	0xae, 0xc1, 0x44, 0x34, 0x96, 0x91, 0x97, 0xd3, 0x23, 0xd7, 0x31, 0xd4, 0xe9, 0x2b, 0x59, 0x8e,
+	0x22, 0xd3, 0x17, 0x25, 0xd3, 0x9b, 0xe4, 0x46, 0x62, 0x70, 0xc5, 0x71, 0xf2, 0x7b, 0x0d, 0x66,
+	0xe2, 0x5f, 0xf2, 0xc4, 0x4c, 0xb5, 0x90, 0x38, 0x97, 0xe8, 0x56, 0xe6, 0xf3, 0x48, 0xeb, 0x15,
+	0x49, 0x6b, 0x91, 0xdc, 0x4a, 0xa2, 0x15, 0x20, 0xa6, 0x84, 0xe3, 0xc0, 0x47, 0x1a, 0x90, 0xee,
+	0xaf, 0x49, 0x92, 0x9e, 0x6b, 0xa9, 0x9f, 0xb4, 0xfa, 0x7a, 0x5f, 0x18, 0x24, 0xbb, 0x2c, 0xc9,
+	0x1a, 0x64, 0x21, 0x89, 0x6c, 0x5d, 0x00, 0xf0, 0x45, 0x6d, 0xbe, 0xf9, 0xe9, 0xb3, 0xbc, 0xf6,
+	0xd9, 0xb3, 0xbc, 0xf6, 0xc5, 0xb3, 0xbc, 0xf6, 0xfe, 0x45, 0x7e, 0xe0, 0xb3, 0x8b, 0xfc, 0xc0,
+	0x3f, 0x2f, 0xf2, 0x03, 0xef, 0xde, 0x8e, 0x8d, 0x0a, 0xf2, 0xff, 0x0c, 0xa8, 0xec, 0x44, 0xaa,
+	0x93, 0xc3, 0x42, 0x79, 0x54, 0x7e, 0xe1, 0xaf, 0xff, 0x6f, 0x00, 0x6a, 0xba, 0x19, 0xea, 0x60,
+	0x19, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// ContractAddress queries the address for one of the PoE contracts
+	ContractAddress(ctx context.Context, in *QueryContractAddressRequest, opts ...grpc.CallOption) (*QueryContractAddressResponse, error)
+	// Validators queries all validators that match the given status.
+	Validators(ctx context.Context, in *types1.QueryValidatorsRequest, opts ...grpc.CallOption) (*types1.QueryValidatorsResponse, error)
+	// Validator queries validator info for given validator address.
+	Validator(ctx context.Context, in *types1.QueryValidatorRequest, opts ...grpc.CallOption) (*types1.QueryValidatorResponse, error)
+	// Validator queries validator info for given validator address.
+	UnbondingPeriod(ctx context.Context, in *QueryUnbondingPeriodRequest, opts ...grpc.CallOption) (*QueryUnbondingPeriodResponse, error)
+	// ValidatorDelegation queries self delegated amount for given validator.
+	ValidatorDelegation(ctx context.Context, in *QueryValidatorDelegationRequest, opts ...grpc.CallOption) (*QueryValidatorDelegationResponse, error)
+	// ValidatorUnbondingDelegations queries unbonding delegations of a validator.
+	ValidatorUnbondingDelegations(ctx context.Context, in *QueryValidatorUnbondingDelegationsRequest, opts ...grpc.CallOption) (*QueryValidatorUnbondingDelegationsResponse, error)
+	// HistoricalInfo queries the historical info for given height.
+	HistoricalInfo(ctx context.Context, in *types1.QueryHistoricalInfoRequest, opts ...grpc.CallOption) (*types1.QueryHistoricalInfoResponse, error)
+	// ValidatorOutstandingRewards queries rewards of a validator address.
+	ValidatorOutstandingReward(ctx context.Context, in *QueryValidatorOutstandingRewardRequest, opts ...grpc.CallOption) (*QueryValidatorOutstandingRewardResponse, error)
+	// ValidatorEngagementReward queries rewards of a validator address.
+	ValidatorEngagementReward(ctx context.Context, in *QueryValidatorEngagementRewardRequest, opts ...grpc.CallOption) (*QueryValidatorEngagementRewardResponse, error)
+	// EngagementPoints queries the engagement points of an address.
+	EngagementPoints(ctx context.Context, in *QueryEngagementPointsRequest, opts ...grpc.CallOption) (*QueryEngagementPointsResponse, error)
+	// EngagementMembers queries all engagement group members ordered by points.
+	EngagementMembers(ctx context.Context, in *QueryEngagementMembersRequest, opts ...grpc.CallOption) (*QueryEngagementMembersResponse, error)
+	// ValidatorJailStatus queries the jail status of a validator.
+	ValidatorJailStatus(ctx context.Context, in *QueryValidatorJailStatusRequest, opts ...grpc.CallOption) (*QueryValidatorJailStatusResponse, error)
+	// EpochInfo queries the current valset epoch and reward information.
+	EpochInfo(ctx context.Context, in *QueryEpochInfoRequest, opts ...grpc.CallOption) (*QueryEpochInfoResponse, error)
+	// SlashingEvents queries the slashing history of all validators.
+	SlashingEvents(ctx context.Context, in *QuerySlashingEventsRequest, opts ...grpc.CallOption) (*QuerySlashingEventsResponse, error)
+	// PreviewMixedPoints queries the validator points that the mixer function
+	// calculates for the given stake and engagement points.
+	PreviewMixedPoints(ctx context.Context, in *QueryPreviewMixedPointsRequest, opts ...grpc.CallOption) (*QueryPreviewMixedPointsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) ContractAddress(ctx context.Context, in *QueryContractAddressRequest, opts ...grpc.CallOption) (*QueryContractAddressResponse, error) {
+	out := new(QueryContractAddressResponse)
+	err := c.cc.Invoke(ctx, "/confio.poe.v1beta1.Query/ContractAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Validators(ctx context.Context, in *types1.QueryValidatorsRequest, opts ...grpc.CallOption) (*types1.QueryValidatorsResponse, error) {
+	out := new(types1.QueryValidatorsResponse)
+	err := c.cc.Invoke(ctx, "/confio.poe.v1beta1.Query/Validators", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Validator(ctx context.Context, in *types1.QueryValidatorRequest, opts ...grpc.CallOption) (*types1.QueryValidatorResponse, error) {
+	out := new(types1.QueryValidatorResponse)
+	err := c.cc.Invoke(ctx, "/confio.poe.v1beta1.Query/Validator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) UnbondingPeriod(ctx context.Context, in *QueryUnbondingPeriodRequest, opts ...grpc.CallOption) (*QueryUnbondingPeriodResponse, error) {
+	out := new(QueryUnbondingPeriodResponse)
+	err := c.cc.Invoke(ctx, "/confio.poe.v1beta1.Query/UnbondingPeriod", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorDelegation(ctx context.Context, in *QueryValidatorDelegationRequest, opts ...grpc.CallOption) (*QueryValidatorDelegationResponse, error) {
+	out := new(QueryValidatorDelegationResponse)
+	err := c.cc.Invoke(ctx, "/confio.poe.v1beta1.Query/ValidatorDelegation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorUnbondingDelegations(ctx context.Context, in *QueryValidatorUnbondingDelegationsRequest, opts ...grpc.CallOption) (*QueryValidatorUnbondingDelegationsResponse, error) {
+	out := new(QueryValidatorUnbondingDelegationsResponse)
+	err := c.cc.Invoke(ctx, "/confio.poe.v1beta1.Query/ValidatorUnbondingDelegations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) HistoricalInfo(ctx context.Context, in *types1.QueryHistoricalInfoRequest, opts ...grpc.CallOption) (*types1.QueryHistoricalInfoResponse, error) {
+	out := new(types1.QueryHistoricalInfoResponse)
+	err := c.cc.Invoke(ctx, "/confio.poe.v1beta1.Query/HistoricalInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorOutstandingReward(ctx context.Context, in *QueryValidatorOutstandingRewardRequest, opts ...grpc.CallOption) (*QueryValidatorOutstandingRewardResponse, error) {
+	out := new(QueryValidatorOutstandingRewardResponse)
+	err := c.cc.Invoke(ctx, "/confio.poe.v1beta1.Query/ValidatorOutstandingReward", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorEngagementReward(ctx context.Context, in *QueryValidatorEngagementRewardRequest, opts ...grpc.CallOption) (*QueryValidatorEngagementRewardResponse, error) {
+	out := new(QueryValidatorEngagementRewardResponse)
+	err := c.cc.Invoke(ctx, "/confio.poe.v1beta1.Query/ValidatorEngagementReward", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EngagementPoints(ctx context.Context, in *QueryEngagementPointsRequest, opts ...grpc.CallOption) (*QueryEngagementPointsResponse, error) {
+	out := new(QueryEngagementPointsResponse)
+	err := c.cc.Invoke(ctx, "/confio.poe.v1beta1.Query/EngagementPoints", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EngagementMembers(ctx context.Context, in *QueryEngagementMembersRequest, opts ...grpc.CallOption) (*QueryEngagementMembersResponse, error) {
+	out := new(QueryEngagementMembersResponse)
+	err := c.cc.Invoke(ctx, "/confio.poe.v1beta1.Query/EngagementMembers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorJailStatus(ctx context.Context, in *QueryValidatorJailStatusRequest, opts ...grpc.CallOption) (*QueryValidatorJailStatusResponse, error) {
+	out := new(QueryValidatorJailStatusResponse)
+	err := c.cc.Invoke(ctx, "/confio.poe.v1beta1.Query/ValidatorJailStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EpochInfo(ctx context.Context, in *QueryEpochInfoRequest, opts ...grpc.CallOption) (*QueryEpochInfoResponse, error) {
+	out := new(QueryEpochInfoResponse)
+	err := c.cc.Invoke(ctx, "/confio.poe.v1beta1.Query/EpochInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SlashingEvents(ctx context.Context, in *QuerySlashingEventsRequest, opts ...grpc.CallOption) (*QuerySlashingEventsResponse, error) {
+	out := new(QuerySlashingEventsResponse)
+	err := c.cc.Invoke(ctx, "/confio.poe.v1beta1.Query/SlashingEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PreviewMixedPoints(ctx context.Context, in *QueryPreviewMixedPointsRequest, opts ...grpc.CallOption) (*QueryPreviewMixedPointsResponse, error) {
+	out := new(QueryPreviewMixedPointsResponse)
+	err := c.cc.Invoke(ctx, "/confio.poe.v1beta1.Query/PreviewMixedPoints", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// ContractAddress queries the address for one of the PoE contracts
+	ContractAddress(context.Context, *QueryContractAddressRequest) (*QueryContractAddressResponse, error)
+	// Validators queries all validators that match the given status.
+	Validators(context.Context, *types1.QueryValidatorsRequest) (*types1.QueryValidatorsResponse, error)
+	// Validator queries validator info for given validator address.
+	Validator(context.Context, *types1.QueryValidatorRequest) (*types1.QueryValidatorResponse, error)
+	// Validator queries validator info for given validator address.
+	UnbondingPeriod(context.Context, *QueryUnbondingPeriodRequest) (*QueryUnbondingPeriodResponse, error)
+	// ValidatorDelegation queries self delegated amount for given validator.
+	ValidatorDelegation(context.Context, *QueryValidatorDelegationRequest) (*QueryValidatorDelegationResponse, error)
+	// ValidatorUnbondingDelegations queries unbonding delegations of a validator.
+	ValidatorUnbondingDelegations(context.Context, *QueryValidatorUnbondingDelegationsRequest) (*QueryValidatorUnbondingDelegationsResponse, error)
+	// HistoricalInfo queries the historical info for given height.
+	HistoricalInfo(context.Context, *types1.QueryHistoricalInfoRequest) (*types1.QueryHistoricalInfoResponse, error)
+	// ValidatorOutstandingRewards queries rewards of a validator address.
+	ValidatorOutstandingReward(context.Context, *QueryValidatorOutstandingRewardRequest) (*QueryValidatorOutstandingRewardResponse, error)
+	// ValidatorEngagementReward queries rewards of a validator address.
+	ValidatorEngagementReward(context.Context, *QueryValidatorEngagementRewardRequest) (*QueryValidatorEngagementRewardResponse, error)
+	// EngagementPoints queries the engagement points of an address.
+	EngagementPoints(context.Context, *QueryEngagementPointsRequest) (*QueryEngagementPointsResponse, error)
+	// EngagementMembers queries all engagement group members ordered by points.
+	EngagementMembers(context.Context, *QueryEngagementMembersRequest) (*QueryEngagementMembersResponse, error)
+	// ValidatorJailStatus queries the jail status of a validator.
+	ValidatorJailStatus(context.Context, *QueryValidatorJailStatusRequest) (*QueryValidatorJailStatusResponse, error)
+	// EpochInfo queries the current valset epoch and reward information.
+	EpochInfo(context.Context, *QueryEpochInfoRequest) (*QueryEpochInfoResponse, error)
+	// SlashingEvents queries the slashing history of all validators.
+	SlashingEvents(context.Context, *QuerySlashingEventsRequest) (*QuerySlashingEventsResponse, error)
+	// PreviewMixedPoints queries the validator points that the mixer function
+	// calculates for the given stake and engagement points.
+	PreviewMixedPoints(context.Context, *QueryPreviewMixedPointsRequest) (*QueryPreviewMixedPointsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct{}
+
+func (*UnimplementedQueryServer) ContractAddress(ctx context.Context, req *QueryContractAddressRequest) (*QueryContractAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContractAddress not implemented")
+}
+
+func (*UnimplementedQueryServer) Validators(ctx context.Context, req *types1.QueryValidatorsRequest) (*types1.QueryValidatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Validators not implemented")
+}
+
+func (*UnimplementedQueryServer) Validator(ctx context.Context, req *types1.QueryValidatorRequest) (*types1.QueryValidatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Validator not implemented")
+}
+
+func (*UnimplementedQueryServer) UnbondingPeriod(ctx context.Context, req *QueryUnbondingPeriodRequest) (*QueryUnbondingPeriodResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnbondingPeriod not implemented")
+}
+
+func (*UnimplementedQueryServer) ValidatorDelegation(ctx context.Context, req *QueryValidatorDelegationRequest) (*QueryValidatorDelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorDelegation not implemented")
+}
+
+func (*UnimplementedQueryServer) ValidatorUnbondingDelegations(ctx context.Context, req *QueryValidatorUnbondingDelegationsRequest) (*QueryValidatorUnbondingDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorUnbondingDelegations not implemented")
+}
+
+func (*UnimplementedQueryServer) HistoricalInfo(ctx context.Context, req *types1.QueryHistoricalInfoRequest) (*types1.QueryHistoricalInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HistoricalInfo not implemented")
+}
+
+func (*UnimplementedQueryServer) ValidatorOutstandingReward(ctx context.Context, req *QueryValidatorOutstandingRewardRequest) (*QueryValidatorOutstandingRewardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorOutstandingReward not implemented")
+}
+
+func (*UnimplementedQueryServer) ValidatorEngagementReward(ctx context.Context, req *QueryValidatorEngagementRewardRequest) (*QueryValidatorEngagementRewardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorEngagementReward not implemented")
+}
+
+func (*UnimplementedQueryServer) EngagementPoints(ctx context.Context, req *QueryEngagementPointsRequest) (*QueryEngagementPointsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EngagementPoints not implemented")
+}
+
+func (*UnimplementedQueryServer) EngagementMembers(ctx context.Context, req *QueryEngagementMembersRequest) (*QueryEngagementMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EngagementMembers not implemented")
+}
+
+func (*UnimplementedQueryServer) ValidatorJailStatus(ctx context.Context, req *QueryValidatorJailStatusRequest) (*QueryValidatorJailStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorJailStatus not implemented")
+}
+
+func (*UnimplementedQueryServer) EpochInfo(ctx context.Context, req *QueryEpochInfoRequest) (*QueryEpochInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EpochInfo not implemented")
+}
+
+func (*UnimplementedQueryServer) SlashingEvents(ctx context.Context, req *QuerySlashingEventsRequest) (*QuerySlashingEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SlashingEvents not implemented")
+}
+
+func (*UnimplementedQueryServer) PreviewMixedPoints(ctx context.Context, req *QueryPreviewMixedPointsRequest) (*QueryPreviewMixedPointsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewMixedPoints not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_ContractAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryContractAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ContractAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/confio.poe.v1beta1.Query/ContractAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContractAddress(ctx, req.(*QueryContractAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Validators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types1.QueryValidatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Validators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/confio.poe.v1beta1.Query/Validators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Validators(ctx, req.(*types1.QueryValidatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Validator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types1.QueryValidatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Validator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/confio.poe.v1beta1.Query/Validator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Validator(ctx, req.(*types1.QueryValidatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UnbondingPeriod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUnbondingPeriodRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UnbondingPeriod(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/confio.poe.v1beta1.Query/UnbondingPeriod",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UnbondingPeriod(ctx, req.(*QueryUnbondingPeriodRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorDelegationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorDelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/confio.poe.v1beta1.Query/ValidatorDelegation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorDelegation(ctx, req.(*QueryValidatorDelegationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorUnbondingDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorUnbondingDelegationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorUnbondingDelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/confio.poe.v1beta1.Query/ValidatorUnbondingDelegations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorUnbondingDelegations(ctx, req.(*QueryValidatorUnbondingDelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_HistoricalInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types1.QueryHistoricalInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HistoricalInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/confio.poe.v1beta1.Query/HistoricalInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HistoricalInfo(ctx, req.(*types1.QueryHistoricalInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorOutstandingReward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorOutstandingRewardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorOutstandingReward(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/confio.poe.v1beta1.Query/ValidatorOutstandingReward",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorOutstandingReward(ctx, req.(*QueryValidatorOutstandingRewardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorEngagementReward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorEngagementRewardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorEngagementReward(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/confio.poe.v1beta1.Query/ValidatorEngagementReward",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorEngagementReward(ctx, req.(*QueryValidatorEngagementRewardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EngagementPoints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEngagementPointsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EngagementPoints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/confio.poe.v1beta1.Query/EngagementPoints",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EngagementPoints(ctx, req.(*QueryEngagementPointsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EngagementMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEngagementMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EngagementMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/confio.poe.v1beta1.Query/EngagementMembers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EngagementMembers(ctx, req.(*QueryEngagementMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorJailStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorJailStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorJailStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/confio.poe.v1beta1.Query/ValidatorJailStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorJailStatus(ctx, req.(*QueryValidatorJailStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EpochInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEpochInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EpochInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/confio.poe.v1beta1.Query/EpochInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EpochInfo(ctx, req.(*QueryEpochInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SlashingEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySlashingEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SlashingEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/confio.poe.v1beta1.Query/SlashingEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SlashingEvents(ctx, req.(*QuerySlashingEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PreviewMixedPoints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPreviewMixedPointsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PreviewMixedPoints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/confio.poe.v1beta1.Query/PreviewMixedPoints",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PreviewMixedPoints(ctx, req.(*QueryPreviewMixedPointsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "confio.poe.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ContractAddress",
+			Handler:    _Query_ContractAddress_Handler,
+		},
+		{
+			MethodName: "Validators",
+			Handler:    _Query_Validators_Handler,
+		},
+		{
+			MethodName: "Validator",
+			Handler:    _Query_Validator_Handler,
+		},
+		{
+			MethodName: "UnbondingPeriod",
+			Handler:    _Query_UnbondingPeriod_Handler,
+		},
+		{
+			MethodName: "ValidatorDelegation",
+			Handler:    _Query_ValidatorDelegation_Handler,
+		},
+		{
+			MethodName: "ValidatorUnbondingDelegations",
+			Handler:    _Query_ValidatorUnbondingDelegations_Handler,
+		},
+		{
+			MethodName: "HistoricalInfo",
+			Handler:    _Query_HistoricalInfo_Handler,
+		},
+		{
+			MethodName: "ValidatorOutstandingReward",
+			Handler:    _Query_ValidatorOutstandingReward_Handler,
+		},
+		{
+			MethodName: "ValidatorEngagementReward",
+			Handler:    _Query_ValidatorEngagementReward_Handler,
+		},
+		{
+			MethodName: "EngagementPoints",
+			Handler:    _Query_EngagementPoints_Handler,
+		},
+		{
+			MethodName: "EngagementMembers",
+			Handler:    _Query_EngagementMembers_Handler,
+		},
+		{
+			MethodName: "ValidatorJailStatus",
+			Handler:    _Query_ValidatorJailStatus_Handler,
+		},
+		{
+			MethodName: "EpochInfo",
+			Handler:    _Query_EpochInfo_Handler,
+		},
+		{
+			MethodName: "SlashingEvents",
+			Handler:    _Query_SlashingEvents_Handler,
+		},
+		{
+			MethodName: "PreviewMixedPoints",
+			Handler:    _Query_PreviewMixedPoints_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "confio/poe/v1beta1/query.proto",
+}
+
+func (m *QueryContractAddressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContractAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContractAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ContractType != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ContractType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContractAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContractAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContractAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUnbondingPeriodRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnbondingPeriodRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnbondingPeriodRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUnbondingPeriodResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnbondingPeriodResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnbondingPeriodResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Time):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintQuery(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorDelegationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorDelegationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorDelegationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorDelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Balance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorUnbondingDelegationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorUnbondingDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorUnbondingDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorUnbondingDelegationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorUnbondingDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorUnbondingDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorOutstandingRewardRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorOutstandingRewardRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorOutstandingRewardRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorOutstandingRewardResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorOutstandingRewardResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorOutstandingRewardResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Reward.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorEngagementRewardRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorEngagementRewardRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorEngagementRewardRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorEngagementRewardResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorEngagementRewardResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorEngagementRewardResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Reward.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEngagementPointsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEngagementPointsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEngagementPointsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEngagementPointsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEngagementPointsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEngagementPointsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Points != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Points))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEngagementMembersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEngagementMembersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEngagementMembersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEngagementMembersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEngagementMembersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEngagementMembersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Members) > 0 {
+		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Members[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EngagementMember) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EngagementMember) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EngagementMember) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Points != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Points))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorJailStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorJailStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorJailStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorJailStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorJailStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorJailStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CanUnjail {
+		i--
+		if m.CanUnjail {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Forever {
+		i--
+		if m.Forever {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Until != nil {
+		n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Until, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Until):])
+		if err9 != nil {
+			return 0, err9
+		}
+		i -= n9
+		i = encodeVarintQuery(dAtA, i, uint64(n9))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Start != nil {
+		n10, err10 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Start, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Start):])
+		if err10 != nil {
+			return 0, err10
+		}
+		i -= n10
+		i = encodeVarintQuery(dAtA, i, uint64(n10))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Jailed {
+		i--
+		if m.Jailed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEpochInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEpochInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n11, err11 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastDistributionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastDistributionTime):])
+	if err11 != nil {
+		return 0, err11
+	}
+	i -= n11
+	i = encodeVarintQuery(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0x32
+	if m.LastDistributionHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LastDistributionHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size, err := m.EpochReward.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	n13, err13 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.NextEpochTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.NextEpochTime):])
+	if err13 != nil {
+		return 0, err13
+	}
+	i -= n13
+	i = encodeVarintQuery(dAtA, i, uint64(n13))
+	i--
+	dAtA[i] = 0x1a
+	n14, err14 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.EpochLength, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.EpochLength):])
+	if err14 != nil {
+		return 0, err14
+	}
+	i -= n14
+	i = encodeVarintQuery(dAtA, i, uint64(n14))
+	i--
+	dAtA[i] = 0x12
+	if m.CurrentEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CurrentEpoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySlashingEventsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySlashingEventsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySlashingEventsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.MaxHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MinHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MinHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySlashingEventsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySlashingEventsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySlashingEventsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Events) > 0 {
+		for iNdEx := len(m.Events) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Events[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SlashingEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SlashingEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlashingEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.JailedForever {
+		i--
+		if m.JailedForever {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.JailedUntil != nil {
+		n17, err17 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.JailedUntil, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.JailedUntil):])
+		if err17 != nil {
+			return 0, err17
+		}
+		i -= n17
+		i = encodeVarintQuery(dAtA, i, uint64(n17))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Tombstoned {
+		i--
+		if m.Tombstoned {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.Portion.Size()
+		i -= size
+		if _, err := m.Portion.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPreviewMixedPointsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPreviewMixedPointsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPreviewMixedPointsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Engagement != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Engagement))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Stake != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Stake))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPreviewMixedPointsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPreviewMixedPointsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPreviewMixedPointsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Points != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Points))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+
+func (m *QueryContractAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ContractType != 0 {
+		n += 1 + sovQuery(uint64(m.ContractType))
+	}
+	return n
+}
+
+func (m *QueryContractAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUnbondingPeriodRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryUnbondingPeriodResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Time)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryValidatorDelegationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorDelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Balance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryValidatorUnbondingDelegationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorUnbondingDelegationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorOutstandingRewardRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorOutstandingRewardResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Reward.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryValidatorEngagementRewardRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorEngagementRewardResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Reward.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryEngagementPointsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEngagementPointsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Points != 0 {
+		n += 1 + sovQuery(uint64(m.Points))
+	}
+	return n
+}
+
+func (m *QueryEngagementMembersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEngagementMembersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Members) > 0 {
+		for _, e := range m.Members {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *EngagementMember) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Points != 0 {
+		n += 1 + sovQuery(uint64(m.Points))
+	}
+	return n
+}
+
+func (m *QueryValidatorJailStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorJailStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Jailed {
+		n += 2
+	}
+	if m.Start != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Start)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Until != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Until)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Forever {
+		n += 2
+	}
+	if m.CanUnjail {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryEpochInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryEpochInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CurrentEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.CurrentEpoch))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.EpochLength)
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.NextEpochTime)
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.EpochReward.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.LastDistributionHeight != 0 {
+		n += 1 + sovQuery(uint64(m.LastDistributionHeight))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LastDistributionTime)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySlashingEventsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MinHeight != 0 {
+		n += 1 + sovQuery(uint64(m.MinHeight))
+	}
+	if m.MaxHeight != 0 {
+		n += 1 + sovQuery(uint64(m.MaxHeight))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySlashingEventsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Events) > 0 {
+		for _, e := range m.Events {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *SlashingEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	l = m.Portion.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Tombstoned {
+		n += 2
+	}
+	if m.JailedUntil != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.JailedUntil)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.JailedForever {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryPreviewMixedPointsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Stake != 0 {
+		n += 1 + sovQuery(uint64(m.Stake))
+	}
+	if m.Engagement != 0 {
+		n += 1 + sovQuery(uint64(m.Engagement))
+	}
+	return n
+}
+
+func (m *QueryPreviewMixedPointsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Points != 0 {
+		n += 1 + sovQuery(uint64(m.Points))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+
+func (m *QueryContractAddressRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryContractAddressRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryContractAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractType", wireType)
+			}
+			m.ContractType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractType |= PoEContractType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryContractAddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryContractAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryContractAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryUnbondingPeriodRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUnbondingPeriodRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUnbondingPeriodRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryUnbondingPeriodResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUnbondingPeriodResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUnbondingPeriodResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryValidatorDelegationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorDelegationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorDelegationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryValidatorDelegationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorDelegationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryValidatorUnbondingDelegationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorUnbondingDelegationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorUnbondingDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryValidatorUnbondingDelegationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorUnbondingDelegationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorUnbondingDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, types1.UnbondingDelegationEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryValidatorOutstandingRewardRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorOutstandingRewardRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorOutstandingRewardRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryValidatorOutstandingRewardResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorOutstandingRewardResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorOutstandingRewardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reward", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Reward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryValidatorEngagementRewardRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorEngagementRewardRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorEngagementRewardRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryValidatorEngagementRewardResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorEngagementRewardResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorEngagementRewardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reward", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Reward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryEngagementPointsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEngagementPointsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEngagementPointsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryEngagementPointsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEngagementPointsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEngagementPointsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Points", wireType)
+			}
+			m.Points = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Points |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryEngagementMembersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEngagementMembersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEngagementMembersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryEngagementMembersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
 				return ErrIntOverflowQuery
 			}
 			if iNdEx >= l {
@@ -1742,17 +5049,240 @@ func (m *QueryContractAddressRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryContractAddressRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEngagementMembersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryContractAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEngagementMembersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Members = append(m.Members, EngagementMember{})
+			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *EngagementMember) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EngagementMember: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EngagementMember: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Points", wireType)
+			}
+			m.Points = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Points |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryValidatorJailStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorJailStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorJailStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
 			}
-			m.ContractType = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1762,11 +5292,24 @@ func (m *QueryContractAddressRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ContractType |= PoEContractType(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1789,7 +5332,7 @@ func (m *QueryContractAddressRequest) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *QueryContractAddressResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorJailStatusResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1812,17 +5355,37 @@ func (m *QueryContractAddressResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryContractAddressResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorJailStatusResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryContractAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorJailStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jailed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Jailed = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1832,24 +5395,104 @@ func (m *QueryContractAddressResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			if m.Start == nil {
+				m.Start = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Start, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Until", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Until == nil {
+				m.Until = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Until, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Forever", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Forever = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CanUnjail", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CanUnjail = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1872,7 +5515,7 @@ func (m *QueryContractAddressResponse) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *QueryUnbondingPeriodRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryEpochInfoRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1895,10 +5538,10 @@ func (m *QueryUnbondingPeriodRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryUnbondingPeriodRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEpochInfoRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryUnbondingPeriodRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEpochInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1923,7 +5566,7 @@ func (m *QueryUnbondingPeriodRequest) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *QueryUnbondingPeriodResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryEpochInfoResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1946,15 +5589,34 @@ func (m *QueryUnbondingPeriodResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryUnbondingPeriodResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEpochInfoResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryUnbondingPeriodResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEpochInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentEpoch", wireType)
+			}
+			m.CurrentEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochLength", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1981,66 +5643,15 @@ func (m *QueryUnbondingPeriodResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.EpochLength, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-
-func (m *QueryValidatorDelegationRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorDelegationRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorDelegationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextEpochTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2050,78 +5661,80 @@ func (m *QueryValidatorDelegationRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.NextEpochTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochReward", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-
-func (m *QueryValidatorDelegationResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.EpochReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorDelegationResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastDistributionHeight", wireType)
+			}
+			m.LastDistributionHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastDistributionHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastDistributionTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2148,7 +5761,7 @@ func (m *QueryValidatorDelegationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.LastDistributionTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2174,7 +5787,7 @@ func (m *QueryValidatorDelegationResponse) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *QueryValidatorUnbondingDelegationsRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySlashingEventsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2197,17 +5810,17 @@ func (m *QueryValidatorUnbondingDelegationsRequest) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorUnbondingDelegationsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySlashingEventsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorUnbondingDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySlashingEventsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinHeight", wireType)
 			}
-			var stringLen uint64
+			m.MinHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2217,25 +5830,31 @@ func (m *QueryValidatorUnbondingDelegationsRequest) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MinHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxHeight", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.MaxHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -2293,7 +5912,7 @@ func (m *QueryValidatorUnbondingDelegationsRequest) Unmarshal(dAtA []byte) error
 	return nil
 }
 
-func (m *QueryValidatorUnbondingDelegationsResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySlashingEventsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2316,15 +5935,15 @@ func (m *QueryValidatorUnbondingDelegationsResponse) Unmarshal(dAtA []byte) erro
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorUnbondingDelegationsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySlashingEventsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorUnbondingDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySlashingEventsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2351,8 +5970,8 @@ func (m *QueryValidatorUnbondingDelegationsResponse) Unmarshal(dAtA []byte) erro
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Entries = append(m.Entries, types1.UnbondingDelegationEntry{})
-			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Events = append(m.Events, SlashingEvent{})
+			if err := m.Events[len(m.Events)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2414,7 +6033,7 @@ func (m *QueryValidatorUnbondingDelegationsResponse) Unmarshal(dAtA []byte) erro
 	return nil
 }
 
-func (m *QueryValidatorOutstandingRewardRequest) Unmarshal(dAtA []byte) error {
+func (m *SlashingEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2437,15 +6056,15 @@ func (m *QueryValidatorOutstandingRewardRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorOutstandingRewardRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SlashingEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorOutstandingRewardRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SlashingEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2473,62 +6092,84 @@ func (m *QueryValidatorOutstandingRewardRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Portion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-
-func (m *QueryValidatorOutstandingRewardResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			if err := m.Portion.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tombstoned", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorOutstandingRewardResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorOutstandingRewardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Tombstoned = bool(v != 0)
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reward", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JailedUntil", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2555,10 +6196,33 @@ func (m *QueryValidatorOutstandingRewardResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Reward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.JailedUntil == nil {
+				m.JailedUntil = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.JailedUntil, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailedForever", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.JailedForever = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2581,7 +6245,7 @@ func (m *QueryValidatorOutstandingRewardResponse) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *QueryValidatorEngagementRewardRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPreviewMixedPointsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2604,17 +6268,17 @@ func (m *QueryValidatorEngagementRewardRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorEngagementRewardRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPreviewMixedPointsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorEngagementRewardRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPreviewMixedPointsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stake", wireType)
 			}
-			var stringLen uint64
+			m.Stake = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2624,24 +6288,30 @@ func (m *QueryValidatorEngagementRewardRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Stake |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Engagement", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Engagement = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Engagement |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2664,7 +6334,7 @@ func (m *QueryValidatorEngagementRewardRequest) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *QueryValidatorEngagementRewardResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPreviewMixedPointsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2687,17 +6357,17 @@ func (m *QueryValidatorEngagementRewardResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorEngagementRewardResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPreviewMixedPointsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorEngagementRewardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPreviewMixedPointsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reward", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Points", wireType)
 			}
-			var msglen int
+			m.Points = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2707,25 +6377,11 @@ func (m *QueryValidatorEngagementRewardResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Points |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Reward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
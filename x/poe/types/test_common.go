@@ -47,8 +47,14 @@ func RandomAccAddress() sdk.AccAddress {
 	return rand.Bytes(address.Len)
 }
 
-// RandomGenTX returns a signed genesis tx
+// RandomGenTX returns a genesis tx signed for an empty chain id
 func RandomGenTX(t *testing.T, power uint32, mutators ...func(*MsgCreateValidator)) (json.RawMessage, sdk.AccAddress, cryptotypes.PubKey) {
+	t.Helper()
+	return RandomGenTXWithChainID(t, "", power, mutators...)
+}
+
+// RandomGenTXWithChainID returns a genesis tx signed for the given chain id
+func RandomGenTXWithChainID(t *testing.T, chainID string, power uint32, mutators ...func(*MsgCreateValidator)) (json.RawMessage, sdk.AccAddress, cryptotypes.PubKey) {
 	t.Helper()
 	nodeConfig := cfg.TestConfig()
 	nodeConfig.RootDir = t.TempDir()
@@ -85,7 +91,7 @@ func RandomGenTX(t *testing.T, power uint32, mutators ...func(*MsgCreateValidato
 
 	txFactory := tx.Factory{}
 	txFactory = txFactory.
-		WithChainID("").
+		WithChainID(chainID).
 		WithKeybase(kb).
 		WithTxConfig(txConfig)
 
@@ -12,6 +12,7 @@ import (
 	time "time"
 
 	types1 "github.com/cosmos/cosmos-sdk/codec/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types2 "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/x/staking/types"
 	_ "github.com/gogo/protobuf/gogoproto"
@@ -405,6 +406,337 @@ func (m *MsgUndelegateResponse) GetCompletionTime() time.Time {
 	return time.Time{}
 }
 
+// MsgWithdrawRewards defines a PoE message for claiming the distribution and/or
+// engagement rewards of the owner
+type MsgWithdrawRewards struct {
+	// Owner is the bech32 address string of the rewards owner
+	// Also know as "signer" in other messages
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	// Distribution claims the validator rewards from the distribution contract
+	Distribution bool `protobuf:"varint,2,opt,name=distribution,proto3" json:"distribution,omitempty"`
+	// Engagement claims the engagement rewards from the engagement contract
+	Engagement bool `protobuf:"varint,3,opt,name=engagement,proto3" json:"engagement,omitempty"`
+}
+
+func (m *MsgWithdrawRewards) Reset()         { *m = MsgWithdrawRewards{} }
+func (m *MsgWithdrawRewards) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawRewards) ProtoMessage()    {}
+func (*MsgWithdrawRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2f36f4be4f27cf5, []int{8}
+}
+
+func (m *MsgWithdrawRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *MsgWithdrawRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *MsgWithdrawRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawRewards.Merge(m, src)
+}
+
+func (m *MsgWithdrawRewards) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *MsgWithdrawRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawRewards proto.InternalMessageInfo
+
+// MsgWithdrawRewardsResponse defines the Msg/WithdrawRewards response type.
+type MsgWithdrawRewardsResponse struct {
+	// Amount is the total amount withdrawn
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *MsgWithdrawRewardsResponse) Reset()         { *m = MsgWithdrawRewardsResponse{} }
+func (m *MsgWithdrawRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawRewardsResponse) ProtoMessage()    {}
+func (*MsgWithdrawRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2f36f4be4f27cf5, []int{9}
+}
+
+func (m *MsgWithdrawRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *MsgWithdrawRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *MsgWithdrawRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawRewardsResponse.Merge(m, src)
+}
+
+func (m *MsgWithdrawRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *MsgWithdrawRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawRewardsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawRewardsResponse proto.InternalMessageInfo
+
+func (m *MsgWithdrawRewardsResponse) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// MsgUnjail defines a PoE message for unjailing a validator
+type MsgUnjail struct {
+	// OperatorAddress is the bech32 address string of the jailed validator
+	// Also know as "signer" in other messages
+	OperatorAddress string `protobuf:"bytes,1,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty" yaml:"operator_address"`
+}
+
+func (m *MsgUnjail) Reset()         { *m = MsgUnjail{} }
+func (m *MsgUnjail) String() string { return proto.CompactTextString(m) }
+func (*MsgUnjail) ProtoMessage()    {}
+func (*MsgUnjail) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2f36f4be4f27cf5, []int{10}
+}
+
+func (m *MsgUnjail) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *MsgUnjail) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnjail.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *MsgUnjail) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnjail.Merge(m, src)
+}
+
+func (m *MsgUnjail) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *MsgUnjail) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnjail.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnjail proto.InternalMessageInfo
+
+// MsgUnjailResponse defines the Msg/Unjail response type.
+type MsgUnjailResponse struct{}
+
+func (m *MsgUnjailResponse) Reset()         { *m = MsgUnjailResponse{} }
+func (m *MsgUnjailResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnjailResponse) ProtoMessage()    {}
+func (*MsgUnjailResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2f36f4be4f27cf5, []int{11}
+}
+
+func (m *MsgUnjailResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *MsgUnjailResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnjailResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *MsgUnjailResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnjailResponse.Merge(m, src)
+}
+
+func (m *MsgUnjailResponse) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *MsgUnjailResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnjailResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnjailResponse proto.InternalMessageInfo
+
+// MsgSetWithdrawAddress defines a PoE message for delegating the withdrawal
+// of the engagement rewards to another address
+type MsgSetWithdrawAddress struct {
+	// Owner is the bech32 address string of the rewards owner
+	// Also know as "signer" in other messages
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	// WithdrawAddress is the bech32 address string that is allowed to withdraw
+	// the rewards
+	WithdrawAddress string `protobuf:"bytes,2,opt,name=withdraw_address,json=withdrawAddress,proto3" json:"withdraw_address,omitempty" yaml:"withdraw_address"`
+}
+
+func (m *MsgSetWithdrawAddress) Reset()         { *m = MsgSetWithdrawAddress{} }
+func (m *MsgSetWithdrawAddress) String() string { return proto.CompactTextString(m) }
+func (*MsgSetWithdrawAddress) ProtoMessage()    {}
+func (*MsgSetWithdrawAddress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2f36f4be4f27cf5, []int{12}
+}
+
+func (m *MsgSetWithdrawAddress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *MsgSetWithdrawAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetWithdrawAddress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *MsgSetWithdrawAddress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetWithdrawAddress.Merge(m, src)
+}
+
+func (m *MsgSetWithdrawAddress) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *MsgSetWithdrawAddress) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetWithdrawAddress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetWithdrawAddress proto.InternalMessageInfo
+
+// MsgSetWithdrawAddressResponse defines the Msg/SetWithdrawAddress response
+// type.
+type MsgSetWithdrawAddressResponse struct{}
+
+func (m *MsgSetWithdrawAddressResponse) Reset()         { *m = MsgSetWithdrawAddressResponse{} }
+func (m *MsgSetWithdrawAddressResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetWithdrawAddressResponse) ProtoMessage()    {}
+func (*MsgSetWithdrawAddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2f36f4be4f27cf5, []int{13}
+}
+
+func (m *MsgSetWithdrawAddressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *MsgSetWithdrawAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetWithdrawAddressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *MsgSetWithdrawAddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetWithdrawAddressResponse.Merge(m, src)
+}
+
+func (m *MsgSetWithdrawAddressResponse) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *MsgSetWithdrawAddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetWithdrawAddressResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetWithdrawAddressResponse proto.InternalMessageInfo
+
+// FeeSponsorExtension is a TX extension option that names a contract with the
+// fee_sponsor privilege to pay the TX fees. The contract must approve the
+// fees via sudo before they are deducted from its balance.
+type FeeSponsorExtension struct {
+	// Contract is the bech32 address string of the sponsoring contract
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+}
+
+func (m *FeeSponsorExtension) Reset()         { *m = FeeSponsorExtension{} }
+func (m *FeeSponsorExtension) String() string { return proto.CompactTextString(m) }
+func (*FeeSponsorExtension) ProtoMessage()    {}
+func (*FeeSponsorExtension) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2f36f4be4f27cf5, []int{14}
+}
+
+func (m *FeeSponsorExtension) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *FeeSponsorExtension) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeSponsorExtension.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *FeeSponsorExtension) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeSponsorExtension.Merge(m, src)
+}
+
+func (m *FeeSponsorExtension) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *FeeSponsorExtension) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeSponsorExtension.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeSponsorExtension proto.InternalMessageInfo
+
+func (m *FeeSponsorExtension) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*MsgCreateValidator)(nil), "confio.poe.v1beta1.MsgCreateValidator")
 	proto.RegisterType((*MsgCreateValidatorResponse)(nil), "confio.poe.v1beta1.MsgCreateValidatorResponse")
@@ -414,52 +746,75 @@ func init() {
 	proto.RegisterType((*MsgDelegateResponse)(nil), "confio.poe.v1beta1.MsgDelegateResponse")
 	proto.RegisterType((*MsgUndelegate)(nil), "confio.poe.v1beta1.MsgUndelegate")
 	proto.RegisterType((*MsgUndelegateResponse)(nil), "confio.poe.v1beta1.MsgUndelegateResponse")
+	proto.RegisterType((*MsgWithdrawRewards)(nil), "confio.poe.v1beta1.MsgWithdrawRewards")
+	proto.RegisterType((*MsgWithdrawRewardsResponse)(nil), "confio.poe.v1beta1.MsgWithdrawRewardsResponse")
+	proto.RegisterType((*MsgUnjail)(nil), "confio.poe.v1beta1.MsgUnjail")
+	proto.RegisterType((*MsgUnjailResponse)(nil), "confio.poe.v1beta1.MsgUnjailResponse")
+	proto.RegisterType((*MsgSetWithdrawAddress)(nil), "confio.poe.v1beta1.MsgSetWithdrawAddress")
+	proto.RegisterType((*MsgSetWithdrawAddressResponse)(nil), "confio.poe.v1beta1.MsgSetWithdrawAddressResponse")
+	proto.RegisterType((*FeeSponsorExtension)(nil), "confio.poe.v1beta1.FeeSponsorExtension")
 }
 
 func init() { proto.RegisterFile("confio/poe/v1beta1/tx.proto", fileDescriptor_c2f36f4be4f27cf5) }
 
 var fileDescriptor_c2f36f4be4f27cf5 = []byte{
-	// 639 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x55, 0xc1, 0x4f, 0xd4, 0x4e,
-	0x14, 0x6e, 0x81, 0xec, 0x6f, 0x7f, 0x43, 0x60, 0x4d, 0x85, 0xb8, 0x14, 0xd3, 0xe2, 0x4a, 0x14,
-	0x0f, 0x4e, 0x03, 0x1e, 0x4c, 0x38, 0x98, 0xb0, 0x10, 0x2e, 0x64, 0x13, 0xb3, 0x41, 0x0f, 0x5c,
-	0x36, 0xd3, 0x76, 0x18, 0x27, 0x6c, 0x3b, 0x93, 0xce, 0x2c, 0xa1, 0xff, 0x81, 0x17, 0x13, 0xce,
-	0x9e, 0xf8, 0x1f, 0xf4, 0x8f, 0x20, 0x9e, 0x38, 0x7a, 0x42, 0xb2, 0x7b, 0xf1, 0xec, 0xd9, 0x83,
-	0x69, 0x3b, 0xed, 0xae, 0x5d, 0x37, 0xa0, 0x24, 0xc6, 0x5b, 0x67, 0xde, 0xf7, 0xbe, 0xf9, 0xbe,
-	0xf7, 0xde, 0x4c, 0xc1, 0xb2, 0xc7, 0xc2, 0x43, 0xca, 0x1c, 0xce, 0xb0, 0x73, 0xbc, 0xee, 0x62,
-	0x89, 0xd6, 0x1d, 0x79, 0x02, 0x79, 0xc4, 0x24, 0x33, 0x8c, 0x2c, 0x08, 0x39, 0xc3, 0x50, 0x05,
-	0xcd, 0x25, 0xc2, 0x18, 0xe9, 0x62, 0x27, 0x45, 0xb8, 0xbd, 0x43, 0x07, 0x85, 0x71, 0x06, 0x37,
-	0xed, 0x72, 0x48, 0xd2, 0x00, 0x0b, 0x89, 0x02, 0xae, 0x00, 0x0b, 0x84, 0x11, 0x96, 0x7e, 0x3a,
-	0xc9, 0x97, 0xda, 0x5d, 0xf2, 0x98, 0x08, 0x98, 0xe8, 0x64, 0x81, 0x6c, 0xa1, 0x42, 0x56, 0xb6,
-	0x72, 0x5c, 0x24, 0x86, 0xf2, 0x3c, 0x46, 0x43, 0x15, 0x5f, 0x55, 0x71, 0x21, 0xd1, 0x11, 0x0d,
-	0x49, 0x01, 0x51, 0xeb, 0x0c, 0xd5, 0xf8, 0x3e, 0x05, 0x8c, 0x96, 0x20, 0xdb, 0x11, 0x46, 0x12,
-	0xbf, 0x46, 0x5d, 0xea, 0x23, 0xc9, 0x22, 0x63, 0x0f, 0xcc, 0xfa, 0x58, 0x78, 0x11, 0xe5, 0x92,
-	0xb2, 0xb0, 0xae, 0xaf, 0xe8, 0x6b, 0xb3, 0x1b, 0x0f, 0xa1, 0x12, 0x90, 0x53, 0x28, 0x4a, 0xb8,
-	0x33, 0x84, 0x36, 0x67, 0xce, 0x2f, 0x6d, 0xad, 0x3d, 0x9a, 0x6d, 0xec, 0x82, 0x3b, 0x8c, 0xe3,
-	0x28, 0x21, 0xee, 0x20, 0xdf, 0x8f, 0xb0, 0x10, 0xf5, 0x99, 0x15, 0x7d, 0xed, 0xff, 0xe6, 0xf2,
-	0xb7, 0x4b, 0xfb, 0x5e, 0x8c, 0x82, 0xee, 0x66, 0xa3, 0x8c, 0x68, 0xb4, 0x6b, 0xf9, 0xd6, 0x56,
-	0xb6, 0x63, 0xec, 0x82, 0x0a, 0xef, 0xb9, 0x47, 0x38, 0xae, 0x57, 0x52, 0x3d, 0x0b, 0x30, 0x2b,
-	0x2a, 0xcc, 0x8b, 0x0a, 0xb7, 0xc2, 0xb8, 0x59, 0xff, 0xf4, 0xf1, 0xe9, 0x82, 0x12, 0xea, 0x45,
-	0x31, 0x97, 0x0c, 0xbe, 0xec, 0xb9, 0x7b, 0x38, 0x6e, 0xab, 0x6c, 0xe3, 0x39, 0xa8, 0xa0, 0x80,
-	0xf5, 0x42, 0x59, 0xff, 0x2f, 0xe5, 0x59, 0xca, 0x7d, 0x25, 0xa5, 0x2c, 0x4c, 0x6d, 0x33, 0x9a,
-	0xbb, 0x51, 0x70, 0x63, 0x17, 0xcc, 0x1f, 0x63, 0x21, 0x69, 0x48, 0x3a, 0x8a, 0xa0, 0x7a, 0x33,
-	0x82, 0x39, 0x95, 0xb6, 0x95, 0x66, 0x6d, 0x56, 0xdf, 0x9e, 0xd9, 0xda, 0xd7, 0x33, 0x5b, 0x6b,
-	0xdc, 0x07, 0xe6, 0x78, 0xf5, 0xdb, 0x58, 0x70, 0x16, 0x0a, 0xdc, 0xf8, 0xa0, 0xa7, 0xcd, 0x79,
-	0xc5, 0xfd, 0xbf, 0xdb, 0x9c, 0xa9, 0xdf, 0x6f, 0xce, 0x98, 0xa7, 0x92, 0xe8, 0xc2, 0xd3, 0x95,
-	0x0e, 0x66, 0x5b, 0x82, 0xec, 0xe0, 0x2e, 0x26, 0x48, 0xe2, 0x5f, 0x9e, 0xaf, 0xff, 0xc1, 0x70,
-	0x0c, 0x9b, 0x3a, 0x75, 0xdb, 0xa6, 0x4e, 0xdf, 0xb2, 0xa9, 0x8b, 0xe0, 0xee, 0x88, 0xc3, 0xc2,
-	0xf9, 0x7b, 0x1d, 0xcc, 0x25, 0x85, 0x09, 0xfd, 0x7f, 0xc5, 0xfb, 0x88, 0xe6, 0x43, 0xb0, 0xf8,
-	0x93, 0xb6, 0x5c, 0xb5, 0xd1, 0x02, 0x35, 0x8f, 0x05, 0xbc, 0x8b, 0x93, 0x69, 0xe9, 0x24, 0xaf,
-	0x96, 0x1a, 0x38, 0x73, 0xec, 0xf6, 0xed, 0xe7, 0x4f, 0x5a, 0xb3, 0x9a, 0x9c, 0x72, 0xfa, 0xc5,
-	0xd6, 0xdb, 0xf3, 0xc3, 0xe4, 0x24, 0xbc, 0xf1, 0x6e, 0x1a, 0x4c, 0xb7, 0x04, 0x31, 0x28, 0xa8,
-	0x95, 0xdf, 0x9c, 0x47, 0x70, 0xfc, 0x49, 0x85, 0xe3, 0xb7, 0xc3, 0x84, 0x37, 0xc3, 0x15, 0x0e,
-	0x28, 0xa8, 0x95, 0x6f, 0xd0, 0xa4, 0xa3, 0x4a, 0xb8, 0x89, 0x47, 0x4d, 0x18, 0x6e, 0x63, 0x1f,
-	0x54, 0x8b, 0xc1, 0xb6, 0x27, 0xe4, 0xe6, 0x00, 0xf3, 0xf1, 0x35, 0x80, 0x82, 0xf5, 0x00, 0x80,
-	0x91, 0xa1, 0x79, 0x30, 0x49, 0x53, 0x01, 0x31, 0x9f, 0x5c, 0x0b, 0xc9, 0xb9, 0x9b, 0x2f, 0xce,
-	0xfb, 0x96, 0x7e, 0xd1, 0xb7, 0xf4, 0xab, 0xbe, 0xa5, 0x9f, 0x0e, 0x2c, 0xed, 0x62, 0x60, 0x69,
-	0x9f, 0x07, 0x96, 0x76, 0xb0, 0x4a, 0xa8, 0x7c, 0xd3, 0x73, 0xa1, 0xc7, 0x02, 0x47, 0xfd, 0x08,
-	0x25, 0x89, 0x90, 0x8f, 0x9d, 0x93, 0xf4, 0x8f, 0x28, 0x63, 0x8e, 0x85, 0x5b, 0x49, 0xbb, 0xff,
-	0xec, 0x47, 0x00, 0x00, 0x00, 0xff, 0xff, 0x09, 0xac, 0xb2, 0x61, 0x2c, 0x07, 0x00, 0x00,
+	// 887 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0x41, 0x8f, 0xdb, 0x44,
+	0x14, 0x8e, 0x77, 0xdb, 0x90, 0xbe, 0x6d, 0x9b, 0xc5, 0xbb, 0x15, 0x59, 0x97, 0xda, 0x8b, 0x29,
+	0x65, 0x39, 0xd4, 0x26, 0xe5, 0x80, 0xd4, 0x03, 0xd2, 0xa6, 0x25, 0x97, 0x2a, 0x08, 0xb9, 0x05,
+	0xa4, 0x4a, 0x28, 0x1a, 0xdb, 0xb3, 0xee, 0xb0, 0xb1, 0xc7, 0xf2, 0x4c, 0x9a, 0xcd, 0x91, 0x1b,
+	0xe2, 0x54, 0xae, 0x9c, 0x7a, 0xe6, 0x0a, 0x47, 0x7e, 0x40, 0xc5, 0xa9, 0x47, 0x4e, 0xdb, 0x6a,
+	0xf7, 0xc2, 0xb9, 0x67, 0x0e, 0xc8, 0xf6, 0x78, 0xe2, 0xd8, 0x9b, 0x6e, 0x60, 0x11, 0xe2, 0x94,
+	0xcc, 0xbc, 0xef, 0xbd, 0xf7, 0x7d, 0xef, 0xbd, 0x79, 0x09, 0x5c, 0xf5, 0x68, 0xb4, 0x47, 0xa8,
+	0x1d, 0x53, 0x6c, 0x3f, 0xee, 0xba, 0x98, 0xa3, 0xae, 0xcd, 0x0f, 0xac, 0x38, 0xa1, 0x9c, 0xaa,
+	0x6a, 0x6e, 0xb4, 0x62, 0x8a, 0x2d, 0x61, 0xd4, 0xb6, 0x02, 0x4a, 0x83, 0x11, 0xb6, 0x33, 0x84,
+	0x3b, 0xde, 0xb3, 0x51, 0x34, 0xcd, 0xe1, 0x9a, 0x51, 0x35, 0x71, 0x12, 0x62, 0xc6, 0x51, 0x18,
+	0x0b, 0xc0, 0x66, 0x40, 0x03, 0x9a, 0x7d, 0xb5, 0xd3, 0x6f, 0xe2, 0x76, 0xcb, 0xa3, 0x2c, 0xa4,
+	0x6c, 0x98, 0x1b, 0xf2, 0x83, 0x30, 0xe9, 0xf9, 0xc9, 0x76, 0x11, 0x9b, 0xd1, 0xf3, 0x28, 0x89,
+	0x84, 0xfd, 0xba, 0xb0, 0x33, 0x8e, 0xf6, 0x49, 0x14, 0x48, 0x88, 0x38, 0xe7, 0x28, 0xf3, 0xcf,
+	0x15, 0x50, 0x07, 0x2c, 0xb8, 0x93, 0x60, 0xc4, 0xf1, 0x97, 0x68, 0x44, 0x7c, 0xc4, 0x69, 0xa2,
+	0xde, 0x83, 0x35, 0x1f, 0x33, 0x2f, 0x21, 0x31, 0x27, 0x34, 0xea, 0x28, 0xdb, 0xca, 0xce, 0xda,
+	0xad, 0x77, 0x2d, 0x41, 0xa0, 0x08, 0x21, 0x42, 0x5a, 0x77, 0x67, 0xd0, 0xde, 0xb9, 0x67, 0x87,
+	0x46, 0xc3, 0x29, 0x7b, 0xab, 0x7d, 0x58, 0xa7, 0x31, 0x4e, 0xd2, 0xc0, 0x43, 0xe4, 0xfb, 0x09,
+	0x66, 0xac, 0x73, 0x6e, 0x5b, 0xd9, 0xb9, 0xd0, 0xbb, 0xfa, 0xea, 0xd0, 0x78, 0x6b, 0x8a, 0xc2,
+	0xd1, 0x6d, 0xb3, 0x8a, 0x30, 0x9d, 0x76, 0x71, 0xb5, 0x9b, 0xdf, 0xa8, 0x7d, 0x68, 0xc6, 0x63,
+	0x77, 0x1f, 0x4f, 0x3b, 0xcd, 0x8c, 0xcf, 0xa6, 0x95, 0x17, 0xd5, 0x2a, 0x8a, 0x6a, 0xed, 0x46,
+	0xd3, 0x5e, 0xe7, 0xb7, 0x5f, 0x6e, 0x6e, 0x0a, 0xa2, 0x5e, 0x32, 0x8d, 0x39, 0xb5, 0x3e, 0x1f,
+	0xbb, 0xf7, 0xf0, 0xd4, 0x11, 0xde, 0xea, 0xc7, 0xd0, 0x44, 0x21, 0x1d, 0x47, 0xbc, 0xf3, 0x46,
+	0x16, 0x67, 0xab, 0xd0, 0x95, 0x96, 0x52, 0x8a, 0xba, 0x43, 0x49, 0xa1, 0x46, 0xc0, 0xd5, 0x3e,
+	0x5c, 0x7e, 0x8c, 0x19, 0x27, 0x51, 0x30, 0x14, 0x01, 0x5a, 0xcb, 0x05, 0xb8, 0x24, 0xdc, 0x76,
+	0x33, 0xaf, 0xdb, 0xad, 0xef, 0x9e, 0x1a, 0x8d, 0x3f, 0x9e, 0x1a, 0x0d, 0xf3, 0x6d, 0xd0, 0xea,
+	0xd5, 0x77, 0x30, 0x8b, 0x69, 0xc4, 0xb0, 0xf9, 0xb3, 0x92, 0x35, 0xe7, 0x8b, 0xd8, 0xff, 0x6f,
+	0x9b, 0xb3, 0xf2, 0xf7, 0x9b, 0x53, 0xd3, 0x54, 0x21, 0x2d, 0x35, 0xbd, 0x54, 0x60, 0x6d, 0xc0,
+	0x82, 0xbb, 0x78, 0x84, 0x03, 0xc4, 0xf1, 0x89, 0xf9, 0x95, 0x7f, 0x30, 0x1c, 0xb3, 0xa6, 0xae,
+	0x9c, 0xb5, 0xa9, 0xab, 0x67, 0x6c, 0xea, 0x15, 0xd8, 0x28, 0x29, 0x94, 0xca, 0x7f, 0x54, 0xe0,
+	0x52, 0x5a, 0x98, 0xc8, 0xff, 0xbf, 0x68, 0x2f, 0x71, 0xde, 0x83, 0x2b, 0x73, 0xdc, 0x0a, 0xd6,
+	0xea, 0x00, 0xda, 0x1e, 0x0d, 0xe3, 0x11, 0x4e, 0xa7, 0x65, 0x98, 0x6e, 0x2d, 0x31, 0x70, 0x5a,
+	0xed, 0xf5, 0x3d, 0x28, 0x56, 0x5a, 0xaf, 0x95, 0x66, 0x79, 0xf2, 0xc2, 0x50, 0x9c, 0xcb, 0x33,
+	0xe7, 0xd4, 0x6c, 0x7e, 0x9f, 0x8f, 0xf4, 0x57, 0x84, 0x3f, 0xf2, 0x13, 0x34, 0x71, 0xf0, 0x04,
+	0x25, 0x3e, 0x53, 0x6f, 0xc0, 0x79, 0x3a, 0x89, 0x70, 0x22, 0xe4, 0xaf, 0xbf, 0x3a, 0x34, 0x2e,
+	0x0a, 0xf9, 0xe9, 0xb5, 0xe9, 0xe4, 0x66, 0xd5, 0x84, 0x8b, 0x3e, 0x61, 0x3c, 0x21, 0xee, 0x38,
+	0x9b, 0xfd, 0x54, 0x6f, 0xcb, 0x99, 0xbb, 0x53, 0x75, 0x00, 0x1c, 0x05, 0x28, 0xc0, 0x21, 0x16,
+	0xcd, 0x6c, 0x39, 0xa5, 0x9b, 0x92, 0xe8, 0x6f, 0x15, 0xd0, 0xea, 0x64, 0xa4, 0x74, 0x4f, 0x96,
+	0x55, 0xd9, 0x5e, 0x7d, 0x7d, 0x59, 0x3f, 0x4c, 0x05, 0xff, 0xf4, 0xc2, 0xd8, 0x09, 0x08, 0x7f,
+	0x34, 0x76, 0x2d, 0x8f, 0x86, 0x62, 0x5b, 0x8b, 0x8f, 0x9b, 0xcc, 0xdf, 0xb7, 0xf9, 0x34, 0xc6,
+	0x2c, 0x73, 0x60, 0x45, 0x0b, 0xcc, 0xaf, 0xe1, 0x42, 0x56, 0xf8, 0x6f, 0x10, 0x19, 0xfd, 0x5b,
+	0x03, 0x51, 0x92, 0xb8, 0x01, 0x6f, 0xca, 0xf0, 0x72, 0x12, 0x7f, 0x50, 0xb2, 0x6e, 0xdf, 0xc7,
+	0xbc, 0x90, 0x5e, 0x4c, 0xd2, 0xb2, 0x7d, 0xe8, 0xc3, 0xfa, 0x44, 0xb8, 0x2e, 0xde, 0x1a, 0x55,
+	0x84, 0xe9, 0xb4, 0x27, 0xf3, 0xf9, 0x4a, 0x44, 0x0d, 0xb8, 0x76, 0x22, 0x25, 0x49, 0xba, 0x0b,
+	0x1b, 0x7d, 0x8c, 0xef, 0xa7, 0x07, 0x9a, 0x7c, 0x7a, 0xc0, 0x71, 0xc4, 0xd2, 0x6e, 0x6b, 0xd0,
+	0xf2, 0x68, 0xc4, 0x13, 0xe4, 0xf1, 0x9c, 0xb4, 0x23, 0xcf, 0xb7, 0x7e, 0x3d, 0x0f, 0xab, 0x03,
+	0x16, 0xa8, 0x04, 0xda, 0xd5, 0x1f, 0xb8, 0x1b, 0x56, 0xfd, 0xf7, 0xdb, 0xaa, 0xaf, 0x62, 0xcd,
+	0x5a, 0x0e, 0x27, 0x67, 0x86, 0x40, 0xbb, 0xba, 0xae, 0x17, 0xa5, 0xaa, 0xe0, 0x34, 0x6b, 0x39,
+	0x9c, 0x4c, 0xf5, 0x00, 0x5a, 0x72, 0x8b, 0x1a, 0x0b, 0x7c, 0x0b, 0x80, 0xf6, 0xfe, 0x29, 0x00,
+	0x19, 0xf5, 0x21, 0x40, 0x69, 0x43, 0xbd, 0xb3, 0x88, 0x93, 0x84, 0x68, 0x1f, 0x9c, 0x0a, 0x29,
+	0x17, 0xa7, 0xf6, 0xf0, 0x17, 0x78, 0x57, 0x70, 0x9a, 0xb5, 0x1c, 0x4e, 0xa6, 0xfa, 0x0c, 0x9a,
+	0xe2, 0x4d, 0x5d, 0x5b, 0xc8, 0x2f, 0x35, 0x6b, 0xef, 0xbd, 0xd6, 0x2c, 0xe3, 0x25, 0xa0, 0x9e,
+	0xf0, 0x5c, 0x16, 0x69, 0xaf, 0x43, 0xb5, 0xee, 0xd2, 0xd0, 0x22, 0x67, 0xef, 0x93, 0x67, 0x47,
+	0xba, 0xf2, 0xfc, 0x48, 0x57, 0x5e, 0x1e, 0xe9, 0xca, 0x93, 0x63, 0xbd, 0xf1, 0xfc, 0x58, 0x6f,
+	0xfc, 0x7e, 0xac, 0x37, 0x1e, 0x5e, 0x9f, 0x5b, 0x33, 0xd9, 0x9f, 0x54, 0x1e, 0x24, 0xc8, 0xc7,
+	0xf6, 0x41, 0xf6, 0x6f, 0x35, 0x5b, 0x34, 0x6e, 0x33, 0xdb, 0xcc, 0x1f, 0xfd, 0x35, 0x00, 0xa8,
+	0x14, 0x2d, 0x3e, 0xc8, 0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -484,6 +839,15 @@ type MsgClient interface {
 	// Undelegate defines a method for performing an undelegation from a
 	// node operator
 	Undelegate(ctx context.Context, in *MsgUndelegate, opts ...grpc.CallOption) (*MsgUndelegateResponse, error)
+	// WithdrawRewards defines a method for claiming distribution and/or
+	// engagement rewards
+	WithdrawRewards(ctx context.Context, in *MsgWithdrawRewards, opts ...grpc.CallOption) (*MsgWithdrawRewardsResponse, error)
+	// Unjail defines a method for unjailing a validator that was previously
+	// jailed for downtime
+	Unjail(ctx context.Context, in *MsgUnjail, opts ...grpc.CallOption) (*MsgUnjailResponse, error)
+	// SetWithdrawAddress defines a method for setting the address that is
+	// allowed to withdraw the engagement rewards of the owner
+	SetWithdrawAddress(ctx context.Context, in *MsgSetWithdrawAddress, opts ...grpc.CallOption) (*MsgSetWithdrawAddressResponse, error)
 }
 
 type msgClient struct {
@@ -530,6 +894,33 @@ func (c *msgClient) Undelegate(ctx context.Context, in *MsgUndelegate, opts ...g
 	return out, nil
 }
 
+func (c *msgClient) WithdrawRewards(ctx context.Context, in *MsgWithdrawRewards, opts ...grpc.CallOption) (*MsgWithdrawRewardsResponse, error) {
+	out := new(MsgWithdrawRewardsResponse)
+	err := c.cc.Invoke(ctx, "/confio.poe.v1beta1.Msg/WithdrawRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Unjail(ctx context.Context, in *MsgUnjail, opts ...grpc.CallOption) (*MsgUnjailResponse, error) {
+	out := new(MsgUnjailResponse)
+	err := c.cc.Invoke(ctx, "/confio.poe.v1beta1.Msg/Unjail", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetWithdrawAddress(ctx context.Context, in *MsgSetWithdrawAddress, opts ...grpc.CallOption) (*MsgSetWithdrawAddressResponse, error) {
+	out := new(MsgSetWithdrawAddressResponse)
+	err := c.cc.Invoke(ctx, "/confio.poe.v1beta1.Msg/SetWithdrawAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// CreateValidator defines a method for creating a new validator.
@@ -542,6 +933,15 @@ type MsgServer interface {
 	// Undelegate defines a method for performing an undelegation from a
 	// node operator
 	Undelegate(context.Context, *MsgUndelegate) (*MsgUndelegateResponse, error)
+	// WithdrawRewards defines a method for claiming distribution and/or
+	// engagement rewards
+	WithdrawRewards(context.Context, *MsgWithdrawRewards) (*MsgWithdrawRewardsResponse, error)
+	// Unjail defines a method for unjailing a validator that was previously
+	// jailed for downtime
+	Unjail(context.Context, *MsgUnjail) (*MsgUnjailResponse, error)
+	// SetWithdrawAddress defines a method for setting the address that is
+	// allowed to withdraw the engagement rewards of the owner
+	SetWithdrawAddress(context.Context, *MsgSetWithdrawAddress) (*MsgSetWithdrawAddressResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -563,6 +963,18 @@ func (*UnimplementedMsgServer) Undelegate(ctx context.Context, req *MsgUndelegat
 	return nil, status.Errorf(codes.Unimplemented, "method Undelegate not implemented")
 }
 
+func (*UnimplementedMsgServer) WithdrawRewards(ctx context.Context, req *MsgWithdrawRewards) (*MsgWithdrawRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawRewards not implemented")
+}
+
+func (*UnimplementedMsgServer) Unjail(ctx context.Context, req *MsgUnjail) (*MsgUnjailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unjail not implemented")
+}
+
+func (*UnimplementedMsgServer) SetWithdrawAddress(ctx context.Context, req *MsgSetWithdrawAddress) (*MsgSetWithdrawAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetWithdrawAddress not implemented")
+}
+
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
 }
@@ -639,6 +1051,60 @@ func _Msg_Undelegate_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_WithdrawRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawRewards)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/confio.poe.v1beta1.Msg/WithdrawRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawRewards(ctx, req.(*MsgWithdrawRewards))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Unjail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnjail)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Unjail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/confio.poe.v1beta1.Msg/Unjail",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Unjail(ctx, req.(*MsgUnjail))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetWithdrawAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetWithdrawAddress)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetWithdrawAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/confio.poe.v1beta1.Msg/SetWithdrawAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetWithdrawAddress(ctx, req.(*MsgSetWithdrawAddress))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "confio.poe.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -659,7 +1125,19 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Undelegate",
 			Handler:    _Msg_Undelegate_Handler,
 		},
-	},
+		{
+			MethodName: "WithdrawRewards",
+			Handler:    _Msg_WithdrawRewards_Handler,
+		},
+		{
+			MethodName: "Unjail",
+			Handler:    _Msg_Unjail_Handler,
+		},
+		{
+			MethodName: "SetWithdrawAddress",
+			Handler:    _Msg_SetWithdrawAddress_Handler,
+		},
+	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "confio/poe/v1beta1/tx.proto",
 }
@@ -966,6 +1444,236 @@ func (m *MsgUndelegateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgWithdrawRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Engagement {
+		i--
+		if m.Engagement {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Distribution {
+		i--
+		if m.Distribution {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnjail) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnjail) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnjail) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnjailResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnjailResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnjailResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetWithdrawAddress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetWithdrawAddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetWithdrawAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WithdrawAddress) > 0 {
+		i -= len(m.WithdrawAddress)
+		copy(dAtA[i:], m.WithdrawAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.WithdrawAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetWithdrawAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetWithdrawAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetWithdrawAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeSponsorExtension) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeSponsorExtension) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeSponsorExtension) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1086,6 +1794,101 @@ func (m *MsgUndelegateResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgWithdrawRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Distribution {
+		n += 2
+	}
+	if m.Engagement {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgWithdrawRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUnjail) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUnjailResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetWithdrawAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.WithdrawAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetWithdrawAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *FeeSponsorExtension) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1322,25 +2125,592 @@ func (m *MsgCreateValidatorResponse) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowTx
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateValidatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *MsgUpdateValidator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateValidator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *MsgUpdateValidatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateValidatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *MsgDelegate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDelegate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VestingAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *MsgDelegateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDelegateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *MsgUndelegate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUndelegate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUndelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *MsgUndelegateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUndelegateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUndelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateValidatorResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1363,7 +2733,7 @@ func (m *MsgCreateValidatorResponse) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *MsgUpdateValidator) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawRewards) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1386,17 +2756,17 @@ func (m *MsgUpdateValidator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateValidator: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawRewards: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawRewards: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1406,30 +2776,29 @@ func (m *MsgUpdateValidator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Distribution", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1439,24 +2808,32 @@ func (m *MsgUpdateValidator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			m.Distribution = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Engagement", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Engagement = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1479,7 +2856,7 @@ func (m *MsgUpdateValidator) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *MsgUpdateValidatorResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawRewardsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1502,12 +2879,46 @@ func (m *MsgUpdateValidatorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateValidatorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawRewardsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types2.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1530,7 +2941,7 @@ func (m *MsgUpdateValidatorResponse) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *MsgDelegate) Unmarshal(dAtA []byte) error {
+func (m *MsgUnjail) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1553,10 +2964,10 @@ func (m *MsgDelegate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDelegate: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnjail: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnjail: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1591,72 +3002,6 @@ func (m *MsgDelegate) Unmarshal(dAtA []byte) error {
 			}
 			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VestingAmount", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.VestingAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1679,7 +3024,7 @@ func (m *MsgDelegate) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *MsgDelegateResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUnjailResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1702,10 +3047,10 @@ func (m *MsgDelegateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDelegateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnjailResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnjailResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1730,7 +3075,7 @@ func (m *MsgDelegateResponse) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *MsgUndelegate) Unmarshal(dAtA []byte) error {
+func (m *MsgSetWithdrawAddress) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1753,15 +3098,15 @@ func (m *MsgUndelegate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUndelegate: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetWithdrawAddress: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUndelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetWithdrawAddress: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1789,13 +3134,13 @@ func (m *MsgUndelegate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1805,25 +3150,75 @@ func (m *MsgUndelegate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.WithdrawAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *MsgSetWithdrawAddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetWithdrawAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetWithdrawAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1846,7 +3241,7 @@ func (m *MsgUndelegate) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *MsgUndelegateResponse) Unmarshal(dAtA []byte) error {
+func (m *FeeSponsorExtension) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1869,17 +3264,17 @@ func (m *MsgUndelegateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUndelegateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: FeeSponsorExtension: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUndelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FeeSponsorExtension: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1889,24 +3284,23 @@ func (m *MsgUndelegateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
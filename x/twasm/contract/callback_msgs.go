@@ -2,6 +2,7 @@ package contract
 
 import (
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
 )
 
 // TgradeSudoMsg callback message sent to a contract.
@@ -21,6 +22,19 @@ type TgradeSudoMsg struct {
 	Export *struct{} `json:"export,omitempty"`
 	// Import genesis state
 	Import *wasmtypes.RawContractMessage `json:"import,omitempty"`
+
+	// ApproveFee is delivered to a fee_sponsor contract before the TX fees are deducted from its balance.
+	// The contract returns an error to reject.
+	ApproveFee *ApproveFee `json:"approve_fee,omitempty"`
+}
+
+// ApproveFee asks a fee sponsor contract to pay the fee of a TX
+type ApproveFee struct {
+	// Payer is the bech32 address of the TX fee payer
+	Payer string `json:"payer"`
+	// Msgs are the proto encoded TX messages
+	Msgs []ProtoAny        `json:"msgs"`
+	Fee  wasmvmtypes.Coins `json:"fee"`
 }
 
 // PrivilegeChangeMsg is called on a contract when it is made privileged or demoted
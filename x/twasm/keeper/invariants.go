@@ -0,0 +1,84 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/confio/tgrade/x/twasm/types"
+)
+
+// RegisterInvariants registers the twasm module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k *Keeper) {
+	ir.RegisterRoute(types.ModuleName, "privilege-index", PrivilegeIndexInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "validator-set-updater", ValidatorSetUpdaterInvariant(k))
+}
+
+// PrivilegeIndexInvariant checks that the privileged flag and privilege registration indexes match the
+// privileges stored in the contract details:
+// - every contract with the privileged flag set exists
+// - every registered privilege in the contract details is indexed for the contract
+// - every indexed privilege belongs to a privileged contract that has it in its details
+func PrivilegeIndexInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		broken := 0
+		k.IteratePrivileged(ctx, func(contractAddr sdk.AccAddress) bool {
+			details, err := k.getContractDetails(ctx, contractAddr)
+			if err != nil {
+				broken++
+				msg += fmt.Sprintf("\tprivileged contract %s: %s\n", contractAddr, err)
+				return false
+			}
+			details.IterateRegisteredPrivileges(func(privilegeType types.PrivilegeType, pos uint8) bool {
+				if addr := k.getPrivilegedContract(ctx, privilegeType, pos); !contractAddr.Equals(sdk.AccAddress(addr)) {
+					broken++
+					msg += fmt.Sprintf("\tprivilege %s at position %d of contract %s not indexed, got %s\n", privilegeType, pos, contractAddr, sdk.AccAddress(addr))
+				}
+				return false
+			})
+			return false
+		})
+		for _, name := range types.AllPrivilegeTypeNames() {
+			privilegeType := *types.PrivilegeTypeFrom(name)
+			k.IteratePrivilegedContractsByType(ctx, privilegeType, func(pos uint8, contractAddr sdk.AccAddress) bool {
+				if !k.IsPrivileged(ctx, contractAddr) {
+					broken++
+					msg += fmt.Sprintf("\tprivilege %s at position %d registered for non privileged contract %s\n", privilegeType, pos, contractAddr)
+					return false
+				}
+				details, err := k.getContractDetails(ctx, contractAddr)
+				if err != nil {
+					broken++
+					msg += fmt.Sprintf("\tprivilege %s at position %d contract %s: %s\n", privilegeType, pos, contractAddr, err)
+					return false
+				}
+				var found bool
+				details.IterateRegisteredPrivileges(func(t types.PrivilegeType, p uint8) bool {
+					found = t == privilegeType && p == pos
+					return found
+				})
+				if !found {
+					broken++
+					msg += fmt.Sprintf("\tprivilege %s at position %d not in details of contract %s\n", privilegeType, pos, contractAddr)
+				}
+				return false
+			})
+		}
+		return sdk.FormatInvariant(types.ModuleName, "privilege-index",
+			fmt.Sprintf("found %d inconsistent privilege index entries\n%s", broken, msg)), broken != 0
+	}
+}
+
+// ValidatorSetUpdaterInvariant checks that exactly one contract is registered for validator set updates
+func ValidatorSetUpdaterInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var contracts []string
+		k.IteratePrivilegedContractsByType(ctx, types.PrivilegeTypeValidatorSetUpdate, func(_ uint8, contractAddr sdk.AccAddress) bool {
+			contracts = append(contracts, contractAddr.String())
+			return false
+		})
+		return sdk.FormatInvariant(types.ModuleName, "validator-set-updater",
+			fmt.Sprintf("expected exactly one %s contract but got %d: %v", types.PrivilegeTypeValidatorSetUpdate, len(contracts), contracts)), len(contracts) != 1
+	}
+}
@@ -0,0 +1,103 @@
+package keeper
+
+import (
+	"testing"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/confio/tgrade/x/twasm/types"
+)
+
+func TestPrivilegeIndexInvariant(t *testing.T) {
+	specs := map[string]struct {
+		setup     func(t *testing.T, ctx sdk.Context, k *Keeper, contractAddr sdk.AccAddress)
+		expBroken bool
+	}{
+		"consistent": {
+			setup: func(t *testing.T, ctx sdk.Context, k *Keeper, contractAddr sdk.AccAddress) {},
+		},
+		"privileged flag for unknown contract": {
+			setup: func(t *testing.T, ctx sdk.Context, k *Keeper, contractAddr sdk.AccAddress) {
+				k.setPrivilegedFlag(ctx, RandomAddress(t))
+			},
+			expBroken: true,
+		},
+		"registered privilege not indexed": {
+			setup: func(t *testing.T, ctx sdk.Context, k *Keeper, contractAddr sdk.AccAddress) {
+				k.removePrivilegeRegistration(ctx, types.PrivilegeTypeBeginBlock, 1, contractAddr)
+			},
+			expBroken: true,
+		},
+		"indexed privilege not in details": {
+			setup: func(t *testing.T, ctx sdk.Context, k *Keeper, contractAddr sdk.AccAddress) {
+				k.storeContractPrivilegeRegistration(ctx, types.PrivilegeTypeEndBlock, 1, contractAddr)
+			},
+			expBroken: true,
+		},
+		"indexed privilege for non privileged contract": {
+			setup: func(t *testing.T, ctx sdk.Context, k *Keeper, contractAddr sdk.AccAddress) {
+				k.clearPrivilegedFlag(ctx, contractAddr)
+			},
+			expBroken: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, keepers := CreateDefaultTestInput(t, wasmkeeper.WithWasmEngine(NewWasmVMMock()))
+			k := keepers.TWasmKeeper
+			_, contractAddr := seedTestContract(t, ctx, k)
+			k.setPrivilegedFlag(ctx, contractAddr)
+			pos, err := k.appendToPrivilegedContracts(ctx, types.PrivilegeTypeBeginBlock, contractAddr)
+			require.NoError(t, err)
+			details, err := k.getContractDetails(ctx, contractAddr)
+			require.NoError(t, err)
+			details.AddRegisteredPrivilege(types.PrivilegeTypeBeginBlock, pos)
+			require.NoError(t, k.setContractDetails(ctx, contractAddr, details))
+
+			spec.setup(t, ctx, k, contractAddr)
+
+			// when
+			msg, broken := PrivilegeIndexInvariant(k)(ctx)
+
+			// then
+			assert.Equal(t, spec.expBroken, broken, msg)
+		})
+	}
+}
+
+func TestValidatorSetUpdaterInvariant(t *testing.T) {
+	specs := map[string]struct {
+		registered int
+		expBroken  bool
+	}{
+		"one registered": {
+			registered: 1,
+		},
+		"none registered": {
+			expBroken: true,
+		},
+		"multiple registered": {
+			registered: 2,
+			expBroken:  true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, keepers := CreateDefaultTestInput(t, wasmkeeper.WithWasmEngine(NewWasmVMMock()))
+			k := keepers.TWasmKeeper
+			for i := 0; i < spec.registered; i++ {
+				// bypass the singleton check
+				k.storeContractPrivilegeRegistration(ctx, types.PrivilegeTypeValidatorSetUpdate, uint8(i+1), RandomAddress(t))
+			}
+
+			// when
+			msg, broken := ValidatorSetUpdaterInvariant(k)(ctx)
+
+			// then
+			assert.Equal(t, spec.expBroken, broken, msg)
+		})
+	}
+}
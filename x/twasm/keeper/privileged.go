@@ -94,6 +94,30 @@ func (k Keeper) UnsetPrivileged(ctx sdk.Context, contractAddr sdk.AccAddress) er
 	k.clearPrivilegedFlag(ctx, contractAddr)
 
 	// remove remaining privileges
+	if err := k.releasePrivileges(ctx, contractAddr, contractInfo); err != nil {
+		return err
+	}
+
+	k.Logger(ctx).Info("Unset privileged", "contractAddr", contractAddr.String())
+	event := sdk.NewEvent(
+		types.EventTypeUnsetPrivileged,
+		sdk.NewAttribute(wasmtypes.AttributeKeyContractAddr, contractAddr.String()),
+	)
+	ctx.EventManager().EmitEvent(event)
+	return nil
+}
+
+// ReleasePrivileges removes all privilege registrations of the contract without calling it.
+// The privileged flag is kept so that the contract can register again on a new promotion.
+func (k Keeper) ReleasePrivileges(ctx sdk.Context, contractAddr sdk.AccAddress) error {
+	contractInfo := k.GetContractInfo(ctx, contractAddr)
+	if contractInfo == nil {
+		return sdkerrors.Wrap(wasmtypes.ErrNotFound, "contractAddr")
+	}
+	return k.releasePrivileges(ctx, contractAddr, contractInfo)
+}
+
+func (k Keeper) releasePrivileges(ctx sdk.Context, contractAddr sdk.AccAddress, contractInfo *wasmtypes.ContractInfo) error {
 	var details types.TgradeContractDetails
 	if err := contractInfo.ReadExtension(&details); err != nil {
 		return err
@@ -106,13 +130,6 @@ func (k Keeper) UnsetPrivileged(ctx sdk.Context, contractAddr sdk.AccAddress) er
 	if err := k.setContractDetails(ctx, contractAddr, &details); err != nil {
 		return sdkerrors.Wrap(err, "store contract info extension")
 	}
-
-	k.Logger(ctx).Info("Unset privileged", "contractAddr", contractAddr.String())
-	event := sdk.NewEvent(
-		types.EventTypeUnsetPrivileged,
-		sdk.NewAttribute(wasmtypes.AttributeKeyContractAddr, contractAddr.String()),
-	)
-	ctx.EventManager().EmitEvent(event)
 	return nil
 }
 
@@ -232,7 +249,7 @@ func (k Keeper) removePrivilegeRegistration(ctx sdk.Context, privilegeType types
 }
 
 // getPrivilegedContract returns the key stored at the given type and position. Result can be nil when none exists
-func (k Keeper) getPrivilegedContract(ctx sdk.Context, privilegeType types.PrivilegeType, pos uint8) sdk.AccAddress { //nolint:unused
+func (k Keeper) getPrivilegedContract(ctx sdk.Context, privilegeType types.PrivilegeType, pos uint8) sdk.AccAddress {
 	store := ctx.KVStore(k.storeKey)
 	key := contractPrivilegesSecondaryIndexKey(privilegeType, pos)
 	return store.Get(key)
@@ -213,6 +213,42 @@ func TestUnsetPrivileged(t *testing.T) {
 	}
 }
 
+func TestReleasePrivileges(t *testing.T) {
+	mock := NewWasmVMMock(func(m *wasmtesting.MockWasmer) {
+		m.SudoFn = func(codeID cosmwasm.Checksum, env wasmvmtypes.Env, sudoMsg []byte, store cosmwasm.KVStore, goapi cosmwasm.GoAPI, querier cosmwasm.Querier, gasMeter cosmwasm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.Response, uint64, error) {
+			panic("not expected to be called")
+		}
+	})
+	ctx, keepers := CreateDefaultTestInput(t, wasmkeeper.WithWasmEngine(mock))
+	k := keepers.TWasmKeeper
+	_, contractAddr := seedTestContract(t, ctx, k)
+
+	h := NewTgradeHandler(nil, k, nil, nil, nil)
+	k.setPrivilegedFlag(ctx, contractAddr)
+	for _, p := range []types.PrivilegeType{types.PrivilegeTypeBeginBlock, types.PrivilegeTypeEndBlock} {
+		require.NoError(t, h.handlePrivilege(ctx, contractAddr, &contract.PrivilegeMsg{Request: p}))
+	}
+
+	// when
+	err := k.ReleasePrivileges(ctx, contractAddr)
+
+	// then
+	require.NoError(t, err)
+	// flag still set
+	assert.True(t, k.IsPrivileged(ctx, contractAddr))
+	// and privileges removed
+	assert.False(t, k.ExistsAnyPrivilegedContract(ctx, types.PrivilegeTypeEndBlock))
+	assert.False(t, k.ExistsAnyPrivilegedContract(ctx, types.PrivilegeTypeBeginBlock))
+	// and state updated
+	info := k.GetContractInfo(ctx, contractAddr)
+	var details types.TgradeContractDetails
+	require.NoError(t, info.ReadExtension(&details))
+	assert.Empty(t, details.RegisteredPrivileges)
+
+	// and unknown contract rejected
+	require.Error(t, k.ReleasePrivileges(ctx, sdk.AccAddress(rand.Bytes(address.Len))))
+}
+
 func TestIteratePrivileged(t *testing.T) {
 	ctx, keepers := CreateDefaultTestInput(t, wasmkeeper.WithWasmEngine(NewWasmVMMock()))
 	k := keepers.TWasmKeeper
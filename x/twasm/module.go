@@ -133,8 +133,10 @@ func (am AppModule) LegacyQuerierHandler(amino *codec.LegacyAmino) sdk.Querier {
 	return wasmkeeper.NewLegacyQuerier(am.keeper, am.keeper.QueryGasLimit())
 }
 
-// RegisterInvariants registers the wasm module invariants.
-func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {}
+// RegisterInvariants registers the twasm module invariants.
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, am.keeper)
+}
 
 // Route returns the message routing key for the wasm module.
 func (am AppModule) Route() sdk.Route {
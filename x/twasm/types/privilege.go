@@ -44,6 +44,10 @@ var (
 	// The contract receives a sudo message of type export where the result is stored in genesis. For the import path the json object containing state
 	// is passed to the contract via sudo import method.
 	PrivilegeStateExporterImporter = registerCallbackType(0x8, "state_exporter_importer", false)
+
+	// PrivilegeFeeSponsor is a permission to pay the fees of TXs that name the contract as sponsor. The contract receives
+	// a sudo message of type approve_fee before the fees are deducted from its balance.
+	PrivilegeFeeSponsor = registerCallbackType(0x9, "fee_sponsor", false)
 )
 
 var (
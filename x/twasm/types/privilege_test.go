@@ -85,6 +85,7 @@ func TestPrivilegedCallbackTypeSingletons(t *testing.T) {
 		PrivilegeConsensusParamChanger:   false,
 		PrivilegeDelegator:               false,
 		PrivilegeStateExporterImporter:   false,
+		PrivilegeFeeSponsor:              false,
 	}
 	for c, exp := range specs {
 		t.Run(c.String(), func(t *testing.T) {